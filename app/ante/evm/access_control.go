@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// EthAccessControlDecorator validates that the senders of the Ethereum Txs are
+// permitted to create or call contracts by the access control of the EVM
+// parameters.
+type EthAccessControlDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthAccessControlDecorator creates a new EthAccessControlDecorator
+func NewEthAccessControlDecorator(ek EVMKeeper) EthAccessControlDecorator {
+	return EthAccessControlDecorator{
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle checks the sender of each message against the create or call
+// policy of the access control, depending on whether the message deploys a
+// contract.
+//
+// This AnteHandler decorator will fail if:
+//   - the message is not a MsgEthereumTx
+//   - from address is empty
+//   - the sender is not permitted to create or call contracts
+func (acd EthAccessControlDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	accessControl := acd.evmKeeper.GetParams(ctx).AccessControl
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		// sender address should be in the tx cache from the previous AnteHandle call
+		from := msgEthTx.GetFrom()
+		if from.Empty() {
+			return ctx, errorsmod.Wrap(errortypes.ErrInvalidAddress, "from address cannot be empty")
+		}
+
+		sender := common.BytesToAddress(from)
+		if msgEthTx.AsTransaction().To() == nil {
+			if !accessControl.CanCreate(sender) {
+				return ctx, errorsmod.Wrapf(evmtypes.ErrCreateNotPermitted, "sender %s is not permitted to create contracts", sender)
+			}
+		} else if !accessControl.CanCall(sender) {
+			return ctx, errorsmod.Wrapf(evmtypes.ErrCallNotPermitted, "sender %s is not permitted to call contracts", sender)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
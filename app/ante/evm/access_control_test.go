@@ -0,0 +1,122 @@
+package evm_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethante "github.com/kato114/byte/v15/app/ante/evm"
+	"github.com/kato114/byte/v15/testutil"
+	testutiltx "github.com/kato114/byte/v15/testutil/tx"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestEthAccessControlDecorator() {
+	dec := ethante.NewEthAccessControlDecorator(suite.app.EvmKeeper)
+
+	addr := testutiltx.GenerateAddress()
+	to := testutiltx.GenerateAddress()
+
+	createTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		Nonce:    1,
+		Amount:   big.NewInt(10),
+		GasLimit: 1000,
+		GasPrice: big.NewInt(1),
+		Accesses: &ethtypes.AccessList{},
+	})
+	createTx.From = addr.Hex()
+
+	callTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		Nonce:    1,
+		To:       &to,
+		Amount:   big.NewInt(10),
+		GasLimit: 1000,
+		GasPrice: big.NewInt(1),
+		Accesses: &ethtypes.AccessList{},
+	})
+	callTx.From = addr.Hex()
+
+	noFromTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		Nonce:    1,
+		To:       &to,
+		GasLimit: 1000,
+		GasPrice: big.NewInt(1),
+	})
+
+	listed := []string{addr.Hex()}
+	other := []string{to.Hex()}
+
+	testCases := []struct {
+		name          string
+		tx            sdk.Tx
+		accessControl evmtypes.AccessControl
+		expPass       bool
+	}{
+		{"invalid transaction type", &testutiltx.InvalidTx{}, evmtypes.DefaultAccessControl(), false},
+		{"sender not set", noFromTx, evmtypes.DefaultAccessControl(), false},
+		{"permissionless create", createTx, evmtypes.DefaultAccessControl(), true},
+		{"permissionless call", callTx, evmtypes.DefaultAccessControl(), true},
+		{
+			"restricted create",
+			createTx,
+			evmtypes.AccessControl{Create: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypeRestricted, AccessControlList: listed}},
+			false,
+		},
+		{
+			"restricted create of another sender",
+			createTx,
+			evmtypes.AccessControl{Create: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypeRestricted, AccessControlList: other}},
+			true,
+		},
+		{
+			"permissioned create",
+			createTx,
+			evmtypes.AccessControl{Create: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypePermissioned, AccessControlList: listed}},
+			true,
+		},
+		{
+			"permissioned create of another sender",
+			createTx,
+			evmtypes.AccessControl{Create: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypePermissioned, AccessControlList: other}},
+			false,
+		},
+		{
+			"call with permissioned create",
+			callTx,
+			evmtypes.AccessControl{Create: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypePermissioned, AccessControlList: other}},
+			true,
+		},
+		{
+			"restricted call",
+			callTx,
+			evmtypes.AccessControl{Call: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypeRestricted, AccessControlList: listed}},
+			false,
+		},
+		{
+			"permissioned call of another sender",
+			callTx,
+			evmtypes.AccessControl{Call: evmtypes.AccessControlType{AccessType: evmtypes.AccessTypePermissioned, AccessControlList: other}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.AccessControl = tc.accessControl
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			_, err := dec.AnteHandle(suite.ctx, tc.tx, false, testutil.NextFn)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
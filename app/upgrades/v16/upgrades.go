@@ -3,6 +3,7 @@
 package v16
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -28,8 +29,35 @@ func CreateUpgradeHandler(
 			}
 		}
 
+		// enable Shanghai (PUSH0) from the upgrade height, and keep Cancun
+		// disabled until the go-ethereum fork ships its instructions, as the
+		// existing chains have both set to the genesis block
+		if err := ScheduleForks(ctx, ek); err != nil {
+			return nil, err
+		}
+
+		// store the parent block hashes from the upgrade height on
+		if err := ek.DeployHistoryStorage(ctx); err != nil {
+			return nil, err
+		}
+
 		// Leave modules are as-is to avoid running InitGenesis.
 		logger.Debug("running module migrations ...")
 		return mm.RunMigrations(ctx, configurator, vm)
 	}
 }
+
+// ScheduleForks sets the Shanghai block of the EVM chain config to the upgrade
+// height, unless it's set to a later one, and unsets the Cancun block.
+func ScheduleForks(ctx sdk.Context, ek *evmkeeper.Keeper) error {
+	params := ek.GetParams(ctx)
+
+	upgradeHeight := sdkmath.NewInt(ctx.BlockHeight())
+	shanghaiBlock := params.ChainConfig.ShanghaiBlock
+	if shanghaiBlock == nil || shanghaiBlock.LT(upgradeHeight) {
+		params.ChainConfig.ShanghaiBlock = &upgradeHeight
+	}
+	params.ChainConfig.CancunBlock = nil
+
+	return ek.SetParams(ctx, params)
+}
@@ -0,0 +1,92 @@
+package v16_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kato114/byte/v15/app"
+	v16 "github.com/kato114/byte/v15/app/upgrades/v16"
+	"github.com/kato114/byte/v15/utils"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+	feemarkettypes "github.com/kato114/byte/v15/x/feemarket/types"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *app.Evmos
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	checkTx := false
+
+	// NOTE: this is the new binary, not the old one.
+	suite.app = app.Setup(checkTx, feemarkettypes.DefaultGenesisState(), utils.MainnetChainID+"-1")
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Height:  1000,
+		ChainID: utils.MainnetChainID + "-1",
+		Time:    time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC),
+	})
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	s := new(UpgradeTestSuite)
+	suite.Run(t, s)
+}
+
+func (suite *UpgradeTestSuite) TestScheduleForks() {
+	zero := sdkmath.ZeroInt()
+	later := sdkmath.NewInt(2000)
+
+	testCases := []struct {
+		name        string
+		shanghai    *sdkmath.Int
+		cancun      *sdkmath.Int
+		expShanghai sdkmath.Int
+	}{
+		{"forks set to the genesis block", &zero, &zero, sdkmath.NewInt(1000)},
+		{"forks not set", nil, nil, sdkmath.NewInt(1000)},
+		{"shanghai set to a later block", &later, &later, later},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.ChainConfig.ShanghaiBlock = tc.shanghai
+			params.ChainConfig.CancunBlock = tc.cancun
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			suite.Require().NoError(v16.ScheduleForks(suite.ctx, suite.app.EvmKeeper))
+
+			chainConfig := suite.app.EvmKeeper.GetParams(suite.ctx).ChainConfig
+			suite.Require().NotNil(chainConfig.ShanghaiBlock)
+			suite.Require().Equal(tc.expShanghai, *chainConfig.ShanghaiBlock)
+			suite.Require().Nil(chainConfig.CancunBlock)
+		})
+	}
+}
+
+func (suite *UpgradeTestSuite) TestUpgradeHandlerDeploysHistoryStorage() {
+	suite.SetupTest()
+
+	ek := suite.app.EvmKeeper
+	suite.Require().NoError(ek.DeleteAccount(suite.ctx, evmtypes.HistoryStorageAddress))
+
+	handler := v16.CreateUpgradeHandler(module.NewManager(), module.NewConfigurator(nil, nil, nil), ek)
+	_, err := handler(suite.ctx, upgradetypes.Plan{Name: v16.UpgradeName, Height: suite.ctx.BlockHeight()}, module.VersionMap{})
+	suite.Require().NoError(err)
+
+	acct := ek.GetAccount(suite.ctx, evmtypes.HistoryStorageAddress)
+	suite.Require().NotNil(acct)
+	suite.Require().True(acct.IsContract())
+}
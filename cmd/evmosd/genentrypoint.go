@@ -0,0 +1,164 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/kato114/byte/v15/types"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// AddGenesisEntryPointCmd returns add-genesis-entry-point cobra Command.
+func AddGenesisEntryPointCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-entry-point VERSION ENTRY_POINT_CODE_FILE SENDER_CREATOR_CODE_FILE",
+		Short: "Pre-deploy the ERC-4337 EntryPoint in genesis.json",
+		Long: fmt.Sprintf(`Pre-deploy the ERC-4337 EntryPoint (%s or %s) at its canonical address in genesis.json.
+The code files contain the hex encoded runtime bytecode (deployedBytecode) of the EntryPoint
+and of the SenderCreator of the official release artifacts. The SenderCreator is deployed
+at the address the EntryPoint creates it at, which is embedded in the EntryPoint code.
+`, evmtypes.EntryPointV06, evmtypes.EntryPointV07),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			config.SetRoot(clientCtx.HomeDir)
+
+			version, err := evmtypes.ParseEntryPointVersion(args[0])
+			if err != nil {
+				return err
+			}
+
+			entryPointCode, err := readCodeFile(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to read EntryPoint code: %w", err)
+			}
+
+			senderCreatorCode, err := readCodeFile(args[2])
+			if err != nil {
+				return fmt.Errorf("failed to read SenderCreator code: %w", err)
+			}
+
+			evmGenAccounts, err := evmtypes.EntryPointGenesisAccounts(version, entryPointCode, senderCreatorCode)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+			if err != nil {
+				return fmt.Errorf("failed to get accounts from any: %w", err)
+			}
+
+			// the EntryPoint created the SenderCreator, so its nonce is 2
+			nonces := []uint64{2, 1}
+			codes := [][]byte{entryPointCode, senderCreatorCode}
+
+			for i, evmGenAccount := range evmGenAccounts {
+				addr := sdk.AccAddress(common.HexToAddress(evmGenAccount.Address).Bytes())
+				if accs.Contains(addr) {
+					return fmt.Errorf("cannot add account at existing address %s", evmGenAccount.Address)
+				}
+
+				genAccount := &types.EthAccount{
+					BaseAccount: authtypes.NewBaseAccount(addr, nil, 0, nonces[i]),
+					CodeHash:    crypto.Keccak256Hash(codes[i]).Hex(),
+				}
+
+				if err := genAccount.Validate(); err != nil {
+					return fmt.Errorf("failed to validate new genesis account: %w", err)
+				}
+
+				accs = append(accs, genAccount)
+			}
+
+			accs = authtypes.SanitizeGenesisAccounts(accs)
+
+			genAccs, err := authtypes.PackAccounts(accs)
+			if err != nil {
+				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+			}
+			authGenState.Accounts = genAccs
+
+			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+			}
+
+			appState[authtypes.ModuleName] = authGenStateBz
+
+			var evmGenState evmtypes.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[evmtypes.ModuleName], &evmGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal evm genesis state: %w", err)
+			}
+
+			evmGenState.Accounts = append(evmGenState.Accounts, evmGenAccounts...)
+
+			if err := evmGenState.Validate(); err != nil {
+				return fmt.Errorf("failed to validate evm genesis state: %w", err)
+			}
+
+			evmGenStateBz, err := clientCtx.Codec.MarshalJSON(&evmGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal evm genesis state: %w", err)
+			}
+
+			appState[evmtypes.ModuleName] = evmGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// readCodeFile reads the hex encoded bytecode of the given file.
+func readCodeFile(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path) // #nosec G304 -- path set by the node operator
+	if err != nil {
+		return nil, err
+	}
+
+	code := strings.TrimSpace(string(bz))
+	if !strings.HasPrefix(code, "0x") {
+		code = "0x" + code
+	}
+
+	return hexutil.Decode(code)
+}
@@ -3,6 +3,7 @@
 package indexer
 
 import (
+	"encoding/json"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,31 +16,50 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/kato114/byte/v15/rpc/types"
 	evmostypes "github.com/kato114/byte/v15/types"
 	evmtypes "github.com/kato114/byte/v15/x/evm/types"
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash        = 1
+	KeyPrefixTxIndex       = 2
+	KeyPrefixAddress       = 3
+	KeyPrefixLogAddress    = 4
+	KeyPrefixLogTopic      = 5
+	KeyPrefixLogIndexRange = 6
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// AddressKeyLength is the length of address key
+	AddressKeyLength = 1 + common.AddressLength + 8 + 8 + 1
+	// LogAddressKeyLength is the length of log address key
+	LogAddressKeyLength = 1 + common.AddressLength + 8 + 8
+	// LogTopicKeyLength is the length of log topic key
+	LogTopicKeyLength = 1 + 1 + common.HashLength + 8 + 8
 )
 
 var _ evmostypes.EVMTxIndexer = &KVIndexer{}
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
-	db        dbm.DB
-	logger    log.Logger
-	clientCtx client.Context
+	db           dbm.DB
+	logger       log.Logger
+	clientCtx    client.Context
+	addressRoles []evmostypes.AddressRole
+	logIndex     bool
 }
 
-// NewKVIndexer creates the KVIndexer
-func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+// NewKVIndexer creates the KVIndexer. The txs are also indexed by the addresses
+// with the given roles, the address index is disabled if none is given.
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context, addressRoles ...evmostypes.AddressRole) *KVIndexer {
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx, addressRoles: addressRoles}
+}
+
+// SetLogIndex enables or disables the index of the logs by address and topics.
+func (kv *KVIndexer) SetLogIndex(enabled bool) {
+	kv.logIndex = enabled
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -113,8 +133,40 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+
+			if len(kv.addressRoles) == 0 && !kv.logIndex {
+				continue
+			}
+
+			var logs []*evmtypes.Log
+			if result.Code == abci.CodeTypeOK {
+				logs, err = txLogs(result.Events, msgIndex)
+				if err != nil {
+					kv.logger.Error("Fail to parse tx logs", "err", err, "block", height, "txIndex", txIndex)
+				}
+			}
+
+			if len(kv.addressRoles) > 0 {
+				addresses, err := txAddresses(ethMsg)
+				if err != nil {
+					kv.logger.Error("Fail to recover tx sender", "err", err, "block", height, "txIndex", txIndex)
+				}
+				addresses = append(addresses, logAddresses(logs)...)
+				if err := kv.saveAddresses(batch, txHash, &txResult, addresses); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
+			}
+
+			if kv.logIndex {
+				if err := saveLogs(batch, txHash, height, logs); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d", height)
+				}
+			}
 		}
 	}
+	if err := kv.updateLogIndexRange(batch, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -159,6 +211,76 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*evmo
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// AddressRoles returns the address roles indexed, empty if the address index
+// is disabled.
+func (kv *KVIndexer) AddressRoles() []evmostypes.AddressRole {
+	return kv.addressRoles
+}
+
+// GetByAddress returns the hashes of the eth txs in which the given address
+// has any of the given roles, or any role if none is given. The txs start
+// after the given block number in ascending order, or before it in descending
+// order if reverse is set. A block number lower than 1 starts from the latest
+// block when reverse is set. At least limit txs are returned if available,
+// together with the remaining txs of the last block, so that a block is never
+// split across pages. The bool is true if more txs are available.
+func (kv *KVIndexer) GetByAddress(
+	address common.Address,
+	roles []evmostypes.AddressRole,
+	blockNumber int64,
+	reverse bool,
+	limit int,
+) ([]common.Hash, bool, error) {
+	prefix := append([]byte{KeyPrefixAddress}, address.Bytes()...)
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	switch {
+	case reverse && blockNumber < 1:
+		it, err = kv.db.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	case reverse:
+		it, err = kv.db.ReverseIterator(prefix, append(prefix, sdk.Uint64ToBigEndian(uint64(blockNumber))...))
+	default:
+		it, err = kv.db.Iterator(append(prefix, sdk.Uint64ToBigEndian(uint64(blockNumber)+1)...), sdk.PrefixEndBytes(prefix))
+	}
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+	}
+	defer it.Close()
+
+	var (
+		hashes     []common.Hash
+		lastHeight int64
+		lastHash   common.Hash
+	)
+	for ; it.Valid(); it.Next() {
+		height, role, err := parseAddressKey(it.Key())
+		if err != nil {
+			return nil, false, errorsmod.Wrapf(err, "GetByAddress %s", address.Hex())
+		}
+		if len(roles) > 0 && !containsRole(roles, role) {
+			continue
+		}
+
+		// the keys of the roles of a tx are adjacent
+		hash := common.BytesToHash(it.Value())
+		if len(hashes) > 0 && hash == lastHash {
+			continue
+		}
+		if len(hashes) >= limit && height != lastHeight {
+			return hashes, true, nil
+		}
+
+		hashes = append(hashes, hash)
+		lastHeight = height
+		lastHash = hash
+	}
+
+	return hashes, false, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -171,6 +293,14 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// AddressKey returns the key for db entry: `(address, block number, tx index, role) -> tx hash`
+func AddressKey(address common.Address, blockNumber int64, txIndex int32, role evmostypes.AddressRole) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	key := append(append(append([]byte{KeyPrefixAddress}, address.Bytes()...), bz1...), bz2...)
+	return append(key, byte(role))
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -222,6 +352,100 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveAddresses index the addresses of the eth tx with the indexed roles into
+// the kv db batch
+func (kv *KVIndexer) saveAddresses(batch dbm.Batch, txHash common.Hash, txResult *evmostypes.TxResult, addresses []txAddress) error {
+	for _, address := range addresses {
+		if !containsRole(kv.addressRoles, address.role) {
+			continue
+		}
+		key := AddressKey(address.address, txResult.Height, txResult.EthTxIndex, address.role)
+		if err := batch.Set(key, txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set address key")
+		}
+	}
+	return nil
+}
+
+// txAddress is an address with its role in an eth tx
+type txAddress struct {
+	address common.Address
+	role    evmostypes.AddressRole
+}
+
+// txAddresses returns the sender and the recipient of the eth tx, or the
+// created contract address if the tx is a contract creation.
+func txAddresses(msg *evmtypes.MsgEthereumTx) ([]txAddress, error) {
+	tx := msg.AsTransaction()
+	from, err := msg.GetSender(tx.ChainId())
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []txAddress{{from, evmostypes.AddressRoleSender}}
+	if to := tx.To(); to != nil {
+		addresses = append(addresses, txAddress{*to, evmostypes.AddressRoleRecipient})
+	} else {
+		addresses = append(addresses, txAddress{crypto.CreateAddress(from, tx.Nonce()), evmostypes.AddressRoleContract})
+	}
+	return addresses, nil
+}
+
+// txLogs returns the logs of the eth tx at the given msg index, parsed from
+// the tx log events.
+func txLogs(events []abci.Event, msgIndex int) ([]*evmtypes.Log, error) {
+	for _, event := range events {
+		if event.Type != evmtypes.EventTypeTxLog {
+			continue
+		}
+
+		if msgIndex > 0 {
+			// not the eth tx we want
+			msgIndex--
+			continue
+		}
+
+		logs := make([]*evmtypes.Log, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			if attr.Key != evmtypes.AttributeKeyTxLog {
+				continue
+			}
+
+			var txLog evmtypes.Log
+			if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
+				return nil, err
+			}
+			logs = append(logs, &txLog)
+		}
+		return logs, nil
+	}
+	return nil, nil
+}
+
+// logAddresses returns the addresses that emitted the logs.
+func logAddresses(logs []*evmtypes.Log) []txAddress {
+	var addresses []txAddress
+	seen := make(map[common.Address]bool)
+	for _, txLog := range logs {
+		address := common.HexToAddress(txLog.Address)
+		if !seen[address] {
+			seen[address] = true
+			addresses = append(addresses, txAddress{address, evmostypes.AddressRoleLog})
+		}
+	}
+	return addresses
+}
+
+// containsRole returns true if the role is in the list
+func containsRole(roles []evmostypes.AddressRole, role evmostypes.AddressRole) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -229,3 +453,12 @@ func parseBlockNumberFromKey(key []byte) (int64, error) {
 
 	return int64(sdk.BigEndianToUint64(key[1:9])), nil
 }
+
+func parseAddressKey(key []byte) (int64, evmostypes.AddressRole, error) {
+	if len(key) != AddressKeyLength {
+		return 0, 0, fmt.Errorf("wrong address key length, expect: %d, got: %d", AddressKeyLength, len(key))
+	}
+
+	offset := 1 + common.AddressLength
+	return int64(sdk.BigEndianToUint64(key[offset : offset+8])), evmostypes.AddressRole(key[AddressKeyLength-1]), nil
+}
@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -17,6 +18,7 @@ import (
 	evmenc "github.com/kato114/byte/v15/encoding"
 	"github.com/kato114/byte/v15/indexer"
 	utiltx "github.com/kato114/byte/v15/testutil/tx"
+	evmostypes "github.com/kato114/byte/v15/types"
 	"github.com/kato114/byte/v15/utils"
 	"github.com/kato114/byte/v15/x/evm/types"
 	"github.com/stretchr/testify/require"
@@ -30,6 +32,9 @@ func TestKVIndexer(t *testing.T) {
 	ethSigner := ethtypes.LatestSignerForChainID(nil)
 
 	to := common.BigToAddress(big.NewInt(1))
+	logAddr := common.BigToAddress(big.NewInt(2))
+	txLog, err := json.Marshal(&types.Log{Address: logAddr.Hex()})
+	require.NoError(t, err)
 	ethTxParams := types.EvmTxArgs{
 		Nonce:    0,
 		To:       &to,
@@ -78,6 +83,9 @@ func TestKVIndexer(t *testing.T) {
 							{Key: "txHash", Value: ""},
 							{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
 						}},
+						{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+							{Key: types.AttributeKeyTxLog, Value: string(txLog)},
+						}},
 					},
 				},
 			},
@@ -156,7 +164,7 @@ func TestKVIndexer(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			db := dbm.NewMemDB()
-			idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+			idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx, evmostypes.AllAddressRoles()...)
 
 			err = idxer.IndexBlock(tc.block, tc.blockResult)
 			require.NoError(t, err)
@@ -183,6 +191,52 @@ func TestKVIndexer(t *testing.T) {
 				res2, err := idxer.GetByBlockAndIndex(1, 0)
 				require.NoError(t, err)
 				require.Equal(t, res1, res2)
+
+				for _, addr := range []common.Address{from, to} {
+					hashes, more, err := idxer.GetByAddress(addr, nil, 0, true, 10)
+					require.NoError(t, err)
+					require.Equal(t, []common.Hash{txHash}, hashes)
+					require.False(t, more)
+
+					hashes, _, err = idxer.GetByAddress(addr, nil, 0, false, 10)
+					require.NoError(t, err)
+					require.Equal(t, []common.Hash{txHash}, hashes)
+
+					hashes, _, err = idxer.GetByAddress(addr, nil, 1, true, 10)
+					require.NoError(t, err)
+					require.Empty(t, hashes)
+
+					hashes, _, err = idxer.GetByAddress(addr, nil, 1, false, 10)
+					require.NoError(t, err)
+					require.Empty(t, hashes)
+				}
+
+				hashes, _, err := idxer.GetByAddress(from, []evmostypes.AddressRole{evmostypes.AddressRoleSender}, 0, true, 10)
+				require.NoError(t, err)
+				require.Equal(t, []common.Hash{txHash}, hashes)
+
+				hashes, _, err = idxer.GetByAddress(from, []evmostypes.AddressRole{evmostypes.AddressRoleRecipient}, 0, true, 10)
+				require.NoError(t, err)
+				require.Empty(t, hashes)
+
+				hashes, _, err = idxer.GetByAddress(to, []evmostypes.AddressRole{evmostypes.AddressRoleRecipient}, 0, true, 10)
+				require.NoError(t, err)
+				require.Equal(t, []common.Hash{txHash}, hashes)
+
+				hashes, _, err = idxer.GetByAddress(logAddr, []evmostypes.AddressRole{evmostypes.AddressRoleLog}, 0, true, 10)
+				require.NoError(t, err)
+				if tc.name == "success, format 1" {
+					require.Equal(t, []common.Hash{txHash}, hashes)
+				} else {
+					require.Empty(t, hashes)
+				}
+
+				// the address index is disabled by default
+				idxer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+				require.NoError(t, idxer.IndexBlock(tc.block, tc.blockResult))
+				hashes, _, err = idxer.GetByAddress(from, nil, 0, true, 10)
+				require.NoError(t, err)
+				require.Empty(t, hashes)
 			}
 		})
 	}
@@ -0,0 +1,240 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package indexer
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/kato114/byte/v15/types"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// LogIndexRange returns the first and last blocks covered by the log index,
+// returns -1 if the log index is empty or disabled.
+func (kv *KVIndexer) LogIndexRange() (int64, int64, error) {
+	if !kv.logIndex {
+		return -1, -1, nil
+	}
+	return loadLogIndexRange(kv.db)
+}
+
+// GetLogPositions returns the positions of the logs in the blocks between from
+// and to that match the addresses and topics criteria, in ascending order. The
+// criteria follow the `eth_getLogs` semantics: a log matches if it's emitted by
+// any of the addresses, and if each of its topics matches any of the topics at
+// the same position. At least one address or topic is required, as the index
+// can't list all the logs. The lookup stops once more than limit positions
+// match, so that the queries over the limit are rejected without loading all
+// their logs.
+func (kv *KVIndexer) GetLogPositions(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]evmostypes.LogPosition, error) {
+	// the logs are listed from the keys of the addresses, or else of the first
+	// topic position that isn't a wildcard, and the other criteria are checked
+	// against the keys of each log
+	var prefixes [][]byte
+	listedTopic := -1
+	if len(addresses) > 0 {
+		for _, address := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogAddress}, address.Bytes()...))
+		}
+	} else {
+		for i, topicList := range topics {
+			if len(topicList) == 0 {
+				// wildcard
+				continue
+			}
+			for _, topic := range topicList {
+				prefixes = append(prefixes, append([]byte{KeyPrefixLogTopic, byte(i)}, topic.Bytes()...))
+			}
+			listedTopic = i
+			break
+		}
+	}
+
+	if len(prefixes) == 0 {
+		return nil, fmt.Errorf("log index requires at least one address or topic")
+	}
+
+	positions := []evmostypes.LogPosition{}
+	for _, prefix := range prefixes {
+		err := kv.iterateLogPositions(prefix, from, to, func(position evmostypes.LogPosition) (bool, error) {
+			matched, err := kv.matchLogTopics(position, topics, listedTopic)
+			if err != nil {
+				return false, err
+			}
+			if matched {
+				positions = append(positions, position)
+			}
+			return len(positions) > limit, nil
+		})
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogPositions")
+		}
+		if len(positions) > limit {
+			break
+		}
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Height != positions[j].Height {
+			return positions[i].Height < positions[j].Height
+		}
+		return positions[i].Index < positions[j].Index
+	})
+	return positions, nil
+}
+
+// iterateLogPositions calls the callback with the positions of the log keys
+// with the given prefix in the blocks between from and to, until it returns
+// true.
+func (kv *KVIndexer) iterateLogPositions(
+	prefix []byte,
+	from, to int64,
+	cb func(evmostypes.LogPosition) (bool, error),
+) error {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...)
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to)+1)...)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		offset := len(key) - 16
+		stop, err := cb(evmostypes.LogPosition{
+			Height: int64(sdk.BigEndianToUint64(key[offset : offset+8])),
+			Index:  sdk.BigEndianToUint64(key[offset+8:]),
+		})
+		if err != nil || stop {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchLogTopics returns true if the log at the position matches the topics
+// criteria, except the topic position its keys are listed from.
+func (kv *KVIndexer) matchLogTopics(position evmostypes.LogPosition, topics [][]common.Hash, listedTopic int) (bool, error) {
+	for i, topicList := range topics {
+		if len(topicList) == 0 || i == listedTopic {
+			continue
+		}
+
+		matched := false
+		for _, topic := range topicList {
+			has, err := kv.db.Has(LogTopicKey(i, topic, position.Height, position.Index))
+			if err != nil {
+				return false, err
+			}
+			if has {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log index) -> tx hash`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint64) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(logIndex)
+	return append(append(append([]byte{KeyPrefixLogAddress}, address.Bytes()...), bz1...), bz2...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, log index) -> tx hash`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(logIndex)
+	return append(append(append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...), bz1...), bz2...)
+}
+
+// LogIndexRangeKey returns the key for db entry: `log index range -> (first block, last block)`
+func LogIndexRangeKey() []byte {
+	return []byte{KeyPrefixLogIndexRange}
+}
+
+// loadLogIndexRange returns the first and last blocks covered by the log
+// index, returns -1 if the log index is empty
+func loadLogIndexRange(db dbm.DB) (int64, int64, error) {
+	bz, err := db.Get(LogIndexRangeKey())
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LoadLogIndexRange")
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("wrong log index range length, expect: 16, got: %d", len(bz))
+	}
+	return int64(sdk.BigEndianToUint64(bz[:8])), int64(sdk.BigEndianToUint64(bz[8:])), nil
+}
+
+// updateLogIndexRange extends the range of the blocks covered by the log index
+// with the given block in the kv db batch. The range is reset if the block is
+// not adjacent to it, and dropped if the log index is disabled, so that it
+// never covers a gap.
+func (kv *KVIndexer) updateLogIndexRange(batch dbm.Batch, height int64) error {
+	first, last, err := loadLogIndexRange(kv.db)
+	if err != nil {
+		return err
+	}
+
+	if !kv.logIndex {
+		if first == -1 {
+			return nil
+		}
+		return batch.Delete(LogIndexRangeKey())
+	}
+
+	switch {
+	case first == -1:
+		first, last = height, height
+	case height >= first && height <= last:
+		return nil
+	case height == first-1:
+		first = height
+	case height == last+1:
+		last = height
+	default:
+		first, last = height, height
+	}
+
+	bz := append(sdk.Uint64ToBigEndian(uint64(first)), sdk.Uint64ToBigEndian(uint64(last))...)
+	if err := batch.Set(LogIndexRangeKey(), bz); err != nil {
+		return errorsmod.Wrap(err, "set log index range key")
+	}
+	return nil
+}
+
+// saveLogs index the logs of the eth tx by address and topics into the kv db
+// batch
+func saveLogs(batch dbm.Batch, txHash common.Hash, height int64, logs []*evmtypes.Log) error {
+	for _, txLog := range logs {
+		key := LogAddressKey(common.HexToAddress(txLog.Address), height, txLog.Index)
+		if err := batch.Set(key, txHash.Bytes()); err != nil {
+			return errorsmod.Wrap(err, "set log address key")
+		}
+		for i, topic := range txLog.Topics {
+			key := LogTopicKey(i, common.HexToHash(topic), height, txLog.Index)
+			if err := batch.Set(key, txHash.Bytes()); err != nil {
+				return errorsmod.Wrap(err, "set log topic key")
+			}
+		}
+	}
+	return nil
+}
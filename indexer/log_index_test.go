@@ -0,0 +1,211 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kato114/byte/v15/crypto/ethsecp256k1"
+	"github.com/kato114/byte/v15/indexer"
+	utiltx "github.com/kato114/byte/v15/testutil/tx"
+	evmostypes "github.com/kato114/byte/v15/types"
+	"github.com/kato114/byte/v15/utils"
+	"github.com/kato114/byte/v15/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKVIndexerLogIndex(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := utiltx.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	var (
+		token    = common.BigToAddress(big.NewInt(1))
+		other    = common.BigToAddress(big.NewInt(2))
+		transfer = common.BigToHash(big.NewInt(10))
+		approval = common.BigToHash(big.NewInt(11))
+		alice    = common.BigToHash(big.NewInt(20))
+		bob      = common.BigToHash(big.NewInt(21))
+	)
+
+	// buildBlock returns a block with a tx emitting the given logs
+	buildBlock := func(height int64, logs ...*types.Log) (*tmtypes.Block, []*abci.ResponseDeliverTx) {
+		tx := types.NewTx(&types.EvmTxArgs{
+			Nonce:    uint64(height),
+			To:       &token,
+			Amount:   big.NewInt(0),
+			GasLimit: 100000,
+		})
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+
+		logAttrs := make([]abci.EventAttribute, len(logs))
+		for i, txLog := range logs {
+			txLog.TxHash = txHash.Hex()
+			txLog.BlockNumber = uint64(height)
+			txLog.Index = uint64(i)
+			bz, err := json.Marshal(txLog)
+			require.NoError(t, err)
+			logAttrs[i] = abci.EventAttribute{Key: types.AttributeKeyTxLog, Value: string(bz)}
+		}
+
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+		results := []*abci.ResponseDeliverTx{
+			{
+				Code: 0,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "50000"},
+					}},
+					{Type: types.EventTypeTxLog, Attributes: logAttrs},
+				},
+			},
+		}
+		return block, results
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	idxer.SetLogIndex(true)
+
+	blocks := [][]*types.Log{
+		1: {
+			{Address: token.Hex(), Topics: []string{transfer.Hex(), alice.Hex(), bob.Hex()}},
+			{Address: other.Hex(), Topics: []string{transfer.Hex(), bob.Hex()}},
+		},
+		2: {
+			{Address: token.Hex(), Topics: []string{approval.Hex(), alice.Hex()}},
+		},
+		3: {
+			{Address: other.Hex(), Topics: []string{approval.Hex()}},
+			{Address: token.Hex(), Topics: []string{transfer.Hex(), bob.Hex(), alice.Hex()}},
+		},
+	}
+	for height := int64(1); height < int64(len(blocks)); height++ {
+		require.NoError(t, idxer.IndexBlock(buildBlock(height, blocks[height]...)))
+	}
+
+	first, last, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(3), last)
+
+	testCases := []struct {
+		name         string
+		from, to     int64
+		addresses    []common.Address
+		topics       [][]common.Hash
+		expPositions []evmostypes.LogPosition
+		expPass      bool
+	}{
+		{
+			"fail - no address nor topic",
+			1, 3,
+			nil,
+			[][]common.Hash{{}},
+			nil,
+			false,
+		},
+		{
+			"pass - address",
+			1, 3,
+			[]common.Address{token},
+			nil,
+			[]evmostypes.LogPosition{{Height: 1, Index: 0}, {Height: 2, Index: 0}, {Height: 3, Index: 1}},
+			true,
+		},
+		{
+			"pass - addresses within block range",
+			2, 3,
+			[]common.Address{token, other},
+			nil,
+			[]evmostypes.LogPosition{{Height: 2, Index: 0}, {Height: 3, Index: 0}, {Height: 3, Index: 1}},
+			true,
+		},
+		{
+			"pass - first topic",
+			1, 3,
+			nil,
+			[][]common.Hash{{transfer}},
+			[]evmostypes.LogPosition{{Height: 1, Index: 0}, {Height: 1, Index: 1}, {Height: 3, Index: 1}},
+			true,
+		},
+		{
+			"pass - address and positional topics",
+			1, 3,
+			[]common.Address{token},
+			[][]common.Hash{{transfer}, {}, {alice}},
+			[]evmostypes.LogPosition{{Height: 3, Index: 1}},
+			true,
+		},
+		{
+			"pass - topic alternatives",
+			1, 3,
+			nil,
+			[][]common.Hash{{}, {alice, bob}},
+			[]evmostypes.LogPosition{{Height: 1, Index: 0}, {Height: 1, Index: 1}, {Height: 2, Index: 0}, {Height: 3, Index: 1}},
+			true,
+		},
+		{
+			"pass - no match",
+			1, 3,
+			[]common.Address{other},
+			[][]common.Hash{{transfer}, {alice}},
+			[]evmostypes.LogPosition{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			positions, err := idxer.GetLogPositions(tc.from, tc.to, tc.addresses, tc.topics, 10)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expPositions, positions)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// the lookup stops once the limit is exceeded
+	positions, err := idxer.GetLogPositions(1, 3, nil, [][]common.Hash{{}, {alice, bob}}, 1)
+	require.NoError(t, err)
+	require.Len(t, positions, 2)
+
+	// a block out of the range resets it
+	require.NoError(t, idxer.IndexBlock(buildBlock(5)))
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), first)
+	require.Equal(t, int64(5), last)
+
+	// a block indexed without the log index drops the range
+	idxer.SetLogIndex(false)
+	require.NoError(t, idxer.IndexBlock(buildBlock(6)))
+	idxer.SetLogIndex(true)
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+}
@@ -0,0 +1,46 @@
+package bank_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kato114/byte/v15/precompiles/bank"
+	"github.com/kato114/byte/v15/precompiles/testutil"
+	testkeyring "github.com/kato114/byte/v15/testutil/integration/evmos/keyring"
+	"github.com/kato114/byte/v15/testutil/integration/evmos/network"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceBalances(t *testing.T) {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	precompile, err := bank.NewPrecompile(unitNetwork.App.BankKeeper, unitNetwork.App.Erc20Keeper)
+	require.NoError(t, err)
+
+	sender := keyring.GetAddr(0)
+	input, err := precompile.Pack(bank.BalancesMethod, sender)
+	require.NoError(t, err)
+
+	res, rsp, err := testutil.TracePrecompileCall(
+		unitNetwork.GetContext(), unitNetwork.App.EvmKeeper, precompile, sender, input, 200_000, evmtracers.CallTracer, nil,
+	)
+	require.NoError(t, err)
+	require.False(t, rsp.Failed(), rsp.VmError)
+
+	// the query holds the decoded method without any events or balance changes
+	var frame struct {
+		To         common.Address
+		Precompile evmtracers.PrecompileCall
+	}
+	require.NoError(t, json.Unmarshal(res, &frame))
+	require.Equal(t, precompile.Address(), frame.To)
+	require.Equal(t, bank.BalancesMethod, frame.Precompile.Method)
+	require.Equal(t, sender, common.HexToAddress(frame.Precompile.Inputs["account"].(string)))
+	require.Empty(t, frame.Precompile.Events)
+	require.Empty(t, frame.Precompile.BalanceChanges)
+}
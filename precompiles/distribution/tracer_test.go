@@ -0,0 +1,99 @@
+package distribution_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/kato114/byte/v15/precompiles/distribution"
+	"github.com/kato114/byte/v15/precompiles/testutil"
+	"github.com/kato114/byte/v15/utils"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+)
+
+func (s *PrecompileTestSuite) TestTraceWithdrawDelegatorRewards() {
+	rewards := big.NewInt(1e18)
+
+	testCases := []struct {
+		name      string
+		tracer    string
+		config    json.RawMessage
+		postCheck func(res json.RawMessage)
+	}{
+		{
+			"callTracer - precompile frame with method, events and balance changes",
+			evmtracers.CallTracer,
+			nil,
+			func(res json.RawMessage) {
+				var frame struct {
+					To         common.Address
+					Precompile evmtracers.PrecompileCall
+				}
+				s.Require().NoError(json.Unmarshal(res, &frame))
+				s.Require().Equal(s.precompile.Address(), frame.To)
+				s.Require().Equal(distribution.WithdrawDelegatorRewardsMethod, frame.Precompile.Method)
+				s.Require().Contains(frame.Precompile.Inputs, "delegatorAddress")
+
+				eventTypes := make([]string, 0, len(frame.Precompile.Events))
+				for _, event := range frame.Precompile.Events {
+					eventTypes = append(eventTypes, event.Type)
+				}
+				s.Require().Contains(eventTypes, "withdraw_rewards")
+
+				var found bool
+				for _, change := range frame.Precompile.BalanceChanges {
+					if change.Address == s.address && change.Denom == utils.BaseDenom {
+						s.Require().Equal(rewards, change.Amount.BigInt())
+						found = true
+					}
+				}
+				s.Require().True(found, "expected balance change for the delegator")
+			},
+		},
+		{
+			"prestateTracer - diff mode includes the delegator balance change",
+			evmtracers.PrestateTracer,
+			json.RawMessage(`{"diffMode":true}`),
+			func(res json.RawMessage) {
+				var diff struct {
+					Pre  map[common.Address]struct{ Balance *hexutil.Big }
+					Post map[common.Address]struct{ Balance *hexutil.Big }
+				}
+				s.Require().NoError(json.Unmarshal(res, &diff))
+				s.Require().Contains(diff.Pre, s.address)
+				s.Require().Contains(diff.Post, s.address)
+
+				pre := diff.Pre[s.address].Balance.ToInt()
+				post := diff.Post[s.address].Balance.ToInt()
+				s.Require().Equal(rewards, new(big.Int).Sub(post, pre))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			val, _ := s.app.StakingKeeper.GetValidator(s.ctx, s.validators[0].GetOperator())
+			coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewIntFromBigInt(rewards)))
+			s.app.DistrKeeper.AllocateTokensToValidator(s.ctx, val, sdk.NewDecCoinsFromCoins(coins...))
+
+			input, err := s.precompile.Pack(
+				distribution.WithdrawDelegatorRewardsMethod,
+				s.address,
+				s.validators[0].GetOperator().String(),
+			)
+			s.Require().NoError(err)
+
+			res, rsp, err := testutil.TraceCall(
+				s.ctx, s.app.EvmKeeper, s.address, s.precompile.Address(), input, 200_000, tc.tracer, tc.config,
+			)
+			s.Require().NoError(err)
+			s.Require().False(rsp.Failed(), rsp.VmError)
+
+			tc.postCheck(res)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package erc20_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kato114/byte/v15/precompiles/erc20"
+	"github.com/kato114/byte/v15/precompiles/testutil"
+	erc20types "github.com/kato114/byte/v15/x/erc20/types"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+)
+
+func (s *PrecompileTestSuite) TestTraceTransfer() {
+	s.SetupTest()
+
+	fromAddr := s.keyring.GetKey(0).Addr
+	amount := big.NewInt(100)
+
+	err := s.network.App.BankKeeper.MintCoins(s.network.GetContext(), erc20types.ModuleName, XMPLCoin)
+	s.Require().NoError(err, "failed to mint coins")
+	err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(s.network.GetContext(), erc20types.ModuleName, fromAddr.Bytes(), XMPLCoin)
+	s.Require().NoError(err, "failed to send coins from module to account")
+
+	input, err := s.precompile.Pack(erc20.TransferMethod, toAddr, amount)
+	s.Require().NoError(err)
+
+	res, rsp, err := testutil.TracePrecompileCall(
+		s.network.GetContext(), s.network.App.EvmKeeper, s.precompile, fromAddr, input, 5_000_000, evmtracers.CallTracer, nil,
+	)
+	s.Require().NoError(err)
+	s.Require().False(rsp.Failed(), rsp.VmError)
+
+	var frame struct {
+		To         common.Address
+		Precompile evmtracers.PrecompileCall
+	}
+	s.Require().NoError(json.Unmarshal(res, &frame))
+	s.Require().Equal(s.precompile.Address(), frame.To)
+	s.Require().Equal(erc20.TransferMethod, frame.Precompile.Method)
+	s.Require().Equal(toAddr, common.HexToAddress(frame.Precompile.Inputs["to"].(string)))
+	s.Require().ElementsMatch([]evmtracers.BalanceChange{
+		{Address: fromAddr, Denom: s.tokenDenom, Amount: sdkmath.NewInt(-100)},
+		{Address: toAddr, Denom: s.tokenDenom, Amount: sdkmath.NewInt(100)},
+	}, frame.Precompile.BalanceChanges)
+}
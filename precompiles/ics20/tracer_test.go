@@ -0,0 +1,76 @@
+package ics20_test
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kato114/byte/v15/precompiles/authorization"
+	cmn "github.com/kato114/byte/v15/precompiles/common"
+	"github.com/kato114/byte/v15/precompiles/testutil"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+)
+
+func (s *PrecompileTestSuite) TestTraceApprove() {
+	testCases := []struct {
+		name      string
+		tracer    string
+		postCheck func(res json.RawMessage)
+	}{
+		{
+			"callTracer - precompile frame with method, inputs and events",
+			evmtracers.CallTracer,
+			func(res json.RawMessage) {
+				var frame struct {
+					To         common.Address
+					Precompile evmtracers.PrecompileCall
+				}
+				s.Require().NoError(json.Unmarshal(res, &frame))
+				s.Require().Equal(s.precompile.Address(), frame.To)
+				s.Require().Equal(authorization.ApproveMethod, frame.Precompile.Method)
+				s.Require().Equal(differentAddress, common.HexToAddress(frame.Precompile.Inputs["grantee"].(string)))
+				s.Require().NotEmpty(frame.Precompile.Events)
+				s.Require().Empty(frame.Precompile.BalanceChanges)
+			},
+		},
+		{
+			"4byteTracer - top level precompile selector",
+			evmtracers.FourByteTracer,
+			func(res json.RawMessage) {
+				var ids map[string]int
+				s.Require().NoError(json.Unmarshal(res, &ids))
+				s.Require().Len(ids, 1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path := NewTransferPath(s.chainA, s.chainB)
+			s.coordinator.Setup(path)
+
+			input, err := s.precompile.Pack(
+				authorization.ApproveMethod,
+				differentAddress,
+				[]cmn.ICS20Allocation{
+					{
+						SourcePort:    path.EndpointA.ChannelConfig.PortID,
+						SourceChannel: path.EndpointA.ChannelID,
+						SpendLimit:    defaultCmnCoins,
+						AllowList:     []string{},
+					},
+				},
+			)
+			s.Require().NoError(err)
+
+			res, rsp, err := testutil.TraceCall(
+				s.ctx, s.app.EvmKeeper, s.address, s.precompile.Address(), input, 200_000, tc.tracer, nil,
+			)
+			s.Require().NoError(err)
+			s.Require().False(rsp.Failed(), rsp.VmError)
+
+			tc.postCheck(res)
+		})
+	}
+}
@@ -44,6 +44,7 @@ var f embed.FS
 // the common Precompile type.
 type Precompile struct {
 	cmn.Precompile
+	address common.Address
 	// IBC
 	portID           string
 	channelID        string
@@ -102,8 +103,18 @@ func LoadABI() (abi.ABI, error) {
 	return cmn.LoadABI(f, "abi.json")
 }
 
+// WithAddress sets the address of an outpost instance that doesn't live at the
+// default Osmosis outpost address.
+func (p *Precompile) WithAddress(address common.Address) *Precompile {
+	p.address = address
+	return p
+}
+
 // Address defines the address of the Osmosis outpost precompile contract.
-func (Precompile) Address() common.Address {
+func (p Precompile) Address() common.Address {
+	if p.address != (common.Address{}) {
+		return p.address
+	}
 	return common.HexToAddress(OsmosisOutpostAddress)
 }
 
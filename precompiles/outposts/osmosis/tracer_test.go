@@ -0,0 +1,126 @@
+package osmosis_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kato114/byte/v15/precompiles/outposts/osmosis"
+	"github.com/kato114/byte/v15/precompiles/testutil"
+	commonnetwork "github.com/kato114/byte/v15/testutil/integration/common/network"
+	testutils "github.com/kato114/byte/v15/testutil/integration/evmos/utils"
+	"github.com/kato114/byte/v15/testutil/integration/ibc/coordinator"
+	utiltx "github.com/kato114/byte/v15/testutil/tx"
+	"github.com/kato114/byte/v15/utils"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+)
+
+func (s *PrecompileTestSuite) TestTraceSwap() {
+	s.SetupTest()
+
+	sender := s.keyring.GetAddr(0)
+	input, err := s.precompile.Pack(
+		osmosis.SwapMethod,
+		sender,
+		utiltx.GenerateAddress(),
+		utiltx.GenerateAddress(),
+		big.NewInt(1e18),
+		uint8(10),
+		uint64(20),
+		"osmo1qql8ag4cluz6r4dz28p3w00dnc9w8ueuhnecd2",
+	)
+	s.Require().NoError(err)
+
+	// the call fails on the unregistered input token, so the frame holds the
+	// decoded method without any events or balance changes
+	res, rsp, err := testutil.TracePrecompileCall(
+		s.unitNetwork.GetContext(), s.unitNetwork.App.EvmKeeper, s.precompile, sender, input, 200_000, evmtracers.CallTracer, nil,
+	)
+	s.Require().NoError(err)
+	s.Require().True(rsp.Failed())
+
+	var frame struct {
+		To         common.Address
+		Error      string
+		Precompile evmtracers.PrecompileCall
+	}
+	s.Require().NoError(json.Unmarshal(res, &frame))
+	s.Require().Equal(s.precompile.Address(), frame.To)
+	s.Require().NotEmpty(frame.Error)
+	s.Require().Equal(osmosis.SwapMethod, frame.Precompile.Method)
+	s.Require().Equal(sender, common.HexToAddress(frame.Precompile.Inputs["sender"].(string)))
+	s.Require().Empty(frame.Precompile.Events)
+	s.Require().Empty(frame.Precompile.BalanceChanges)
+}
+
+func (s *PrecompileTestSuite) TestTraceSwapSuccess() {
+	s.SetupTest()
+
+	sender := s.keyring.GetAddr(0)
+	senderAcc := s.keyring.GetAccAddr(0)
+
+	ibcAcc, err := s.grpcHandler.GetAccount(senderAcc.String())
+	s.Require().NoError(err)
+	coordinator := coordinator.NewIntegrationCoordinator(
+		s.T(),
+		[]commonnetwork.Network{s.unitNetwork},
+	)
+	coordinator.SetDefaultSignerForChain(s.unitNetwork.GetChainID(), s.keyring.GetPrivKey(0), ibcAcc)
+	coordinator.Setup(s.unitNetwork.GetChainID(), coordinator.GetDummyChainsIds()[0])
+	s.Require().NoError(coordinator.CommitAll())
+
+	evmosTokenPair, err := testutils.RegisterEvmosERC20Coins(*s.unitNetwork, senderAcc)
+	s.Require().NoError(err, "expected no error during evmos erc20 registration")
+	osmoIbcDenomTrace := utils.ComputeIBCDenomTrace(portID, channelID, osmosis.OsmosisDenom)
+	osmoTokenPair, err := testutils.RegisterIBCERC20Coins(*s.unitNetwork, senderAcc, osmoIbcDenomTrace)
+	s.Require().NoError(err, "expected no error during ibc erc20 registration")
+
+	amount := big.NewInt(1e18)
+	input, err := s.precompile.Pack(
+		osmosis.SwapMethod,
+		sender,
+		osmoTokenPair.GetERC20Contract(),
+		evmosTokenPair.GetERC20Contract(),
+		amount,
+		uint8(10),
+		uint64(20),
+		"osmo1qql8ag4cluz6r4dz28p3w00dnc9w8ueuhnecd2",
+	)
+	s.Require().NoError(err)
+
+	res, rsp, err := testutil.TracePrecompileCall(
+		s.unitNetwork.GetContext(), s.unitNetwork.App.EvmKeeper, s.precompile, sender, input, 200_000, evmtracers.CallTracer, nil,
+	)
+	s.Require().NoError(err)
+	s.Require().False(rsp.Failed(), rsp.VmError)
+
+	var frame struct {
+		To         common.Address
+		Error      string
+		Precompile evmtracers.PrecompileCall
+	}
+	s.Require().NoError(json.Unmarshal(res, &frame))
+	s.Require().Equal(s.precompile.Address(), frame.To)
+	s.Require().Empty(frame.Error)
+	s.Require().Equal(osmosis.SwapMethod, frame.Precompile.Method)
+	s.Require().True(hasEvent(frame.Precompile.Events, transfertypes.EventTypeTransfer), "expected an ibc transfer event")
+
+	// the input tokens are escrowed for the IBC transfer to the Osmosis chain
+	s.Require().Contains(frame.Precompile.BalanceChanges, evmtracers.BalanceChange{
+		Address: sender,
+		Denom:   osmoIbcDenomTrace.IBCDenom(),
+		Amount:  sdkmath.NewIntFromBigInt(amount).Neg(),
+	})
+}
+
+// hasEvent returns true if any of the events is of the given type.
+func hasEvent(events []evmtracers.Event, typ string) bool {
+	for _, event := range events {
+		if event.Type == typ {
+			return true
+		}
+	}
+	return false
+}
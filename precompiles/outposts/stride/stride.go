@@ -30,6 +30,7 @@ var f embed.FS
 
 type Precompile struct {
 	cmn.Precompile
+	address        common.Address
 	portID         string
 	channelID      string
 	timeoutHeight  clienttypes.Height
@@ -75,8 +76,18 @@ func LoadABI() (abi.ABI, error) {
 	return cmn.LoadABI(f, "abi.json")
 }
 
+// WithAddress sets the address of an outpost instance that doesn't live at the
+// default Stride outpost address.
+func (p *Precompile) WithAddress(address common.Address) *Precompile {
+	p.address = address
+	return p
+}
+
 // Address defines the address of the Stride Outpost precompile contract.
-func (Precompile) Address() common.Address {
+func (p Precompile) Address() common.Address {
+	if p.address != (common.Address{}) {
+		return p.address
+	}
 	return common.HexToAddress("0x0000000000000000000000000000000000000900")
 }
 
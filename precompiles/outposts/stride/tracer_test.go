@@ -0,0 +1,109 @@
+package stride_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kato114/byte/v15/precompiles/outposts/stride"
+	"github.com/kato114/byte/v15/precompiles/testutil"
+	"github.com/kato114/byte/v15/utils"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+)
+
+func (s *PrecompileTestSuite) TestTraceLiquidStake() {
+	s.SetupTest()
+
+	denomID := s.app.Erc20Keeper.GetDenomMap(s.ctx, utils.BaseDenom)
+	tokenPair, ok := s.app.Erc20Keeper.GetTokenPair(s.ctx, denomID)
+	s.Require().True(ok, "expected token pair to be found")
+
+	input, err := s.precompile.Pack(
+		stride.LiquidStakeMethod,
+		s.address,
+		common.HexToAddress(tokenPair.Erc20Address),
+		big.NewInt(1e18),
+		"cosmos1xv9tklw7d82sezh9haa573wufgy59vmwe6xxe5",
+	)
+	s.Require().NoError(err)
+
+	// the call fails on the receiver validation, so the frame holds the
+	// decoded method without any events or balance changes
+	res, rsp, err := testutil.TraceCall(
+		s.ctx, s.app.EvmKeeper, s.address, s.precompile.Address(), input, 200_000, evmtracers.CallTracer, nil,
+	)
+	s.Require().NoError(err)
+	s.Require().True(rsp.Failed())
+
+	var frame struct {
+		To         common.Address
+		Error      string
+		Precompile evmtracers.PrecompileCall
+	}
+	s.Require().NoError(json.Unmarshal(res, &frame))
+	s.Require().Equal(s.precompile.Address(), frame.To)
+	s.Require().NotEmpty(frame.Error)
+	s.Require().Equal(stride.LiquidStakeMethod, frame.Precompile.Method)
+	s.Require().Equal(s.address, common.HexToAddress(frame.Precompile.Inputs["sender"].(string)))
+	s.Require().Empty(frame.Precompile.Events)
+	s.Require().Empty(frame.Precompile.BalanceChanges)
+}
+
+func (s *PrecompileTestSuite) TestTraceLiquidStakeSuccess() {
+	s.SetupTest()
+
+	denomID := s.app.Erc20Keeper.GetDenomMap(s.ctx, utils.BaseDenom)
+	tokenPair, ok := s.app.Erc20Keeper.GetTokenPair(s.ctx, denomID)
+	s.Require().True(ok, "expected token pair to be found")
+
+	path := NewTransferPath(s.chainA, s.chainB)
+	s.coordinator.Setup(path)
+
+	amount := big.NewInt(1e18)
+	input, err := s.precompile.Pack(
+		stride.LiquidStakeMethod,
+		s.address,
+		common.HexToAddress(tokenPair.Erc20Address),
+		amount,
+		"stride1rhe5leyt5w0mcwd9rpp93zqn99yktsxvyaqgd0",
+	)
+	s.Require().NoError(err)
+
+	// the active precompile is bound to the mainnet channel, so the suite
+	// precompile is set on the EVM to transfer through the test channel
+	res, rsp, err := testutil.TracePrecompileCall(
+		s.ctx, s.app.EvmKeeper, s.precompile, s.address, input, 200_000, evmtracers.CallTracer, nil,
+	)
+	s.Require().NoError(err)
+	s.Require().False(rsp.Failed(), rsp.VmError)
+
+	var frame struct {
+		To         common.Address
+		Error      string
+		Precompile evmtracers.PrecompileCall
+	}
+	s.Require().NoError(json.Unmarshal(res, &frame))
+	s.Require().Equal(s.precompile.Address(), frame.To)
+	s.Require().Empty(frame.Error)
+	s.Require().Equal(stride.LiquidStakeMethod, frame.Precompile.Method)
+	s.Require().True(hasEvent(frame.Precompile.Events, transfertypes.EventTypeTransfer), "expected an ibc transfer event")
+
+	// the staked tokens are escrowed for the IBC transfer
+	s.Require().Contains(frame.Precompile.BalanceChanges, evmtracers.BalanceChange{
+		Address: s.address,
+		Denom:   utils.BaseDenom,
+		Amount:  sdkmath.NewIntFromBigInt(amount).Neg(),
+	})
+}
+
+// hasEvent returns true if any of the events is of the given type.
+func hasEvent(events []evmtracers.Event, typ string) bool {
+	for _, event := range events {
+		if event.Type == typ {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package testutil
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	evmostypes "github.com/kato114/byte/v15/types"
+	evmkeeper "github.com/kato114/byte/v15/x/evm/keeper"
+	"github.com/kato114/byte/v15/x/evm/statedb"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// TraceCall applies a call with the given input from the sender to the target
+// address using the named tracer of the EVM module, and returns the tracer
+// result together with the execution response.
+func TraceCall(
+	ctx sdk.Context,
+	evmKeeper *evmkeeper.Keeper,
+	from, to common.Address,
+	input []byte,
+	gasLimit uint64,
+	tracerName string,
+	tracerConfig json.RawMessage,
+) (json.RawMessage, *evmtypes.MsgEthereumTxResponse, error) {
+	cfg, err := evmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, evmKeeper.ChainID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tracer, err := evmtracers.New(tracerName, &tracers.Context{}, tracerConfig, cfg.Params.EvmDenom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		&to,
+		evmKeeper.GetNonce(ctx, from),
+		big.NewInt(0),
+		gasLimit,
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		input,
+		nil,
+		false,
+	)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	ctx = ctx.WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(gasLimit))
+
+	res, err := evmKeeper.ApplyMessageWithConfig(ctx, msg, tracer, true, cfg, txConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := tracer.GetResult()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, res, nil
+}
+
+// TracePrecompileCall applies a call with the given input from the sender to
+// the precompile using the named tracer of the EVM module, and returns the
+// tracer result together with the execution response. The precompile is set
+// on the EVM, so it doesn't need to be active on the chain.
+func TracePrecompileCall(
+	ctx sdk.Context,
+	evmKeeper *evmkeeper.Keeper,
+	precompile vm.PrecompiledContract,
+	from common.Address,
+	input []byte,
+	gasLimit uint64,
+	tracerName string,
+	tracerConfig json.RawMessage,
+) (json.RawMessage, *evmtypes.MsgEthereumTxResponse, error) {
+	cfg, err := evmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, evmKeeper.ChainID())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tracer, err := evmtracers.New(tracerName, &tracers.Context{}, tracerConfig, cfg.Params.EvmDenom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	to := precompile.Address()
+	msg := ethtypes.NewMessage(
+		from,
+		&to,
+		evmKeeper.GetNonce(ctx, from),
+		big.NewInt(0),
+		gasLimit,
+		big.NewInt(0),
+		big.NewInt(0),
+		big.NewInt(0),
+		input,
+		nil,
+		false,
+	)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	ctx = ctx.WithGasMeter(evmostypes.NewInfiniteGasMeterWithLimit(gasLimit))
+
+	stateDB := statedb.New(ctx, evmKeeper, txConfig)
+	evm := evmKeeper.NewEVM(ctx, msg, cfg, tracer, stateDB)
+	evm.WithPrecompiles(map[common.Address]vm.PrecompiledContract{to: precompile}, []common.Address{to})
+
+	tracer.CaptureTxStart(gasLimit)
+	ret, leftoverGas, vmErr := evm.Call(vm.AccountRef(from), to, input, gasLimit, big.NewInt(0))
+	tracer.CaptureTxEnd(leftoverGas)
+
+	res := &evmtypes.MsgEthereumTxResponse{Ret: ret, GasUsed: gasLimit - leftoverGas}
+	if vmErr != nil {
+		res.VmError = vmErr.Error()
+	}
+
+	result, err := tracer.GetResult()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, res, nil
+}
@@ -0,0 +1,74 @@
+package vesting_test
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kato114/byte/v15/precompiles/testutil"
+	"github.com/kato114/byte/v15/precompiles/vesting"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	vestingtypes "github.com/kato114/byte/v15/x/vesting/types"
+)
+
+func (s *PrecompileTestSuite) TestTraceCreateClawbackVestingAccount() {
+	testCases := []struct {
+		name      string
+		tracer    string
+		postCheck func(res json.RawMessage)
+	}{
+		{
+			"callTracer - precompile frame with method, inputs and events",
+			evmtracers.CallTracer,
+			func(res json.RawMessage) {
+				var frame struct {
+					To         common.Address
+					Precompile evmtracers.PrecompileCall
+				}
+				s.Require().NoError(json.Unmarshal(res, &frame))
+				s.Require().Equal(s.precompile.Address(), frame.To)
+				s.Require().Equal(vesting.CreateClawbackVestingAccountMethod, frame.Precompile.Method)
+				s.Require().Equal(funderAddr, common.HexToAddress(frame.Precompile.Inputs["funderAddress"].(string)))
+				s.Require().Equal(s.address, common.HexToAddress(frame.Precompile.Inputs["vestingAddress"].(string)))
+
+				eventTypes := make([]string, 0, len(frame.Precompile.Events))
+				for _, event := range frame.Precompile.Events {
+					eventTypes = append(eventTypes, event.Type)
+				}
+				s.Require().Contains(eventTypes, vestingtypes.EventTypeCreateClawbackVestingAccount)
+				s.Require().Empty(frame.Precompile.BalanceChanges)
+			},
+		},
+		{
+			"prestateTracer - includes the vesting account",
+			evmtracers.PrestateTracer,
+			func(res json.RawMessage) {
+				var pre map[common.Address]json.RawMessage
+				s.Require().NoError(json.Unmarshal(res, &pre))
+				s.Require().Contains(pre, s.address)
+				s.Require().Contains(pre, s.precompile.Address())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			input, err := s.precompile.Pack(
+				vesting.CreateClawbackVestingAccountMethod,
+				funderAddr,
+				s.address,
+				false,
+			)
+			s.Require().NoError(err)
+
+			res, rsp, err := testutil.TraceCall(
+				s.ctx, s.app.EvmKeeper, s.address, s.precompile.Address(), input, 200_000, tc.tracer, nil,
+			)
+			s.Require().NoError(err)
+			s.Require().False(rsp.Failed(), rsp.VmError)
+
+			tc.postCheck(res)
+		})
+	}
+}
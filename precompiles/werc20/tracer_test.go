@@ -0,0 +1,61 @@
+package werc20_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kato114/byte/v15/precompiles/erc20"
+	"github.com/kato114/byte/v15/precompiles/testutil"
+	"github.com/kato114/byte/v15/precompiles/werc20"
+	testkeyring "github.com/kato114/byte/v15/testutil/integration/evmos/keyring"
+	"github.com/kato114/byte/v15/testutil/integration/evmos/network"
+	utiltx "github.com/kato114/byte/v15/testutil/tx"
+	"github.com/kato114/byte/v15/utils"
+	erc20types "github.com/kato114/byte/v15/x/erc20/types"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceTransfer(t *testing.T) {
+	keyring := testkeyring.New(1)
+	unitNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+
+	tokenPair := erc20types.NewTokenPair(utiltx.GenerateAddress(), utils.BaseDenom, erc20types.OWNER_MODULE)
+	precompile, err := werc20.NewPrecompile(
+		tokenPair,
+		unitNetwork.App.BankKeeper,
+		unitNetwork.App.AuthzKeeper,
+		unitNetwork.App.TransferKeeper,
+	)
+	require.NoError(t, err)
+
+	sender := keyring.GetAddr(0)
+	receiver := utiltx.GenerateAddress()
+	input, err := precompile.Pack(erc20.TransferMethod, receiver, big.NewInt(1000))
+	require.NoError(t, err)
+
+	res, rsp, err := testutil.TracePrecompileCall(
+		unitNetwork.GetContext(), unitNetwork.App.EvmKeeper, precompile, sender, input, 5_000_000, evmtracers.CallTracer, nil,
+	)
+	require.NoError(t, err)
+	require.False(t, rsp.Failed(), rsp.VmError)
+
+	// the transfer of the wrapped native coin holds its balance changes
+	var frame struct {
+		To         common.Address
+		Precompile evmtracers.PrecompileCall
+	}
+	require.NoError(t, json.Unmarshal(res, &frame))
+	require.Equal(t, precompile.Address(), frame.To)
+	require.Equal(t, erc20.TransferMethod, frame.Precompile.Method)
+	require.Equal(t, receiver, common.HexToAddress(frame.Precompile.Inputs["to"].(string)))
+	require.ElementsMatch(t, []evmtracers.BalanceChange{
+		{Address: sender, Denom: utils.BaseDenom, Amount: sdkmath.NewInt(-1000)},
+		{Address: receiver, Denom: utils.BaseDenom, Amount: sdkmath.NewInt(1000)},
+	}, frame.Precompile.BalanceChanges)
+}
@@ -18,7 +18,7 @@ import (
 )
 
 // abiPath defines the path to the WERC-20 precompile ABI JSON file.
-const abiPath = "./abi.json"
+const abiPath = "abi.json"
 
 // Embed abi json file to the executable binary. Needed when importing as dependency.
 //
@@ -11,14 +11,19 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/kato114/byte/v15/rpc/backend"
+	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/admin"
+	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/bundler"
 	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/debug"
 	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/eth"
 	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/eth/filters"
 	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/miner"
 	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/net"
+	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/ots"
 	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/personal"
+	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/trace"
 	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/txpool"
 	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/web3"
+	"github.com/kato114/byte/v15/server/config"
 	"github.com/kato114/byte/v15/types"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
@@ -39,6 +44,10 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	OtsNamespace      = "ots"
+	AdminNamespace    = "admin"
+	BundlerNamespace  = "bundler"
 
 	apiVersion = "1.0"
 )
@@ -115,12 +124,18 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
@@ -157,6 +172,98 @@ func init() {
 				},
 			}
 		},
+		TraceNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: TraceNamespace,
+					Version:   apiVersion,
+					Service:   trace.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		OtsNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: OtsNamespace,
+					Version:   apiVersion,
+					Service:   ots.NewAPI(ctx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		AdminNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+			return []rpc.API{
+				{
+					Namespace: AdminNamespace,
+					Version:   apiVersion,
+					Service:   admin.NewAPI(ctx, clientCtx),
+					Public:    false,
+				},
+			}
+		},
+		BundlerNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			// a misconfigured bundler is skipped, as the invalid namespaces,
+			// instead of stopping the node
+			appConf, err := config.GetConfig(ctx.Viper)
+			if err != nil {
+				ctx.Logger.Error("failed to read the bundler config, the namespace is skipped", "error", err.Error())
+				return nil
+			}
+
+			cfg, err := bundler.NewConfig(appConf.JSONRPC, clientCtx.Keyring)
+			if err != nil {
+				ctx.Logger.Error("invalid bundler config, the namespace is skipped", "error", err.Error())
+				return nil
+			}
+
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			b, err := bundler.NewBundler(ctx.Logger, evmBackend, cfg)
+			if err != nil {
+				ctx.Logger.Error("failed to create the bundler, the namespace is skipped", "error", err.Error())
+				return nil
+			}
+			b.Start()
+
+			apis := []rpc.API{
+				{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   bundler.NewPublicAPI(ctx.Logger, b),
+					Public:    true,
+				},
+			}
+
+			// the methods managing the mempool are served as the admin namespace
+			if !appConf.JSONRPC.IsLocalOrAuthenticated() {
+				ctx.Logger.Info("bundler methods are only served on localhost addresses if the authentication isn't configured")
+				return apis
+			}
+
+			return append(apis, rpc.API{
+				Namespace: BundlerNamespace,
+				Version:   apiVersion,
+				Service:   bundler.NewAPI(ctx.Logger, b),
+				Public:    false,
+			})
+		},
 	}
 }
 
@@ -0,0 +1,22 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRPCAPIsMisconfiguredBundler(t *testing.T) {
+	// the bundler requires a keyring, the namespace is skipped without it
+	ctx := server.NewDefaultContext()
+
+	var namespaces []string
+	require.NotPanics(t, func() {
+		for _, api := range GetRPCAPIs(ctx, client.Context{}, nil, false, nil, []string{BundlerNamespace, Web3Namespace}) {
+			namespaces = append(namespaces, api.Namespace)
+		}
+	})
+	require.Equal(t, []string{Web3Namespace}, namespaces)
+}
@@ -0,0 +1,207 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// AllowAll is the allowlist entry that allows all the namespaces and
+	// methods.
+	AllowAll = "*"
+
+	// maxRequestContentLength is the max size of the request body read to check
+	// the methods, as enforced by the JSON-RPC server
+	maxRequestContentLength = 1024 * 1024 * 5
+)
+
+// Allowlist is the set of JSON-RPC namespaces and methods allowed to a client.
+type Allowlist struct {
+	all        bool
+	namespaces map[string]bool
+	methods    map[string]bool
+}
+
+// NewAllowlist creates an allowlist from its entries, which are either a
+// namespace (eg. `eth`), a method (eg. `debug_traceTransaction`) or `*` to
+// allow everything. An empty allowlist allows nothing.
+func NewAllowlist(entries []string) *Allowlist {
+	a := &Allowlist{
+		namespaces: make(map[string]bool),
+		methods:    make(map[string]bool),
+	}
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		switch {
+		case entry == AllowAll:
+			a.all = true
+		case strings.Contains(entry, "_"):
+			a.methods[entry] = true
+		case entry != "":
+			a.namespaces[entry] = true
+		}
+	}
+
+	return a
+}
+
+// Allowed returns true if the method is allowed.
+func (a *Allowlist) Allowed(method string) bool {
+	if a.all || a.methods[method] {
+		return true
+	}
+
+	namespace, _, found := strings.Cut(method, "_")
+	return found && a.namespaces[namespace]
+}
+
+// AllowedAll returns the first method that is not allowed, or true if all the
+// methods are allowed.
+func (a *Allowlist) AllowedAll(methods []string) (string, bool) {
+	for _, method := range methods {
+		if !a.Allowed(method) {
+			return method, false
+		}
+	}
+	return "", true
+}
+
+// Call is the part of a call of a JSON-RPC request read by the handlers.
+type Call struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// IsCall returns true if the message is a call expecting a response, unlike
+// the notifications.
+func (c Call) IsCall() bool {
+	return len(c.ID) > 0 && c.Method != ""
+}
+
+// Request is a JSON-RPC HTTP request parsed by the first handler reading it,
+// and carried by the request context to the next ones.
+type Request struct {
+	// Messages are the messages of the request, one per call of a batch
+	Messages []json.RawMessage
+	// Calls are the calls decoded from the messages
+	Calls []Call
+	// Batch is true if the request is a batch
+	Batch bool
+
+	// err is the error parsing the messages or decoding their calls
+	err error
+}
+
+// ParseRequest parses the body of a JSON-RPC request or batch. The error of
+// the body that can't be parsed is returned by Methods.
+func ParseRequest(body []byte) *Request {
+	msgs, batch, err := RequestMessages(body)
+	if err != nil {
+		return &Request{Batch: batch, err: err}
+	}
+
+	req := &Request{
+		Messages: msgs,
+		Calls:    make([]Call, len(msgs)),
+		Batch:    batch,
+	}
+	for i, msg := range msgs {
+		if err := json.Unmarshal(msg, &req.Calls[i]); err != nil && req.err == nil {
+			req.err = fmt.Errorf("invalid request %d: %w", i, err)
+		}
+	}
+	return req
+}
+
+// Methods returns the methods called by the request. Returns an error if the
+// request can't be parsed or the method of one of its calls can't be read.
+func (req *Request) Methods() ([]string, error) {
+	if req.err != nil {
+		return nil, req.err
+	}
+
+	methods := make([]string, len(req.Calls))
+	for i, call := range req.Calls {
+		methods[i] = call.Method
+	}
+	return methods, nil
+}
+
+// ReadRequest returns the parsed JSON-RPC HTTP request, and the HTTP request
+// carrying it in its context for the next handlers. The body is only read and
+// parsed by the first handler, and restored for the JSON-RPC server.
+func ReadRequest(r *http.Request) (*Request, *http.Request, error) {
+	if req, ok := RequestFromContext(r.Context()); ok {
+		return req, r, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength))
+	if err != nil {
+		return nil, r, err
+	}
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+	req := ParseRequest(body)
+	return req, r.WithContext(ContextWithRequest(r.Context(), req)), nil
+}
+
+// RequestMethods returns the methods called by the JSON-RPC request or batch.
+// Returns an error if the request can't be parsed or the method of one of its
+// calls can't be read.
+func RequestMethods(body []byte) ([]string, error) {
+	return ParseRequest(body).Methods()
+}
+
+// RequestMessages returns the messages of the JSON-RPC request or batch, and
+// whether it is a batch. The body is read as the JSON-RPC server reads it: only
+// its first JSON value is decoded, the bytes after it being ignored, and the
+// messages of a batch are split one at a time.
+func RequestMessages(body []byte) ([]json.RawMessage, bool, error) {
+	var raw json.RawMessage
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&raw); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, false, errors.New("empty request")
+		}
+		return nil, false, err
+	}
+
+	if !isBatch(raw) {
+		return []json.RawMessage{raw}, false, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil { // skip '['
+		return nil, true, err
+	}
+
+	var msgs []json.RawMessage
+	for dec.More() {
+		var msg json.RawMessage
+		if err := dec.Decode(&msg); err != nil {
+			return nil, true, err
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, true, nil
+}
+
+// isBatch returns true when the first non-whitespace character is '[', as
+// github.com/ethereum/go-ethereum/rpc/json.go does.
+func isBatch(raw []byte) bool {
+	for _, c := range raw {
+		// skip insignificant whitespace (http://www.ietf.org/rfc/rfc4627.txt)
+		if c == 0x20 || c == 0x09 || c == 0x0a || c == 0x0d {
+			continue
+		}
+		return c == '['
+	}
+	return false
+}
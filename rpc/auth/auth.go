@@ -0,0 +1,267 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kato114/byte/v15/server/config"
+)
+
+const (
+	// HeaderAPIKey is the HTTP header carrying the API key.
+	HeaderAPIKey = "X-API-Key"
+
+	// CodeUnauthorized is the JSON-RPC error code of the requests with missing
+	// or invalid credentials.
+	CodeUnauthorized = -32001
+	// CodeInvalidRequest is the JSON-RPC error code of the requests that can't
+	// be read.
+	CodeInvalidRequest = -32600
+	// CodeMethodNotFound is the JSON-RPC error code of the requests calling a
+	// method that isn't allowed, as for the methods that don't exist.
+	CodeMethodNotFound = -32601
+)
+
+// clientContextKey is the context key of the authenticated client.
+type clientContextKey struct{}
+
+// requestContextKey is the context key of the parsed JSON-RPC request.
+type requestContextKey struct{}
+
+// ErrUnauthorized is returned for the requests with missing or invalid
+// credentials.
+var ErrUnauthorized = errors.New("unauthorized")
+
+// Client is an authenticated JSON-RPC client.
+type Client struct {
+	// Name identifies the client: the API key name, `jwt` or `public`.
+	Name string
+	// Authenticated is false for the public client.
+	Authenticated bool
+	// Allowlist is the set of namespaces and methods allowed to the client.
+	Allowlist *Allowlist
+}
+
+// APIKey is an entry of the API keys file.
+type APIKey struct {
+	Name  string   `json:"name"`
+	Key   string   `json:"key"`
+	Allow []string `json:"allow"`
+}
+
+// Authenticator authenticates the JSON-RPC requests with a JWT signed with the
+// shared secret (HS256) or a static API key, and checks the called methods
+// against the allowlist of the client. The requests without credentials are
+// served as the public client.
+type Authenticator struct {
+	jwtSecret []byte
+	jwt       *Client
+	apiKeys   []APIKey
+	clients   map[string]*Client
+	public    *Client
+}
+
+// NewAuthenticator creates the authenticator from the JSON-RPC config, returns
+// nil if neither a JWT secret nor an API keys file is set.
+func NewAuthenticator(cfg config.JSONRPCConfig) (*Authenticator, error) {
+	if cfg.AuthJWTSecret == "" && cfg.AuthKeysFile == "" {
+		return nil, nil
+	}
+
+	a := &Authenticator{
+		clients: make(map[string]*Client),
+		public:  &Client{Name: "public", Allowlist: NewAllowlist(cfg.AuthPublicAllow)},
+	}
+
+	if cfg.AuthJWTSecret != "" {
+		secret, err := LoadJWTSecret(cfg.AuthJWTSecret)
+		if err != nil {
+			return nil, err
+		}
+		a.jwtSecret = secret
+		a.jwt = &Client{Name: "jwt", Authenticated: true, Allowlist: NewAllowlist(cfg.AuthJWTAllow)}
+	}
+
+	if cfg.AuthKeysFile != "" {
+		keys, err := LoadAPIKeys(cfg.AuthKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		a.apiKeys = keys
+		for _, key := range keys {
+			a.clients[key.Name] = &Client{Name: key.Name, Authenticated: true, Allowlist: NewAllowlist(key.Allow)}
+		}
+	}
+
+	return a, nil
+}
+
+// LoadJWTSecret loads the hex encoded 32 bytes JWT secret from the file.
+func LoadJWTSecret(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path) // #nosec G304 -- path set by the node operator
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT secret: %w", err)
+	}
+
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT secret: %w", err)
+	}
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("invalid JWT secret length, expect: 32, got: %d", len(secret))
+	}
+	return secret, nil
+}
+
+// LoadAPIKeys loads the API keys from the JSON file, a list of entries with the
+// name of the client, its key and the namespaces and methods allowed to it.
+func LoadAPIKeys(path string) ([]APIKey, error) {
+	bz, err := os.ReadFile(path) // #nosec G304 -- path set by the node operator
+	if err != nil {
+		return nil, fmt.Errorf("failed to read API keys: %w", err)
+	}
+
+	var keys []APIKey
+	if err := json.Unmarshal(bz, &keys); err != nil {
+		return nil, fmt.Errorf("invalid API keys file: %w", err)
+	}
+
+	names := make(map[string]bool)
+	values := make(map[string]bool)
+	for i, key := range keys {
+		switch {
+		case key.Name == "":
+			return nil, fmt.Errorf("API key %d has no name", i)
+		case key.Key == "":
+			return nil, fmt.Errorf("API key %s is empty", key.Name)
+		case names[key.Name]:
+			return nil, fmt.Errorf("repeated API key name '%s'", key.Name)
+		case values[key.Key]:
+			return nil, fmt.Errorf("API key %s is repeated", key.Name)
+		}
+		names[key.Name] = true
+		values[key.Key] = true
+	}
+
+	return keys, nil
+}
+
+// Authenticate returns the client of the request. The JWT is read from the
+// `Authorization: Bearer` header and the API key from the `X-API-Key` header.
+// The credentials are never read from the URL, as it ends up in the access
+// logs of the node and of the proxies.
+func (a *Authenticator) Authenticate(r *http.Request) (*Client, error) {
+	token := strings.TrimSpace(r.Header.Get("Authorization"))
+	if token != "" {
+		scheme, value, found := strings.Cut(token, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") {
+			return nil, fmt.Errorf("%w: unsupported authorization scheme", ErrUnauthorized)
+		}
+		token = strings.TrimSpace(value)
+	}
+
+	if token != "" {
+		if a.jwtSecret == nil {
+			return nil, fmt.Errorf("%w: JWT authentication is disabled", ErrUnauthorized)
+		}
+		if _, err := verifyJWT(token, a.jwtSecret, time.Now()); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnauthorized, err.Error())
+		}
+		return a.jwt, nil
+	}
+
+	if apiKey := r.Header.Get(HeaderAPIKey); apiKey != "" {
+		for _, key := range a.apiKeys {
+			if subtle.ConstantTimeCompare([]byte(apiKey), []byte(key.Key)) == 1 {
+				return a.clients[key.Name], nil
+			}
+		}
+		return nil, fmt.Errorf("%w: invalid API key", ErrUnauthorized)
+	}
+
+	return a.public, nil
+}
+
+// Handler returns the HTTP handler authenticating the JSON-RPC requests and
+// checking the called methods before serving them with the next handler, which
+// gets the client from the request context.
+func (a *Authenticator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, err := a.Authenticate(r)
+		if err != nil {
+			WriteError(w, http.StatusUnauthorized, CodeUnauthorized, err.Error())
+			return
+		}
+
+		req, r, err := ReadRequest(r)
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+		methods, err := req.Methods()
+		if err != nil {
+			WriteError(w, http.StatusBadRequest, CodeInvalidRequest, err.Error())
+			return
+		}
+		if method, ok := client.Allowlist.AllowedAll(methods); !ok {
+			WriteError(w, http.StatusForbidden, CodeMethodNotFound, MethodNotAllowedError(method).Error())
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ContextWithClient(r.Context(), client)))
+	})
+}
+
+// ContextWithClient returns a copy of the context carrying the client.
+func ContextWithClient(ctx context.Context, client *Client) context.Context {
+	return context.WithValue(ctx, clientContextKey{}, client)
+}
+
+// ClientFromContext returns the client carried by the context, if any.
+func ClientFromContext(ctx context.Context) (*Client, bool) {
+	client, ok := ctx.Value(clientContextKey{}).(*Client)
+	return client, ok
+}
+
+// ContextWithRequest returns a copy of the context carrying the parsed
+// JSON-RPC request.
+func ContextWithRequest(ctx context.Context, req *Request) context.Context {
+	return context.WithValue(ctx, requestContextKey{}, req)
+}
+
+// RequestFromContext returns the parsed JSON-RPC request carried by the
+// context, if any.
+func RequestFromContext(ctx context.Context) (*Request, bool) {
+	req, ok := ctx.Value(requestContextKey{}).(*Request)
+	return req, ok
+}
+
+// MethodNotAllowedError returns the error of a method that isn't allowed,
+// worded as for the methods that don't exist.
+func MethodNotAllowedError(method string) error {
+	return fmt.Errorf("the method %s does not exist/is not available", method)
+}
+
+// WriteError writes a JSON-RPC error response with the HTTP status.
+func WriteError(w http.ResponseWriter, status, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{ // #nosec G703
+		"jsonrpc": "2.0",
+		"id":      nil,
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": msg,
+		},
+	})
+}
@@ -0,0 +1,370 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kato114/byte/v15/server/config"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// signJWT returns a JWT of the claims signed with the secret
+func signJWT(t *testing.T, alg string, claims map[string]interface{}, secret []byte) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestAllowlist(t *testing.T) {
+	testCases := []struct {
+		name    string
+		entries []string
+		method  string
+		expPass bool
+	}{
+		{"empty allowlist", nil, "eth_call", false},
+		{"allow all", []string{AllowAll}, "debug_traceTransaction", true},
+		{"namespace", []string{"eth", "net"}, "eth_call", true},
+		{"namespace not allowed", []string{"eth", "net"}, "debug_traceTransaction", false},
+		{"method", []string{"eth", "debug_traceTransaction"}, "debug_traceTransaction", true},
+		{"method not allowed", []string{"eth", "debug_traceTransaction"}, "debug_traceBlockByNumber", false},
+		{"namespace prefix", []string{"eth"}, "ethx_call", false},
+		{"no namespace", []string{"eth"}, "eth", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPass, NewAllowlist(tc.entries).Allowed(tc.method))
+		})
+	}
+}
+
+func TestRequestMethods(t *testing.T) {
+	methods, err := RequestMethods([]byte(`{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"eth_call"}, methods)
+
+	methods, err = RequestMethods([]byte(` [{"method":"eth_call"},{"method":"debug_traceTransaction"}]`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"eth_call", "debug_traceTransaction"}, methods)
+
+	// only the first value is read, as the JSON-RPC server ignores the bytes
+	// after it
+	methods, err = RequestMethods([]byte(`[{"method":"eth_call"},{"method":"debug_traceTransaction"}]x`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"eth_call", "debug_traceTransaction"}, methods)
+
+	_, err = RequestMethods([]byte(`[{"method":"eth_call"},1]`))
+	require.Error(t, err)
+
+	_, err = RequestMethods([]byte(`[{"method":"eth_call"},{"method":1}]`))
+	require.Error(t, err)
+
+	_, err = RequestMethods([]byte(`{"method":`))
+	require.Error(t, err)
+
+	_, err = RequestMethods([]byte(" "))
+	require.Error(t, err)
+}
+
+func TestReadRequest(t *testing.T) {
+	body := `[{"jsonrpc":"2.0","id":1,"method":"eth_call"},{"jsonrpc":"2.0","method":"eth_blockNumber"}]`
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+
+	req, r, err := ReadRequest(r)
+	require.NoError(t, err)
+	require.True(t, req.Batch)
+	require.Len(t, req.Messages, 2)
+	require.True(t, req.Calls[0].IsCall())
+	require.False(t, req.Calls[1].IsCall())
+	methods, err := req.Methods()
+	require.NoError(t, err)
+	require.Equal(t, []string{"eth_call", "eth_blockNumber"}, methods)
+
+	// the body is restored for the JSON-RPC server
+	bz, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	require.Equal(t, body, string(bz))
+
+	// the next handlers get the request parsed from the context, without
+	// reading the body again
+	next, _, err := ReadRequest(r)
+	require.NoError(t, err)
+	require.Same(t, req, next)
+
+	// the error of a request that can't be parsed is returned with the methods
+	r = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"method":`))
+	req, _, err = ReadRequest(r)
+	require.NoError(t, err)
+	_, err = req.Methods()
+	require.Error(t, err)
+}
+
+func TestVerifyJWT(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		name    string
+		token   string
+		expPass bool
+	}{
+		{
+			"pass - issued now",
+			signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix()}, testSecret),
+			true,
+		},
+		{
+			"pass - issued within the clock skew",
+			signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix() - 30}, testSecret),
+			true,
+		},
+		{
+			"pass - valid time claims",
+			signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix(), "nbf": now.Unix(), "exp": now.Unix() + 60}, testSecret),
+			true,
+		},
+		{
+			"fail - no claims",
+			signJWT(t, "HS256", map[string]interface{}{}, testSecret),
+			false,
+		},
+		{
+			"fail - no issued-at",
+			signJWT(t, "HS256", map[string]interface{}{"exp": now.Unix() + 60}, testSecret),
+			false,
+		},
+		{
+			"fail - stale",
+			signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix() - 120}, testSecret),
+			false,
+		},
+		{
+			"fail - malformed token",
+			"abc.def",
+			false,
+		},
+		{
+			"fail - unsupported algorithm",
+			signJWT(t, "HS512", map[string]interface{}{"iat": now.Unix()}, testSecret),
+			false,
+		},
+		{
+			"fail - wrong secret",
+			signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix()}, []byte("wrong secret")),
+			false,
+		},
+		{
+			"fail - expired",
+			signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix(), "exp": now.Unix() - 120}, testSecret),
+			false,
+		},
+		{
+			"fail - not valid yet",
+			signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix(), "nbf": now.Unix() + 120}, testSecret),
+			false,
+		},
+		{
+			"fail - issued in the future",
+			signJWT(t, "HS256", map[string]interface{}{"iat": now.Unix() + 120}, testSecret),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := verifyJWT(tc.token, testSecret, now)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func setupAuthenticator(t *testing.T) *Authenticator {
+	dir := t.TempDir()
+
+	secretFile := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, os.WriteFile(secretFile, []byte("0x"+hex.EncodeToString(testSecret)+"\n"), 0o600))
+
+	keys, err := json.Marshal([]APIKey{
+		{Name: "internal", Key: "internal-key", Allow: []string{AllowAll}},
+		{Name: "partner", Key: "partner-key", Allow: []string{"eth", "debug_traceTransaction"}},
+	})
+	require.NoError(t, err)
+	keysFile := filepath.Join(dir, "keys.json")
+	require.NoError(t, os.WriteFile(keysFile, keys, 0o600))
+
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.AuthJWTSecret = secretFile
+	cfg.AuthJWTAllow = []string{"eth", "debug"}
+	cfg.AuthKeysFile = keysFile
+	cfg.AuthPublicAllow = []string{"eth", "net"}
+
+	a, err := NewAuthenticator(*cfg)
+	require.NoError(t, err)
+	require.NotNil(t, a)
+	return a
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	a, err := NewAuthenticator(*config.DefaultJSONRPCConfig())
+	require.NoError(t, err)
+	require.Nil(t, a)
+
+	dir := t.TempDir()
+	testCases := []struct {
+		name   string
+		secret string
+		keys   string
+	}{
+		{"short secret", "0x0102", ""},
+		{"invalid secret", "not hex", ""},
+		{"invalid keys file", "", `{"name":"internal"}`},
+		{"unnamed key", "", `[{"key":"abc","allow":["eth"]}]`},
+		{"empty key", "", `[{"name":"internal","allow":["eth"]}]`},
+		{"repeated name", "", `[{"name":"internal","key":"abc"},{"name":"internal","key":"def"}]`},
+		{"repeated key", "", `[{"name":"internal","key":"abc"},{"name":"partner","key":"abc"}]`},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.DefaultJSONRPCConfig()
+			if tc.secret != "" {
+				cfg.AuthJWTSecret = filepath.Join(dir, "jwt"+string(rune('a'+i)))
+				require.NoError(t, os.WriteFile(cfg.AuthJWTSecret, []byte(tc.secret), 0o600))
+			}
+			if tc.keys != "" {
+				cfg.AuthKeysFile = filepath.Join(dir, "keys"+string(rune('a'+i)))
+				require.NoError(t, os.WriteFile(cfg.AuthKeysFile, []byte(tc.keys), 0o600))
+			}
+
+			_, err := NewAuthenticator(*cfg)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	a := setupAuthenticator(t)
+	token := signJWT(t, "HS256", map[string]interface{}{"iat": time.Now().Unix()}, testSecret)
+
+	testCases := []struct {
+		name     string
+		malleate func(r *http.Request)
+		expName  string
+		expPass  bool
+	}{
+		{"public", func(r *http.Request) {}, "public", true},
+		{"jwt header", func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) }, "jwt", true},
+		{"api key header", func(r *http.Request) { r.Header.Set(HeaderAPIKey, "partner-key") }, "partner", true},
+		// the credentials of the URL are ignored
+		{"jwt query", func(r *http.Request) { r.URL.RawQuery = "token=" + token }, "public", true},
+		{"api key query", func(r *http.Request) { r.URL.RawQuery = "apikey=internal-key" }, "public", true},
+		{"invalid jwt", func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token+"x") }, "", false},
+		{"unsupported scheme", func(r *http.Request) { r.Header.Set("Authorization", "Basic abc") }, "", false},
+		{"invalid api key", func(r *http.Request) { r.Header.Set(HeaderAPIKey, "unknown-key") }, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			tc.malleate(r)
+
+			client, err := a.Authenticate(r)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expName, client.Name)
+			} else {
+				require.ErrorIs(t, err, ErrUnauthorized)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	a := setupAuthenticator(t)
+
+	var served string
+	handler := a.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bz, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		served = string(bz)
+
+		_, ok := RequestFromContext(r.Context())
+		require.True(t, ok, "expected the parsed request in the context")
+	}))
+
+	testCases := []struct {
+		name      string
+		apiKey    string
+		body      string
+		expStatus int
+	}{
+		{"public allowed", "", `{"method":"eth_blockNumber"}`, http.StatusOK},
+		{"public not allowed", "", `{"method":"debug_traceTransaction"}`, http.StatusForbidden},
+		{"partner allowed method", "partner-key", `{"method":"debug_traceTransaction"}`, http.StatusOK},
+		{"partner batch not allowed", "partner-key", `[{"method":"eth_call"},{"method":"personal_sign"}]`, http.StatusForbidden},
+		{"internal allowed", "internal-key", `[{"method":"eth_call"},{"method":"personal_sign"}]`, http.StatusOK},
+		{"invalid api key", "unknown-key", `{"method":"eth_blockNumber"}`, http.StatusUnauthorized},
+		{"unparsable request", "", `{"method":`, http.StatusBadRequest},
+		{"public trailing bytes", "", `[{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber","params":["latest"]}]x`, http.StatusForbidden},
+		{"public invalid call", "", `[{"jsonrpc":"2.0","id":1,"method":"personal_sign","params":[]},1]`, http.StatusBadRequest},
+		{"public allowed trailing bytes", "", `[{"method":"eth_blockNumber"}]x`, http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			served = ""
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			if tc.apiKey != "" {
+				r.Header.Set(HeaderAPIKey, tc.apiKey)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, r)
+			require.Equal(t, tc.expStatus, w.Code)
+			if tc.expStatus == http.StatusOK {
+				// the next handler reads the whole body
+				require.Equal(t, tc.body, served)
+				return
+			}
+
+			require.Empty(t, served)
+			var res struct {
+				Error struct {
+					Code int `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			switch tc.expStatus {
+			case http.StatusForbidden:
+				require.Equal(t, CodeMethodNotFound, res.Error.Code)
+			case http.StatusBadRequest:
+				require.Equal(t, CodeInvalidRequest, res.Error.Code)
+			default:
+				require.Equal(t, CodeUnauthorized, res.Error.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package auth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// jwtClockSkew is the clock difference tolerated when checking the time claims
+// of a JWT. As for the engine API of geth, the tokens must be issued within it.
+const jwtClockSkew = 60 * time.Second
+
+// verifyJWT verifies the HS256 signature and the time claims of the token, and
+// returns its claims. The `iat` claim is required, and must be within
+// jwtClockSkew of now.
+func verifyJWT(token string, secret []byte, now time.Time) (*jwt.RegisteredClaims, error) {
+	// the time claims are checked below, against now and with the clock skew
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithoutClaimsValidation())
+
+	var claims jwt.RegisteredClaims
+	if _, err := parser.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case claims.IssuedAt == nil:
+		return nil, errors.New("missing issued-at")
+	case now.Sub(claims.IssuedAt.Time) > jwtClockSkew:
+		return nil, errors.New("stale token")
+	case claims.IssuedAt.Time.Sub(now) > jwtClockSkew:
+		return nil, errors.New("token is issued in the future")
+	case claims.ExpiresAt != nil && now.After(claims.ExpiresAt.Add(jwtClockSkew)):
+		return nil, errors.New("token is expired")
+	case claims.NotBefore != nil && now.Before(claims.NotBefore.Add(-jwtClockSkew)):
+		return nil, errors.New("token is not valid yet")
+	}
+
+	return &claims, nil
+}
@@ -12,9 +12,12 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	rpctypes "github.com/kato114/byte/v15/rpc/types"
 	evmtypes "github.com/kato114/byte/v15/x/evm/types"
 	"github.com/pkg/errors"
@@ -76,15 +79,18 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	for i, key := range storageKeys {
 		hexKey := common.HexToHash(key)
-		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.StateKey(address, hexKey.Bytes()))
+		stateKey := evmtypes.StateKey(address, hexKey.Bytes())
+		valueBz, proof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, stateKey)
 		if err != nil {
 			return nil, err
 		}
 
+		storeProof := GetStoreProof(evmtypes.StoreKey, stateKey, valueBz, proof)
 		storageProofs[i] = rpctypes.StorageResult{
-			Key:   key,
-			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: GetHexProofs(proof),
+			Key:        key,
+			Value:      (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
+			Proof:      GetHexProofs(proof),
+			StoreProof: &storeProof,
 		}
 	}
 
@@ -100,7 +106,25 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	// query account proofs
 	accountKey := authtypes.AddressStoreKey(sdk.AccAddress(address.Bytes()))
-	_, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	accountBz, proof, err := b.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// query the proofs of the evm params, holding the evm denom, and of the
+	// balance of the evm denom
+	paramsBz, paramsProof, err := b.queryClient.GetProof(clientCtx, evmtypes.StoreKey, evmtypes.KeyPrefixParams)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := b.queryClient.Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	balanceKey := banktypes.CreatePrefixedAccountStoreKey(address.Bytes(), []byte(params.Params.EvmDenom))
+	balanceBz, balanceProof, err := b.queryClient.GetProof(clientCtx, banktypes.StoreKey, balanceKey)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +142,12 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 		Nonce:        hexutil.Uint64(res.Nonce),
 		StorageHash:  common.Hash{}, // NOTE: Evmos doesn't have a storage hash. TODO: implement?
 		StorageProof: storageProofs,
+		StoreProofs: &rpctypes.AccountStoreProofs{
+			Height:  hexutil.Uint64(height), //#nosec G701 -- height is positive, checked by the proof queries
+			Account: GetStoreProof(authtypes.StoreKey, accountKey, accountBz, proof),
+			Params:  GetStoreProof(evmtypes.StoreKey, evmtypes.KeyPrefixParams, paramsBz, paramsProof),
+			Balance: GetStoreProof(banktypes.StoreKey, balanceKey, balanceBz, balanceProof),
+		},
 	}, nil
 }
 
@@ -142,6 +172,68 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 	return value.Bytes(), nil
 }
 
+// AccountRange returns a page of the ethereum accounts at the given block
+// number, starting from the given address. The next page starts from the
+// returned next key, which is nil once all the accounts are returned.
+func (b *Backend) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	noCode, noStorage bool,
+) (state.IteratorDump, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+
+	if maxResults <= 0 || maxResults > MaxAccountRangeResults {
+		maxResults = MaxAccountRangeResults
+	}
+
+	req := &evmtypes.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{
+			Key:   start,
+			Limit: uint64(maxResults),
+		},
+		NoCode:    noCode,
+		NoStorage: noStorage,
+	}
+
+	res, err := b.queryClient.AccountRange(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+
+	dump := state.IteratorDump{
+		Accounts: make(map[common.Address]state.DumpAccount, len(res.Accounts)),
+	}
+	for _, acct := range res.Accounts {
+		address := common.HexToAddress(acct.Address)
+		dumpAccount := state.DumpAccount{
+			Balance:  acct.Balance,
+			Nonce:    acct.Nonce,
+			CodeHash: common.HexToHash(acct.CodeHash).Bytes(),
+			Code:     acct.Code,
+			Address:  &address,
+		}
+
+		if len(acct.Storage) > 0 {
+			dumpAccount.Storage = make(map[common.Hash]string, len(acct.Storage))
+			for _, s := range acct.Storage {
+				dumpAccount.Storage[common.HexToHash(s.Key)] = s.Value
+			}
+		}
+
+		dump.Accounts[address] = dumpAccount
+	}
+
+	if res.Pagination != nil && len(res.Pagination.NextKey) > 0 {
+		dump.Next = res.Pagination.NextKey
+	}
+
+	return dump, nil
+}
+
 // GetBalance returns the provided account's balance up to the provided block number.
 func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error) {
 	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
@@ -6,9 +6,12 @@ import (
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/kato114/byte/v15/rpc/backend/mocks"
@@ -147,6 +150,21 @@ func (suite *BackendTestSuite) TestGetProof() {
 					authtypes.AddressStoreKey(sdk.AccAddress(address1.Bytes())),
 					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
 				)
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					evmtypes.KeyPrefixParams,
+					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
+				RegisterParamsWithoutHeader(queryClient, bn.Int64())
+				RegisterABCIQueryWithOptions(
+					client,
+					bn.Int64(),
+					"store/bank/key",
+					banktypes.CreatePrefixedAccountStoreKey(address1.Bytes(), []byte(evmtypes.DefaultEVMDenom)),
+					tmrpcclient.ABCIQueryOptions{Height: iavlHeight, Prove: true},
+				)
 			},
 			true,
 			&rpctypes.AccountResult{
@@ -161,6 +179,33 @@ func (suite *BackendTestSuite) TestGetProof() {
 						Key:   "0x0",
 						Value: (*hexutil.Big)(big.NewInt(2)),
 						Proof: []string{""},
+						StoreProof: &rpctypes.StoreProof{
+							StoreKey: evmtypes.StoreKey,
+							Key:      evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
+							Value:    []byte{2},
+							ProofOps: []rpctypes.StoreProofOp{},
+						},
+					},
+				},
+				StoreProofs: &rpctypes.AccountStoreProofs{
+					Height: 4,
+					Account: rpctypes.StoreProof{
+						StoreKey: authtypes.StoreKey,
+						Key:      authtypes.AddressStoreKey(sdk.AccAddress(address1.Bytes())),
+						Value:    []byte{2},
+						ProofOps: []rpctypes.StoreProofOp{},
+					},
+					Params: rpctypes.StoreProof{
+						StoreKey: evmtypes.StoreKey,
+						Key:      evmtypes.KeyPrefixParams,
+						Value:    []byte{2},
+						ProofOps: []rpctypes.StoreProofOp{},
+					},
+					Balance: rpctypes.StoreProof{
+						StoreKey: banktypes.StoreKey,
+						Key:      banktypes.CreatePrefixedAccountStoreKey(address1.Bytes(), []byte(evmtypes.DefaultEVMDenom)),
+						Value:    []byte{2},
+						ProofOps: []rpctypes.StoreProofOp{},
 					},
 				},
 			},
@@ -245,6 +290,106 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestAccountRange() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	addr := utiltx.GenerateAddress()
+	codeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	start := hexutil.Bytes(addr.Bytes())
+	nextKey := utiltx.GenerateAddress().Bytes()
+
+	accounts := []evmtypes.AccountDump{
+		{
+			Address:  addr.Hex(),
+			Balance:  "1000",
+			Nonce:    2,
+			CodeHash: codeHash.Hex(),
+			Storage:  []evmtypes.State{evmtypes.NewState(common.HexToHash("0x1"), common.HexToHash("0x2"))},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		blockNrOrHash rpctypes.BlockNumberOrHash
+		maxResults    int
+		registerMock  func()
+		expPass       bool
+		expDump       state.IteratorDump
+	}{
+		{
+			"fail - BlockHash and BlockNumber are both nil",
+			rpctypes.BlockNumberOrHash{},
+			1,
+			func() {},
+			false,
+			state.IteratorDump{},
+		},
+		{
+			"fail - query client errors on getting accounts",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			1,
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountRangeError(queryClient, &evmtypes.QueryAccountRangeRequest{
+					Pagination: &query.PageRequest{Key: start, Limit: 1},
+				})
+			},
+			false,
+			state.IteratorDump{},
+		},
+		{
+			"pass - max results capped",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			0,
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountRange(queryClient, &evmtypes.QueryAccountRangeRequest{
+					Pagination: &query.PageRequest{Key: start, Limit: MaxAccountRangeResults},
+				}, nil, nil)
+			},
+			true,
+			state.IteratorDump{Accounts: map[common.Address]state.DumpAccount{}},
+		},
+		{
+			"pass",
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			1,
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterAccountRange(queryClient, &evmtypes.QueryAccountRangeRequest{
+					Pagination: &query.PageRequest{Key: start, Limit: 1},
+				}, accounts, nextKey)
+			},
+			true,
+			state.IteratorDump{
+				Accounts: map[common.Address]state.DumpAccount{
+					addr: {
+						Balance:  "1000",
+						Nonce:    2,
+						CodeHash: codeHash.Bytes(),
+						Storage:  map[common.Hash]string{common.HexToHash("0x1"): common.HexToHash("0x2").Hex()},
+						Address:  &addr,
+					},
+				},
+				Next: nextKey,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.registerMock()
+
+			dump, err := suite.backend.AccountRange(tc.blockNrOrHash, start, tc.maxResults, false, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expDump, dump)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
 
@@ -15,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -88,6 +89,7 @@ type EVMBackend interface {
 	GetStorageAt(address common.Address, key string, blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error)
 	GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error)
 	GetTransactionCount(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Uint64, error)
+	AccountRange(blockNrOrHash rpctypes.BlockNumberOrHash, start hexutil.Bytes, maxResults int, noCode, noStorage bool) (state.IteratorDump, error)
 
 	// Chain Info
 	ChainID() (*hexutil.Big, error)
@@ -102,33 +104,63 @@ type EVMBackend interface {
 
 	// Tx Info
 	GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error)
+	GetRawTransaction(txHash common.Hash) (hexutil.Bytes, error)
 	GetTxByEthHash(txHash common.Hash) (*evmostypes.TxResult, error)
 	GetTxByTxIndex(height int64, txIndex uint) (*evmostypes.TxResult, error)
+	GetTxHashesByAddress(address common.Address, roles []evmostypes.AddressRole, height int64, reverse bool, limit int) ([]common.Hash, bool, error)
+	GetTransactionsByAddress(address common.Address, args rpctypes.AddressHistoryArgs) (*rpctypes.AddressHistory, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
+	GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 
+	// Tx Pool
+	TxPoolContent() (map[common.Address]TxPoolTxs, map[common.Address]TxPoolTxs, error)
+	TxPoolContentFrom(address common.Address) (TxPoolTxs, TxPoolTxs, error)
+	TxPoolStatus() (uint64, uint64, error)
+
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
-	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts evmtypes.SimulateOpts, blockNrOrHash rpctypes.BlockNumberOrHash) ([]*evmtypes.SimBlockResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
+	LogIndexRange() (int64, int64, error)
+	GetLogPositions(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]evmostypes.LogPosition, error)
 
 	// Tracing
 	TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error)
 	TraceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *evmtypes.TraceCallConfig) (interface{}, error)
+	StorageRangeAt(blockHash common.Hash, txIndex int, address common.Address, keyStart hexutil.Bytes, maxResult int) (rpctypes.StorageRangeResult, error)
 }
 
 var _ BackendI = (*Backend)(nil)
 
+const (
+	// DefaultAddressHistoryLimit is the default number of txs of a page of
+	// `eth_getTransactionsByAddress`.
+	DefaultAddressHistoryLimit = 100
+	// MaxAddressHistoryLimit is the max number of txs of a page of
+	// `eth_getTransactionsByAddress`.
+	MaxAddressHistoryLimit = 1000
+	// MaxAccountRangeResults is the max number of accounts of a page of
+	// `debug_accountRange`.
+	MaxAccountRangeResults = 256
+	// MaxUnconfirmedTxs is the number of unconfirmed txs requested from the
+	// mempool, the max number that CometBFT returns.
+	MaxUnconfirmedTxs = 100
+)
+
 // Backend implements the BackendI interface
 type Backend struct {
 	ctx                 context.Context
@@ -139,6 +171,7 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             evmostypes.EVMTxIndexer
+	gasOracle           *gasPriceOracle
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -168,5 +201,6 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		gasOracle:           sharedGasPriceOracle(ctx),
 	}
 }
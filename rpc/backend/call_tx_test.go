@@ -220,7 +220,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client, &unconfirmedTxsLimit)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -250,7 +250,7 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterEstimateGas(queryClient, callArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
-				RegisterUnconfirmedTxsEmpty(client, nil)
+				RegisterUnconfirmedTxsEmpty(client, &unconfirmedTxsLimit)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -406,11 +406,17 @@ func (suite *BackendTestSuite) TestDoCall() {
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
 
+	balance := gasPrice
+	overrides := rpctypes.StateOverride{toAddr: {Balance: &balance}}
+	overridesBz, err := json.Marshal(overrides)
+	suite.Require().NoError(err)
+
 	testCases := []struct {
 		name         string
 		registerMock func()
 		blockNum     rpctypes.BlockNumber
 		callArgs     evmtypes.TransactionArgs
+		overrides    *rpctypes.StateOverride
 		expEthTx     *evmtypes.MsgEthereumTxResponse
 		expPass      bool
 	}{
@@ -425,6 +431,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
 			&evmtypes.MsgEthereumTxResponse{},
 			false,
 		},
@@ -439,6 +446,26 @@ func (suite *BackendTestSuite) TestDoCall() {
 			},
 			rpctypes.BlockNumber(1),
 			callArgs,
+			nil,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
+		{
+			"pass - Returned transaction response with state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{
+					Args:           argsBz,
+					ChainId:        suite.backend.chainID.Int64(),
+					StateOverrides: overridesBz,
+				})
+			},
+			rpctypes.BlockNumber(1),
+			callArgs,
+			&overrides,
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
@@ -449,7 +476,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, tc.overrides)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -460,6 +487,109 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSimulateV1() {
+	from := common.BytesToAddress(suite.acc)
+	opts := evmtypes.SimulateOpts{
+		BlockStateCalls: []evmtypes.SimBlock{
+			{Calls: []evmtypes.TransactionArgs{{From: &from, To: &from}}},
+		},
+	}
+	optsBz, err := json.Marshal(&opts)
+	suite.Require().NoError(err)
+
+	blockNr := rpctypes.BlockNumber(1)
+	req := &evmtypes.QuerySimulateV1Request{
+		Opts:        optsBz,
+		GasCap:      suite.backend.RPCGasCap(),
+		BlockNumber: 1,
+		ChainId:     9000,
+		BlockMaxGas: -1,
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    []*evmtypes.SimBlockResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - consensus params error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParamsError(client, 1)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - simulate error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterSimulateV1Error(queryClient, req)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - simulated blocks",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterSimulateV1(queryClient, req)
+			},
+			[]*evmtypes.SimBlockResult{
+				{
+					Number:  2,
+					GasUsed: 21000,
+					Calls: []evmtypes.SimCallResult{
+						{
+							ReturnData: hexutil.Bytes{},
+							Logs:       []*ethtypes.Log{},
+							GasUsed:    21000,
+							Status:     1,
+						},
+					},
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.SimulateV1(opts, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
@@ -106,10 +106,15 @@ func (b *Backend) PendingTransactions() ([]*sdk.Tx, error) {
 		return nil, errors.New("invalid rpc client")
 	}
 
-	res, err := mc.UnconfirmedTxs(b.ctx, nil)
+	// CometBFT returns 30 txs without limit
+	limit := MaxUnconfirmedTxs
+	res, err := mc.UnconfirmedTxs(b.ctx, &limit)
 	if err != nil {
 		return nil, err
 	}
+	if res.Total > len(res.Txs) {
+		b.logger.Debug("unconfirmed txs truncated", "returned", len(res.Txs), "total", res.Total)
+	}
 
 	result := make([]*sdk.Tx, 0, len(res.Txs))
 	for _, txBz := range res.Txs {
@@ -245,15 +250,23 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
-// SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// SuggestGasTipCap returns the suggested tip cap from the gas price oracle,
+// sampling the effective tips of the txs in the latest blocks. If there are no
+// txs to sample, we return a positive value to help client to mitigate the base
+// fee changes.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
 		return big.NewInt(0), nil
 	}
 
+	tip, err := b.suggestTipCap()
+	if err != nil {
+		b.logger.Debug("gas price oracle failed to sample the tips", "error", err.Error())
+	} else if tip != nil {
+		return tip, nil
+	}
+
 	params, err := b.queryClient.FeeMarket.Params(b.ctx, &feemarkettypes.QueryParamsRequest{})
 	if err != nil {
 		return nil, err
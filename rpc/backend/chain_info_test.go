@@ -3,16 +3,21 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"sync"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"google.golang.org/grpc/metadata"
 
+	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cometbft/cometbft/abci/types"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/kato114/byte/v15/rpc/backend/mocks"
 	rpc "github.com/kato114/byte/v15/rpc/types"
@@ -286,6 +291,142 @@ func (suite *BackendTestSuite) TestSuggestGasTipCap() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSuggestGasTipCapOracle() {
+	baseFee := sdk.NewInt(1)
+
+	// registerBlock registers the block 1 with txs paying the given tips
+	registerBlock := func(tips ...int64) {
+		var header metadata.MD
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterParams(queryClient, &header, 1)
+		RegisterBaseFee(queryClient, baseFee)
+
+		txs := make([]tmtypes.Tx, len(tips))
+		txResults := make([]*types.ResponseDeliverTx, len(tips))
+		for i, tip := range tips {
+			msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:  suite.backend.chainID,
+				Nonce:    uint64(i),
+				To:       &common.Address{},
+				Amount:   big.NewInt(0),
+				GasLimit: 100000,
+				GasPrice: new(big.Int).Add(baseFee.BigInt(), big.NewInt(tip)),
+			})
+			txs[i] = suite.signAndEncodeEthTx(msg)
+			txResults[i] = &types.ResponseDeliverTx{Code: 0, GasUsed: 21000}
+		}
+		_, err := RegisterBlockMultipleTxs(client, 1, txs)
+		suite.Require().NoError(err)
+		client.On("BlockResults", rpc.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+			Return(&tmrpctypes.ResultBlockResults{Height: 1, TxsResults: txResults}, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expGasTipCap *big.Int
+	}{
+		{
+			"pass - percentile of the lowest tips of the block",
+			func() {
+				registerBlock(40, 10, 30, 20)
+			},
+			big.NewInt(20),
+		},
+		{
+			"pass - highest percentile",
+			func() {
+				suite.backend.cfg.JSONRPC.GPOPercentile = 100
+				registerBlock(40, 10, 30, 20)
+			},
+			big.NewInt(30),
+		},
+		{
+			"pass - tips below the ignore price",
+			func() {
+				suite.backend.cfg.JSONRPC.GPOIgnorePrice = 15
+				registerBlock(40, 10, 30, 20)
+			},
+			big.NewInt(30),
+		},
+		{
+			"pass - capped by the max price",
+			func() {
+				suite.backend.cfg.JSONRPC.GPOPercentile = 100
+				suite.backend.cfg.JSONRPC.GPOMaxPrice = 25
+				registerBlock(40, 10, 30, 20)
+			},
+			big.NewInt(25),
+		},
+		{
+			"pass - no tx, fall back to the max base fee delta",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+				registerBlock()
+			},
+			big.NewInt(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			tipCap, err := suite.backend.SuggestGasTipCap(baseFee.BigInt())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGasTipCap, tipCap)
+
+			// cached for the block
+			tipCap, err = suite.backend.SuggestGasTipCap(baseFee.BigInt())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expGasTipCap, tipCap)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSuggestGasTipCapSharedOracle() {
+	suite.SetupTest()
+
+	// the backends of a node share the oracle
+	ctx := server.NewDefaultContext()
+	suite.Require().Same(sharedGasPriceOracle(ctx), sharedGasPriceOracle(ctx))
+	suite.Require().NotSame(sharedGasPriceOracle(ctx), sharedGasPriceOracle(server.NewDefaultContext()))
+
+	baseFee := sdk.NewInt(1)
+	var header metadata.MD
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParams(queryClient, &header, 1)
+	RegisterBaseFee(queryClient, baseFee)
+	_, err := RegisterBlockMultipleTxs(client, 1, nil)
+	suite.Require().NoError(err)
+	client.On("BlockResults", rpc.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+		Return(&tmrpctypes.ResultBlockResults{Height: 1}, nil)
+
+	// the concurrent requests sample the head block once
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := suite.backend.suggestTipCap()
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		suite.Require().NoError(err)
+	}
+
+	client.AssertNumberOfCalls(suite.T(), "Block", 1)
+	client.AssertNumberOfCalls(suite.T(), "BlockResults", 1)
+}
+
 func (suite *BackendTestSuite) TestGlobalMinGasPrice() {
 	testCases := []struct {
 		name           string
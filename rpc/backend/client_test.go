@@ -58,6 +58,9 @@ func RegisterBroadcastTxError(client *mocks.Client, tx types.Tx) {
 }
 
 // Unconfirmed Transactions
+// unconfirmedTxsLimit is the limit of the unconfirmed txs requested by the backend
+var unconfirmedTxsLimit = MaxUnconfirmedTxs
+
 func RegisterUnconfirmedTxs(client *mocks.Client, limit *int, txs []types.Tx) {
 	client.On("UnconfirmedTxs", rpc.ContextWithHeight(1), limit).
 		Return(&tmrpctypes.ResultUnconfirmedTxs{Txs: txs}, nil)
@@ -75,6 +78,16 @@ func RegisterUnconfirmedTxsError(client *mocks.Client, limit *int) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterNumUnconfirmedTxs(client *mocks.Client, total int) {
+	client.On("NumUnconfirmedTxs", rpc.ContextWithHeight(1)).
+		Return(&tmrpctypes.ResultUnconfirmedTxs{Count: total, Total: total}, nil)
+}
+
+func RegisterNumUnconfirmedTxsError(client *mocks.Client) {
+	client.On("NumUnconfirmedTxs", rpc.ContextWithHeight(1)).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Status
 func RegisterStatus(client *mocks.Client) {
 	client.On("Status", rpc.ContextWithHeight(1)).
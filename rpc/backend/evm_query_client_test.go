@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/kato114/byte/v15/rpc/backend/mocks"
 	rpc "github.com/kato114/byte/v15/rpc/types"
@@ -64,6 +65,60 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", rpc.ContextWithHeight(req.BlockNumber), req).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(req.BlockNumber), req).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// SimulateV1
+func RegisterSimulateV1(queryClient *mocks.EVMQueryClient, req *evmtypes.QuerySimulateV1Request) {
+	data := []byte(`[{"number":"0x2","gasUsed":"0x5208","calls":[{"returnData":"0x","logs":[],"gasUsed":"0x5208","status":"0x1"}]}]`)
+	queryClient.On("SimulateV1", mock.Anything, req).
+		Return(&evmtypes.QuerySimulateV1Response{Data: data}, nil)
+}
+
+func RegisterSimulateV1Error(queryClient *mocks.EVMQueryClient, req *evmtypes.QuerySimulateV1Request) {
+	queryClient.On("SimulateV1", mock.Anything, req).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// StorageRangeAt
+func RegisterStorageRangeAt(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryStorageRangeAtRequest) {
+	queryClient.On("StorageRangeAt", rpc.ContextWithHeight(1), req).
+		Return(&evmtypes.QueryStorageRangeAtResponse{
+			Storage: []evmtypes.State{
+				evmtypes.NewState(common.HexToHash("0x1"), common.HexToHash("0x2")),
+			},
+			NextKey: common.HexToHash("0x3").Hex(),
+		}, nil)
+}
+
+func RegisterStorageRangeAtError(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryStorageRangeAtRequest) {
+	queryClient.On("StorageRangeAt", rpc.ContextWithHeight(1), req).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// AccountRange
+func RegisterAccountRange(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryAccountRangeRequest, accounts []evmtypes.AccountDump, nextKey []byte) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(1), req).
+		Return(&evmtypes.QueryAccountRangeResponse{
+			Accounts:   accounts,
+			Pagination: &query.PageResponse{NextKey: nextKey},
+		}, nil)
+}
+
+func RegisterAccountRangeError(queryClient *mocks.EVMQueryClient, req *evmtypes.QueryAccountRangeRequest) {
+	queryClient.On("AccountRange", rpc.ContextWithHeight(1), req).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
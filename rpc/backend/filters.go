@@ -5,6 +5,7 @@ package backend
 import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmostypes "github.com/kato114/byte/v15/types"
 	"github.com/pkg/errors"
 )
 
@@ -36,3 +37,27 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// LogIndexRange returns the first and last blocks covered by the log index of
+// the EVM indexer, returns -1 if the log index is not available.
+func (b *Backend) LogIndexRange() (int64, int64, error) {
+	if b.indexer == nil {
+		return -1, -1, nil
+	}
+	return b.indexer.LogIndexRange()
+}
+
+// GetLogPositions returns the positions of the logs between the from and to
+// blocks that match the addresses and topics criteria, from the log index of
+// the EVM indexer. The lookup stops once more than limit positions match.
+func (b *Backend) GetLogPositions(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+	limit int,
+) ([]evmostypes.LogPosition, error) {
+	if b.indexer == nil {
+		return nil, errors.New("log index requires the EVM indexer to be enabled")
+	}
+	return b.indexer.GetLogPositions(from, to, addresses, topics, limit)
+}
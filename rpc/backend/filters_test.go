@@ -3,9 +3,12 @@ package backend
 import (
 	"encoding/json"
 
+	dbm "github.com/cometbft/cometbft-db"
+	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kato114/byte/v15/indexer"
 	"github.com/kato114/byte/v15/rpc/backend/mocks"
 	ethrpc "github.com/kato114/byte/v15/rpc/types"
 	evmtypes "github.com/kato114/byte/v15/x/evm/types"
@@ -120,3 +123,54 @@ func (suite *BackendTestSuite) TestBloomStatus() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestLogIndexRange() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		expFirst int64
+		expLast  int64
+	}{
+		{
+			"pass - indexer disabled",
+			func() { suite.backend.indexer = nil },
+			-1,
+			-1,
+		},
+		{
+			"pass - log index disabled",
+			func() {
+				suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			},
+			-1,
+			-1,
+		},
+		{
+			"pass - log index empty",
+			func() {
+				kvIndexer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+				kvIndexer.SetLogIndex(true)
+				suite.backend.indexer = kvIndexer
+			},
+			-1,
+			-1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			tc.malleate()
+			first, last, err := suite.backend.LogIndexRange()
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFirst, first)
+			suite.Require().Equal(tc.expLast, last)
+
+			if suite.backend.indexer == nil {
+				_, err := suite.backend.GetLogPositions(1, 1, []common.Address{{}}, nil, 10)
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
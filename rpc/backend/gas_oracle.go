@@ -0,0 +1,199 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package backend
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"golang.org/x/sync/singleflight"
+
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+)
+
+// gpoSampleNumber is the number of the lowest tips sampled in each block, as
+// in geth, so that a few high tips can't drive the suggestion.
+const gpoSampleNumber = 3
+
+var (
+	gasOraclesMu sync.Mutex
+	// gasOracles holds the oracle shared by the backends of each node
+	gasOracles = make(map[*server.Context]*gasPriceOracle)
+)
+
+// gasPriceOracle caches the tips sampled from each block and the tip suggested
+// for the latest block. The cache is guarded by the mutex, while the blocks are
+// sampled outside of it, once per head for all the concurrent requests.
+type gasPriceOracle struct {
+	group     singleflight.Group
+	mu        sync.Mutex
+	lastHead  int64
+	lastTip   *big.Int             // nil if no tip was sampled
+	blockTips map[int64][]*big.Int // sampled tips per block height
+}
+
+func newGasPriceOracle() *gasPriceOracle {
+	return &gasPriceOracle{
+		lastHead:  -1,
+		blockTips: make(map[int64][]*big.Int),
+	}
+}
+
+// sharedGasPriceOracle returns the oracle of the node with the given server
+// context, so that the backends of all the namespaces share the same cache.
+func sharedGasPriceOracle(ctx *server.Context) *gasPriceOracle {
+	gasOraclesMu.Lock()
+	defer gasOraclesMu.Unlock()
+
+	oracle, ok := gasOracles[ctx]
+	if !ok {
+		oracle = newGasPriceOracle()
+		gasOracles[ctx] = oracle
+	}
+	return oracle
+}
+
+// cachedTip returns the tip suggested for the given head, if cached.
+func (o *gasPriceOracle) cachedTip(head int64) (*big.Int, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if head != o.lastHead {
+		return nil, false
+	}
+	return copyBig(o.lastTip), true
+}
+
+// suggestTipCap returns the tip at the configured percentile of the effective
+// tips sampled from the latest blocks, capped by the max price. The txs with a
+// tip below the ignore price are left out. Returns nil if no tip was sampled.
+func (b *Backend) suggestTipCap() (*big.Int, error) {
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	headHeight := int64(head) //#nosec G701 -- checked for int overflow already
+
+	oracle := b.gasOracle
+	if tip, ok := oracle.cachedTip(headHeight); ok {
+		return tip, nil
+	}
+
+	// the concurrent requests for the same head wait for a single sampling
+	res, err, _ := oracle.group.Do(strconv.FormatInt(headHeight, 10), func() (interface{}, error) {
+		return b.sampleTipCap(headHeight)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyBig(res.(*big.Int)), nil
+}
+
+// sampleTipCap samples the blocks up to the given head that aren't cached yet
+// and caches the tip suggested for it.
+func (b *Backend) sampleTipCap(headHeight int64) (*big.Int, error) {
+	oracle := b.gasOracle
+
+	fromHeight := headHeight - int64(b.cfg.JSONRPC.GPOBlocks) + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	var samples []*big.Int
+	for height := headHeight; height >= fromHeight; height-- {
+		oracle.mu.Lock()
+		tips, ok := oracle.blockTips[height]
+		oracle.mu.Unlock()
+
+		if !ok {
+			var err error
+			tips, err = b.sampleBlockTips(height)
+			if err != nil {
+				return nil, err
+			}
+
+			oracle.mu.Lock()
+			oracle.blockTips[height] = tips
+			oracle.mu.Unlock()
+		}
+		samples = append(samples, tips...)
+	}
+
+	var tip *big.Int
+	if len(samples) > 0 {
+		sort.Slice(samples, func(i, j int) bool {
+			return samples[i].Cmp(samples[j]) < 0
+		})
+		tip = samples[(len(samples)-1)*b.cfg.JSONRPC.GPOPercentile/100]
+
+		maxPrice := big.NewInt(b.cfg.JSONRPC.GPOMaxPrice)
+		if maxPrice.Sign() > 0 && tip.Cmp(maxPrice) > 0 {
+			tip = maxPrice
+		}
+	}
+
+	oracle.mu.Lock()
+	defer oracle.mu.Unlock()
+
+	// a newer head may have been sampled meanwhile
+	if headHeight < oracle.lastHead {
+		return tip, nil
+	}
+
+	// drop the blocks out of the sampled range
+	for height := range oracle.blockTips {
+		if height < fromHeight || height > headHeight {
+			delete(oracle.blockTips, height)
+		}
+	}
+
+	oracle.lastHead = headHeight
+	oracle.lastTip = tip
+	return tip, nil
+}
+
+// sampleBlockTips returns the lowest effective tips of the ethereum txs of the
+// block that aren't below the ignore price.
+func (b *Backend) sampleBlockTips(height int64) ([]*big.Int, error) {
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block not found for height %d", height)
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	ignorePrice := big.NewInt(b.cfg.JSONRPC.GPOIgnorePrice)
+	tips := make([]*big.Int, 0, gpoSampleNumber)
+	for _, tx := range b.blockGasAndRewards(resBlock, blockRes, baseFee) {
+		if tx.reward.Cmp(ignorePrice) < 0 {
+			continue
+		}
+		tips = append(tips, tx.reward)
+		if len(tips) == gpoSampleNumber {
+			break
+		}
+	}
+	return tips, nil
+}
+
+func copyBig(n *big.Int) *big.Int {
+	if n == nil {
+		return nil
+	}
+	return new(big.Int).Set(n)
+}
@@ -11,7 +11,10 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	evmostypes "github.com/kato114/byte/v15/types"
 	evmtypes "github.com/kato114/byte/v15/x/evm/types"
 	"github.com/pkg/errors"
 )
@@ -26,6 +29,121 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		return nil, err
 	}
 
+	traceTxRequest, err := b.replayTxRequest(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if config != nil {
+		traceTxRequest.TraceConfig = config
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := transaction.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), traceTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	err = json.Unmarshal(traceResult.Data, &decodedResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// StorageRangeAt returns the range of the contract storage right after the
+// transaction of the given index within the block, starting from the given
+// key. The predecessors are replayed in the same way as for tracing the
+// transaction.
+func (b *Backend) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	address common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	if txIndex < 0 {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("invalid transaction index %d", txIndex)
+	}
+	if maxResult < 0 {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("invalid max result %d", maxResult)
+	}
+
+	blk, err := b.TendermintBlockByHash(blockHash)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if blk == nil || blk.Block == nil {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("block %s not found", blockHash.Hex())
+	}
+
+	transaction, err := b.GetTxByTxIndex(blk.Block.Height, uint(txIndex))
+	if err != nil {
+		b.logger.Debug("tx not found", "height", blk.Block.Height, "index", txIndex)
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	replayRequest, err := b.replayTxRequest(transaction)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	req := &evmtypes.QueryStorageRangeAtRequest{
+		Msg:             replayRequest.Msg,
+		Predecessors:    replayRequest.Predecessors,
+		BlockNumber:     replayRequest.BlockNumber,
+		BlockHash:       replayRequest.BlockHash,
+		BlockTime:       replayRequest.BlockTime,
+		ProposerAddress: replayRequest.ProposerAddress,
+		ChainId:         replayRequest.ChainId,
+		BlockMaxGas:     replayRequest.BlockMaxGas,
+		Address:         address.Hex(),
+		StartKey:        keyStart,
+		MaxResults:      uint64(maxResult),
+	}
+
+	// minus one to get the context of block beginning
+	contextHeight := transaction.Height - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+	res, err := b.queryClient.StorageRangeAt(rpctypes.ContextWithHeight(contextHeight), req)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	result := rpctypes.StorageRangeResult{
+		Storage: make(rpctypes.StorageMap, len(res.Storage)),
+	}
+	for _, s := range res.Storage {
+		key := common.HexToHash(s.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(s.Value),
+		}
+	}
+
+	if res.NextKey != "" {
+		nextKey := common.HexToHash(res.NextKey)
+		result.NextKey = &nextKey
+	}
+
+	return result, nil
+}
+
+// replayTxRequest returns the request replaying the predecessors of the
+// transaction within its block, followed by the transaction itself.
+func (b *Backend) replayTxRequest(transaction *evmostypes.TxResult) (*evmtypes.QueryTraceTxRequest, error) {
 	// check if block number is 0
 	if transaction.Height == 0 {
 		return nil, errors.New("genesis is not traceable")
@@ -43,7 +161,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 	}
 	txsLen := uint32(len(blk.Block.Txs)) // #nosec G701 -- checked for int overflow already
 	if txsLen < transaction.TxIndex {
-		b.logger.Debug("tx index out of bounds", "index", transaction.TxIndex, "hash", hash.String(), "height", blk.Block.Height)
+		b.logger.Debug("tx index out of bounds", "index", transaction.TxIndex, "height", blk.Block.Height)
 		return nil, fmt.Errorf("transaction not included in block %v", blk.Block.Height)
 	}
 
@@ -66,7 +184,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 
 	tx, err := b.clientCtx.TxConfig.TxDecoder()(blk.Block.Txs[transaction.TxIndex])
 	if err != nil {
-		b.logger.Debug("tx not found", "hash", hash)
+		b.logger.Debug("failed to decode transaction in block", "height", blk.Block.Height, "error", err.Error())
 		return nil, err
 	}
 
@@ -96,7 +214,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		return nil, err
 	}
 
-	traceTxRequest := evmtypes.QueryTraceTxRequest{
+	return &evmtypes.QueryTraceTxRequest{
 		Msg:             ethMessage,
 		Predecessors:    predecessors,
 		BlockNumber:     blk.Block.Height,
@@ -105,32 +223,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
-	}
-
-	if config != nil {
-		traceTxRequest.TraceConfig = config
-	}
-
-	// minus one to get the context of block beginning
-	contextHeight := transaction.Height - 1
-	if contextHeight < 1 {
-		// 0 is a special value in `ContextWithHeight`
-		contextHeight = 1
-	}
-	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	// Response format is unknown due to custom tracer config param
-	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
-	var decodedResult interface{}
-	err = json.Unmarshal(traceResult.Data, &decodedResult)
-	if err != nil {
-		return nil, err
-	}
-
-	return decodedResult, nil
+	}, nil
 }
 
 // TraceBlock configures a new tracer according to the provided configuration, and
@@ -207,5 +300,89 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		return nil, err
 	}
 
+	// the results are returned in the order of the traced transactions
+	if len(decodedResults) == len(txsMessages) {
+		for i, result := range decodedResults {
+			txHash := txsMessages[i].AsTransaction().Hash()
+			result.TxHash = &txHash
+		}
+	}
+
 	return decodedResults, nil
 }
+
+// TraceCall executes the given call on top of the state of the requested block
+// with the provided tracer configuration. The state and block overrides of the
+// config are applied before executing the call.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *evmtypes.TraceCallConfig,
+) (interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || blk == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.ctx, &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	if config != nil {
+		traceCallRequest.TraceConfig = &config.TraceConfig
+
+		if config.StateOverrides != nil {
+			if traceCallRequest.StateOverrides, err = json.Marshal(config.StateOverrides); err != nil {
+				return nil, err
+			}
+		}
+
+		if config.BlockOverrides != nil {
+			if traceCallRequest.BlockOverrides, err = json.Marshal(config.BlockOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blk.Block.Height), &traceCallRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
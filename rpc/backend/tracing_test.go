@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -10,10 +11,14 @@ import (
 	"github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/kato114/byte/v15/crypto/ethsecp256k1"
 	"github.com/kato114/byte/v15/indexer"
 	"github.com/kato114/byte/v15/rpc/backend/mocks"
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	utiltx "github.com/kato114/byte/v15/testutil/tx"
 	evmtypes "github.com/kato114/byte/v15/x/evm/types"
 )
 
@@ -262,3 +267,266 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	from := common.BytesToAddress(suite.acc)
+	args := evmtypes.TransactionArgs{From: &from}
+	argsBz, err := json.Marshal(&args)
+	suite.Require().NoError(err)
+
+	nonce := hexutil.Uint64(1)
+	stateOverrides := evmtypes.StateOverride{from: {Nonce: &nonce}}
+	stateOverridesBz, err := json.Marshal(&stateOverrides)
+	suite.Require().NoError(err)
+
+	blockTime := hexutil.Uint64(1)
+	blockOverrides := evmtypes.BlockOverrides{Time: &blockTime}
+	blockOverridesBz, err := json.Marshal(&blockOverrides)
+	suite.Require().NoError(err)
+
+	blockNr := rpctypes.BlockNumber(1)
+	traceConfig := evmtypes.TraceConfig{Tracer: "callTracer"}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *evmtypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - consensus params error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParamsError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - trace call error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:        argsBz,
+					GasCap:      suite.backend.RPCGasCap(),
+					BlockNumber: 1,
+					ChainId:     9000,
+					BlockMaxGas: -1,
+				})
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - without config",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:        argsBz,
+					GasCap:      suite.backend.RPCGasCap(),
+					BlockNumber: 1,
+					ChainId:     9000,
+					BlockMaxGas: -1,
+				})
+			},
+			nil,
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+		{
+			"pass - with state and block overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:           argsBz,
+					GasCap:         suite.backend.RPCGasCap(),
+					TraceConfig:    &traceConfig,
+					StateOverrides: stateOverridesBz,
+					BlockOverrides: blockOverridesBz,
+					BlockNumber:    1,
+					ChainId:        9000,
+					BlockMaxGas:    -1,
+				})
+			},
+			&evmtypes.TraceCallConfig{
+				TraceConfig:    traceConfig,
+				StateOverrides: &stateOverrides,
+				BlockOverrides: &blockOverrides,
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.TraceCall(args, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr}, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestStorageRangeAt() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	bz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	contractAddr := utiltx.GenerateAddress()
+	key := common.HexToHash("0x1")
+
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: ChainID}, Data: types.Data{Txs: []types.Tx{bz}}}
+	responseDeliver := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+			},
+		},
+	}
+
+	req := &evmtypes.QueryStorageRangeAtRequest{
+		Msg:         msgEthereumTx,
+		BlockNumber: 1,
+		ChainId:     9000,
+		BlockMaxGas: -1,
+		Address:     contractAddr.Hex(),
+		StartKey:    key.Bytes(),
+		MaxResults:  1,
+	}
+
+	nextKey := common.HexToHash("0x3")
+	testCases := []struct {
+		name         string
+		registerMock func()
+		txIndex      int
+		expResult    rpctypes.StorageRangeResult
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockByHashError(client, common.Hash{}, bz)
+			},
+			0,
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - tx not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				suite.Require().NoError(err)
+			},
+			1,
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - negative tx index",
+			func() {},
+			-1,
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"fail - query error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterStorageRangeAtError(queryClient, req)
+			},
+			0,
+			rpctypes.StorageRangeResult{},
+			false,
+		},
+		{
+			"pass",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlockByHash(client, common.Hash{}, bz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterConsensusParams(client, 1)
+				RegisterStorageRangeAt(queryClient, req)
+			},
+			0,
+			rpctypes.StorageRangeResult{
+				Storage: rpctypes.StorageMap{
+					ethcrypto.Keccak256Hash(key.Bytes()): {Key: &key, Value: common.HexToHash("0x2")},
+				},
+				NextKey: &nextKey,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			suite.Require().NoError(err)
+
+			res, err := suite.backend.StorageRangeAt(common.Hash{}, tc.txIndex, contractAddr, key.Bytes(), 1)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
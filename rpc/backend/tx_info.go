@@ -127,6 +127,60 @@ func (b *Backend) getTransactionByHashPending(txHash common.Hash) (*rpctypes.RPC
 	return nil, nil
 }
 
+// GetRawTransaction returns the signed RLP encoding of the Ethereum
+// transaction identified by hash, included in a block or pending in the
+// mempool. Returns nil if the transaction isn't found.
+func (b *Backend) GetRawTransaction(txHash common.Hash) (hexutil.Bytes, error) {
+	res, err := b.GetTxByEthHash(txHash)
+	if err != nil {
+		return b.getRawTransactionPending(txHash)
+	}
+
+	block, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	// the `res.MsgIndex` is inferred from tx index, should be within the bound.
+	msg, ok := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, errors.New("invalid ethereum tx")
+	}
+
+	return msg.AsTransaction().MarshalBinary()
+}
+
+// getRawTransactionPending returns the signed RLP encoding of the pending tx
+// from the mempool.
+func (b *Backend) getRawTransactionPending(txHash common.Hash) (hexutil.Bytes, error) {
+	hexTx := txHash.Hex()
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
+		return nil, nil
+	}
+
+	for _, tx := range txs {
+		msg, err := evmtypes.UnwrapEthereumMsg(tx, txHash)
+		if err != nil {
+			// not ethereum tx
+			continue
+		}
+
+		if msg.Hash == hexTx {
+			return msg.AsTransaction().MarshalBinary()
+		}
+	}
+
+	b.logger.Debug("tx not found", "hash", hexTx)
+	return nil, nil
+}
+
 // GetGasUsed returns gasUsed from transaction
 func (b *Backend) GetGasUsed(res *types.TxResult, price *big.Int, gas uint64) uint64 {
 	// patch gasUsed if tx is reverted and happened before height on which fixed was introduced
@@ -160,12 +214,6 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
-	if err != nil {
-		b.logger.Error("failed to unpack tx data", "error", err.Error())
-		return nil, err
-	}
-
 	cumulativeGasUsed := uint64(0)
 	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
 	if err != nil {
@@ -177,22 +225,11 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	cumulativeGasUsed += res.CumulativeGasUsed
 
-	var status hexutil.Uint
-	if res.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-	}
 	chainID, err := b.ChainID()
 	if err != nil {
 		return nil, err
 	}
 
-	from, err := ethMsg.GetSender(chainID.ToInt())
-	if err != nil {
-		return nil, err
-	}
-
 	// parse tx logs from events
 	msgIndex := int(res.MsgIndex) // #nosec G701 -- checked for int overflow already
 	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
@@ -215,6 +252,237 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, errors.New("can't find index of ethereum tx")
 	}
 
+	var baseFee *big.Int
+	if ethMsg.AsTransaction().Type() == ethtypes.DynamicFeeTxType {
+		baseFee, err = b.BaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for pruned node.
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
+		}
+	}
+
+	return b.formatTxReceipt(ethMsg, res, resBlock, logs, cumulativeGasUsed, chainID.ToInt(), baseFee)
+}
+
+// GetBlockReceipts returns the receipts of all the Ethereum transactions
+// included in the given block. The block results are fetched and decoded once
+// to build all the receipts.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, blockRes, err := b.blockAndResults(blockNum)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block", "error", err.Error())
+		return nil, nil
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		// tolerate the error for pruned node.
+		b.logger.Error("fetch basefee failed, node is pruned?", "height", resBlock.Block.Height, "error", err)
+	}
+
+	txResults, err := b.blockTxResults(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]map[string]interface{}, 0, len(txResults))
+	for _, txResult := range txResults {
+		receipt, err := b.formatTxReceipt(txResult.msg, txResult.res, resBlock, txResult.logs, txResult.cumulativeGasUsed, chainID.ToInt(), baseFee)
+		if err != nil {
+			return nil, err
+		}
+
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// GetRawReceipts returns the consensus encoding of the receipts of all the
+// Ethereum transactions included in the given block.
+func (b *Backend) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, blockRes, err := b.blockAndResults(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	txResults, err := b.blockTxResults(resBlock, blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]hexutil.Bytes, 0, len(txResults))
+	for _, txResult := range txResults {
+		receipt := &ethtypes.Receipt{
+			Type:              txResult.msg.AsTransaction().Type(),
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: txResult.cumulativeGasUsed,
+			Logs:              txResult.logs,
+		}
+		if txResult.res.Failed {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+
+		bz, err := receipt.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, bz)
+	}
+
+	return receipts, nil
+}
+
+// blockAndResults returns the block and its results.
+func (b *Backend) blockAndResults(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, *tmrpctypes.ResultBlockResults, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil, fmt.Errorf("block not found for height %d", blockNum)
+	}
+
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve block results for height %d: %w", height, err)
+	}
+
+	return resBlock, blockRes, nil
+}
+
+// blockTxResult is an Ethereum transaction of a block, with its result and
+// logs parsed from the tx events.
+type blockTxResult struct {
+	msg               *evmtypes.MsgEthereumTx
+	res               *types.TxResult
+	logs              []*ethtypes.Log
+	cumulativeGasUsed uint64 // gas used by the block up to the transaction
+}
+
+// blockTxResults returns the results of the Ethereum transactions included in
+// the block, to build their receipts.
+func (b *Backend) blockTxResults(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) ([]blockTxResult, error) {
+	var (
+		height       = resBlock.Block.Height
+		txResults    []blockTxResult
+		blockGasUsed uint64
+		ethTxIndex   int32
+	)
+
+	for i, txBz := range resBlock.Block.Txs {
+		txResult := blockRes.TxsResults[i]
+		txGasUsed := uint64(txResult.GasUsed) // #nosec G701 -- checked for int overflow already
+
+		// skip the txs that are not included in the EVM, as in EthMsgsFromTendermintBlock
+		if !rpctypes.TxSucessOrExpectedFailure(txResult) {
+			blockGasUsed += txGasUsed
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			blockGasUsed += txGasUsed
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tx events: block %d, index %d, %v", height, i, err)
+		}
+
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+			if parsedTx == nil {
+				return nil, fmt.Errorf("ethereum tx not found in msgs: block %d, index %d", height, i)
+			}
+
+			res := &types.TxResult{
+				Height:            height,
+				TxIndex:           uint32(i),        // #nosec G701 -- checked for int overflow already
+				MsgIndex:          uint32(msgIndex), // #nosec G701 -- checked for int overflow already
+				EthTxIndex:        parsedTx.EthTxIndex,
+				Failed:            parsedTx.Failed,
+				GasUsed:           parsedTx.GasUsed,
+				CumulativeGasUsed: parsedTxs.AccumulativeGasUsed(msgIndex),
+			}
+			if res.EthTxIndex == -1 {
+				res.EthTxIndex = ethTxIndex
+			}
+
+			logs, err := TxLogsFromEvents(txResult.Events, msgIndex)
+			if err != nil {
+				b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
+			}
+
+			txResults = append(txResults, blockTxResult{
+				msg:               ethMsg,
+				res:               res,
+				logs:              logs,
+				cumulativeGasUsed: blockGasUsed + res.CumulativeGasUsed,
+			})
+			ethTxIndex++
+		}
+
+		blockGasUsed += txGasUsed
+	}
+
+	return txResults, nil
+}
+
+// formatTxReceipt returns the receipt of an Ethereum transaction from its
+// message, the result parsed from the tx events and the logs. The base fee is
+// only used for dynamic fee transactions and can be nil.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *types.TxResult,
+	resBlock *tmrpctypes.ResultBlock,
+	logs []*ethtypes.Log,
+	cumulativeGasUsed uint64,
+	chainID, baseFee *big.Int,
+) (map[string]interface{}, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		b.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, err
+	}
+
+	var status hexutil.Uint
+	if res.Failed {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	} else {
+		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	}
+
+	from, err := ethMsg.GetSender(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	ethTx := ethMsg.AsTransaction()
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
@@ -224,7 +492,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
+		"transactionHash": ethTx.Hash(),
 		"contractAddress": nil,
 		"gasUsed":         hexutil.Uint64(b.GetGasUsed(res, txData.GetGasPrice(), txData.GetGas())),
 
@@ -237,7 +505,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(ethTx.Type()),
 	}
 
 	if logs == nil {
@@ -249,14 +517,8 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
+	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok && baseFee != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
 	}
 
 	return receipt, nil
@@ -342,6 +604,88 @@ func (b *Backend) GetTxByTxIndex(height int64, index uint) (*types.TxResult, err
 	return txResult, nil
 }
 
+// GetTxHashesByAddress returns the hashes of the eth txs in which the given
+// address has any of the given roles, or any indexed role if none is given,
+// from the address index of the EVM indexer. The txs start after the given
+// height in ascending order, or before it in descending order if reverse is
+// set. The bool is true if there are more txs available. The Tendermint tx
+// indexer has no address index, so the EVM indexer must be enabled.
+func (b *Backend) GetTxHashesByAddress(
+	address common.Address,
+	roles []types.AddressRole,
+	height int64,
+	reverse bool,
+	limit int,
+) ([]common.Hash, bool, error) {
+	if b.indexer == nil || len(b.indexer.AddressRoles()) == 0 {
+		return nil, false, errors.New("address search requires the EVM indexer and its address index to be enabled")
+	}
+	for _, role := range roles {
+		if !containsAddressRole(b.indexer.AddressRoles(), role) {
+			return nil, false, fmt.Errorf("address role '%s' is not indexed", role)
+		}
+	}
+	return b.indexer.GetByAddress(address, roles, height, reverse, limit)
+}
+
+// GetTransactionsByAddress returns a page of the eth txs of the given address,
+// together with the block number to start the next page from, if any.
+func (b *Backend) GetTransactionsByAddress(address common.Address, args rpctypes.AddressHistoryArgs) (*rpctypes.AddressHistory, error) {
+	roles, err := types.ParseAddressRoles(args.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := DefaultAddressHistoryLimit
+	if args.Limit != nil {
+		limit = int(*args.Limit)
+	}
+	if limit <= 0 || limit > MaxAddressHistoryLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d, got %d", MaxAddressHistoryLimit, limit)
+	}
+
+	hashes, more, err := b.GetTxHashesByAddress(address, roles, int64(args.FromBlock), args.Reverse, limit) // #nosec G701 -- block numbers fit in int64
+	if err != nil {
+		return nil, err
+	}
+
+	history := &rpctypes.AddressHistory{
+		Transactions: make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+	}
+	for _, hash := range hashes {
+		tx, err := b.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+		}
+		history.Transactions = append(history.Transactions, tx)
+	}
+
+	// the pages never split a block, so the next page starts after the block
+	// of the last tx
+	if more && len(history.Transactions) > 0 {
+		last := history.Transactions[len(history.Transactions)-1]
+		if last.BlockNumber != nil {
+			nextBlock := hexutil.Uint64(last.BlockNumber.ToInt().Uint64())
+			history.NextBlock = &nextBlock
+		}
+	}
+
+	return history, nil
+}
+
+// containsAddressRole returns true if the role is in the list
+func containsAddressRole(roles []types.AddressRole, role types.AddressRole) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
 // queryTendermintTxIndexer query tx in tendermint tx indexer
 func (b *Backend) queryTendermintTxIndexer(query string, txGetter func(*rpctypes.ParsedTxs) *rpctypes.ParsedTx) (*types.TxResult, error) {
 	resTxs, err := b.clientCtx.Client.TxSearch(b.ctx, query, false, nil, nil, "")
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -12,11 +13,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/kato114/byte/v15/indexer"
 	"github.com/kato114/byte/v15/rpc/backend/mocks"
 	rpctypes "github.com/kato114/byte/v15/rpc/types"
 	evmostypes "github.com/kato114/byte/v15/types"
 	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -142,7 +145,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"fail - Pending transactions returns error",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxsError(client, nil)
+				RegisterUnconfirmedTxsError(client, &unconfirmedTxsLimit)
 			},
 			msgEthereumTx,
 			nil,
@@ -152,7 +155,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"fail - Tx not found return nil",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, nil)
+				RegisterUnconfirmedTxs(client, &unconfirmedTxsLimit, nil)
 			},
 			msgEthereumTx,
 			nil,
@@ -162,7 +165,7 @@ func (suite *BackendTestSuite) TestGetTransactionsByHashPending() {
 			"pass - Tx found and returned",
 			func() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
-				RegisterUnconfirmedTxs(client, nil, types.Txs{bz})
+				RegisterUnconfirmedTxs(client, &unconfirmedTxsLimit, types.Txs{bz})
 			},
 			msgEthereumTx,
 			rpcTransaction,
@@ -613,6 +616,316 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+
+	ethLog := &ethtypes.Log{
+		Address: common.BytesToAddress([]byte{0x1}),
+		Topics:  []common.Hash{common.BytesToHash([]byte{0x2})},
+		Data:    []byte{0x3},
+		TxHash:  txHash,
+		Index:   3,
+	}
+	logBz, err := json.Marshal(evmtypes.NewLogFromEth(ethLog))
+	suite.Require().NoError(err)
+
+	blockNr := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  func(receipts []map[string]interface{})
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			func(receipts []map[string]interface{}) {
+				suite.Require().Nil(receipts)
+			},
+		},
+		{
+			"fail - block results error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			func(receipts []map[string]interface{}) {
+				suite.Require().Nil(receipts)
+			},
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&tmrpctypes.ResultBlockResults{Height: 1}, nil)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+			},
+			func(receipts []map[string]interface{}) {
+				suite.Require().NotNil(receipts)
+				suite.Require().Empty(receipts)
+			},
+		},
+		{
+			"pass - block with an ethereum transaction",
+			func() {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&tmrpctypes.ResultBlockResults{
+						Height: 1,
+						TxsResults: []*abci.ResponseDeliverTx{
+							{
+								Code:    0,
+								GasUsed: 21000,
+								Events: []abci.Event{
+									{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+										{Key: "ethereumTxHash", Value: txHash.Hex()},
+										{Key: "txIndex", Value: "0"},
+										{Key: "amount", Value: "1000"},
+										{Key: "txGasUsed", Value: "21000"},
+										{Key: "txHash", Value: ""},
+										{Key: "recipient", Value: ""},
+									}},
+									{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+										{Key: evmtypes.AttributeKeyTxLog, Value: string(logBz)},
+									}},
+								},
+							},
+						},
+					}, nil)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+			},
+			func(receipts []map[string]interface{}) {
+				suite.Require().Len(receipts, 1)
+
+				receipt := receipts[0]
+				suite.Require().Equal(txHash, receipt["transactionHash"])
+				suite.Require().Equal(hexutil.Uint(ethtypes.ReceiptStatusSuccessful), receipt["status"])
+				suite.Require().Equal(hexutil.Uint64(21000), receipt["cumulativeGasUsed"])
+				suite.Require().Equal(hexutil.Uint64(21000), receipt["gasUsed"])
+				suite.Require().Equal(hexutil.Uint64(1), receipt["blockNumber"])
+				suite.Require().Equal(hexutil.Uint64(0), receipt["transactionIndex"])
+				suite.Require().Equal([]*ethtypes.Log{ethLog}, receipt["logs"])
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+			suite.Require().NoError(err)
+			tc.expReceipts(receipts)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetRawReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+
+	ethLog := &ethtypes.Log{
+		Address: common.BytesToAddress([]byte{0x1}),
+		Topics:  []common.Hash{common.BytesToHash([]byte{0x2})},
+		Data:    []byte{0x3},
+		TxHash:  txHash,
+	}
+	logBz, err := json.Marshal(evmtypes.NewLogFromEth(ethLog))
+	suite.Require().NoError(err)
+
+	blockNr := rpctypes.BlockNumber(1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			false,
+		},
+		{
+			"fail - block results error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				RegisterBlockResultsError(client, 1)
+			},
+			false,
+		},
+		{
+			"pass - block with an ethereum transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+					Return(&tmrpctypes.ResultBlockResults{
+						Height: 1,
+						TxsResults: []*abci.ResponseDeliverTx{
+							{
+								Code:    0,
+								GasUsed: 21000,
+								Events: []abci.Event{
+									{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+										{Key: "ethereumTxHash", Value: txHash.Hex()},
+										{Key: "txIndex", Value: "0"},
+										{Key: "amount", Value: "1000"},
+										{Key: "txGasUsed", Value: "21000"},
+										{Key: "txHash", Value: ""},
+										{Key: "recipient", Value: ""},
+									}},
+									{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+										{Key: evmtypes.AttributeKeyTxLog, Value: string(logBz)},
+									}},
+								},
+							},
+						},
+					}, nil)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetRawReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(receipts, 1)
+
+			var receipt ethtypes.Receipt
+			suite.Require().NoError(receipt.UnmarshalBinary(receipts[0]))
+			suite.Require().Equal(msgEthereumTx.AsTransaction().Type(), receipt.Type)
+			suite.Require().Equal(ethtypes.ReceiptStatusSuccessful, receipt.Status)
+			suite.Require().Equal(uint64(21000), receipt.CumulativeGasUsed)
+			suite.Require().Len(receipt.Logs, 1)
+			suite.Require().Equal(ethLog.Address, receipt.Logs[0].Address)
+			suite.Require().Equal(ethLog.Topics, receipt.Logs[0].Topics)
+			suite.Require().True(receipt.Bloom.Test(ethLog.Address.Bytes()))
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetRawTransaction() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	rawTx, err := msgEthereumTx.AsTransaction().MarshalBinary()
+	suite.Require().NoError(err)
+
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		hash         common.Hash
+		expRawTx     hexutil.Bytes
+		expPass      bool
+	}{
+		{
+			"fail - block error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			txHash,
+			nil,
+			false,
+		},
+		{
+			"pass - transaction not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsEmpty(client, &unconfirmedTxsLimit)
+			},
+			common.HexToHash("0x1"),
+			nil,
+			true,
+		},
+		{
+			"pass - transaction found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+			},
+			txHash,
+			rawTx,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			suite.Require().NoError(err)
+
+			raw, err := suite.backend.GetRawTransaction(tc.hash)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRawTx, raw)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -668,3 +981,234 @@ func (suite *BackendTestSuite) TestGetGasUsed() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetTxHashesByAddress() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	from, err := msgEthereumTx.GetSender(suite.backend.chainID)
+	suite.Require().NoError(err)
+
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		address   common.Address
+		roles     []evmostypes.AddressRole
+		height    int64
+		reverse   bool
+		expHashes []common.Hash
+		expPass   bool
+	}{
+		{
+			"fail - indexer disabled",
+			func() { suite.backend.indexer = nil },
+			from,
+			nil,
+			0,
+			true,
+			nil,
+			false,
+		},
+		{
+			"fail - address index disabled",
+			func() {
+				suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			},
+			from,
+			nil,
+			0,
+			true,
+			nil,
+			false,
+		},
+		{
+			"fail - role not indexed",
+			func() {
+				suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx, evmostypes.AddressRoleSender)
+			},
+			from,
+			[]evmostypes.AddressRole{evmostypes.AddressRoleRecipient},
+			0,
+			true,
+			nil,
+			false,
+		},
+		{
+			"pass - sender before latest block",
+			func() {},
+			from,
+			nil,
+			0,
+			true,
+			[]common.Hash{txHash},
+			true,
+		},
+		{
+			"pass - recipient after genesis",
+			func() {},
+			common.Address{},
+			[]evmostypes.AddressRole{evmostypes.AddressRoleRecipient},
+			0,
+			false,
+			[]common.Hash{txHash},
+			true,
+		},
+		{
+			"pass - sender before the tx block",
+			func() {},
+			from,
+			nil,
+			1,
+			true,
+			nil,
+			true,
+		},
+		{
+			"pass - recipient role of the sender",
+			func() {},
+			from,
+			[]evmostypes.AddressRole{evmostypes.AddressRoleRecipient},
+			0,
+			true,
+			nil,
+			true,
+		},
+		{
+			"pass - unknown address",
+			func() {},
+			common.HexToAddress("0x1"),
+			nil,
+			0,
+			true,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx, evmostypes.AllAddressRoles()...)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			hashes, more, err := suite.backend.GetTxHashesByAddress(tc.address, tc.roles, tc.height, tc.reverse, 10)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expHashes, hashes)
+				suite.Require().False(more)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetTransactionsByAddress() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	from, err := msgEthereumTx.GetSender(suite.backend.chainID)
+	suite.Require().NoError(err)
+
+	block := &types.Block{Header: types.Header{Height: 1, ChainID: "test"}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseDeliver := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: ""},
+				}},
+			},
+		},
+	}
+
+	tooLarge := hexutil.Uint64(MaxAddressHistoryLimit + 1)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		args         rpctypes.AddressHistoryArgs
+		expTxs       int
+		expPass      bool
+	}{
+		{
+			"fail - unknown role",
+			func() {},
+			rpctypes.AddressHistoryArgs{Roles: []string{"owner"}},
+			0,
+			false,
+		},
+		{
+			"fail - limit too large",
+			func() {},
+			rpctypes.AddressHistoryArgs{Limit: &tooLarge},
+			0,
+			false,
+		},
+		{
+			"pass - sender history",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				_, err = RegisterBlockResults(client, 1)
+				suite.Require().NoError(err)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+			},
+			rpctypes.AddressHistoryArgs{Reverse: true, Roles: []string{"sender"}},
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx, evmostypes.AllAddressRoles()...)
+			err := suite.backend.indexer.IndexBlock(block, responseDeliver)
+			suite.Require().NoError(err)
+
+			tc.registerMock()
+
+			history, err := suite.backend.GetTransactionsByAddress(from, tc.args)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(history.Transactions, tc.expTxs)
+				suite.Require().Equal(txHash, history.Transactions[0].Hash)
+				suite.Require().Nil(history.NextBlock)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
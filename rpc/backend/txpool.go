@@ -0,0 +1,168 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package backend
+
+import (
+	"sort"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/ethereum/go-ethereum/common"
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+	"github.com/pkg/errors"
+)
+
+// TxPoolTxs maps an account nonce to the RPC representation of the mempool
+// transaction that uses it.
+type TxPoolTxs map[uint64]*rpctypes.RPCTransaction
+
+// TxPoolContent returns the Ethereum transactions currently held in the
+// CometBFT mempool, grouped by sender. Transactions whose nonces follow the
+// sender's current account nonce without gaps are returned as pending, the
+// remaining ones are returned as queued.
+func (b *Backend) TxPoolContent() (map[common.Address]TxPoolTxs, map[common.Address]TxPoolTxs, error) {
+	bySender, err := b.pendingEthMsgsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending := make(map[common.Address]TxPoolTxs)
+	queued := make(map[common.Address]TxPoolTxs)
+
+	for sender, msgs := range bySender {
+		p, q, err := b.splitTxPoolMsgs(sender, msgs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(p) > 0 {
+			pending[sender] = p
+		}
+		if len(q) > 0 {
+			queued[sender] = q
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// TxPoolContentFrom returns the pending and queued mempool transactions sent
+// by the given address.
+func (b *Backend) TxPoolContentFrom(address common.Address) (TxPoolTxs, TxPoolTxs, error) {
+	bySender, err := b.pendingEthMsgsBySender()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b.splitTxPoolMsgs(address, bySender[address])
+}
+
+// TxPoolStatus returns the number of pending and queued transactions in the
+// CometBFT mempool. As the mempool returns at most MaxUnconfirmedTxs txs, the
+// total is read from the mempool size and the txs that aren't queued are
+// counted as pending.
+func (b *Backend) TxPoolStatus() (uint64, uint64, error) {
+	mc, ok := b.clientCtx.Client.(tmrpcclient.MempoolClient)
+	if !ok {
+		return 0, 0, errors.New("invalid rpc client")
+	}
+
+	res, err := mc.NumUnconfirmedTxs(b.ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	_, queued, err := b.TxPoolContent()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var numQueued uint64
+	for _, txs := range queued {
+		numQueued += uint64(len(txs))
+	}
+
+	total := uint64(res.Total)
+	if numQueued > total {
+		// the mempool changed in between
+		total = numQueued
+	}
+	return total - numQueued, numQueued, nil
+}
+
+// pendingEthMsgsBySender decodes the unconfirmed txs from the mempool and
+// groups the contained MsgEthereumTx by their sender.
+func (b *Backend) pendingEthMsgsBySender() (map[common.Address][]*evmtypes.MsgEthereumTx, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[common.Address][]*evmtypes.MsgEthereumTx)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to recover mempool tx sender", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+			result[sender] = append(result[sender], ethMsg)
+		}
+	}
+
+	return result, nil
+}
+
+// splitTxPoolMsgs sorts the sender's messages by nonce and splits them into
+// the executable (pending) and non-executable (queued) sets, using the
+// account nonce from the EVM state as the starting point. Messages with a
+// nonce lower than the account nonce are stale and are omitted.
+func (b *Backend) splitTxPoolMsgs(sender common.Address, msgs []*evmtypes.MsgEthereumTx) (TxPoolTxs, TxPoolTxs, error) {
+	pending := make(TxPoolTxs)
+	queued := make(TxPoolTxs)
+
+	if len(msgs) == 0 {
+		return pending, queued, nil
+	}
+
+	res, err := b.queryClient.Account(b.ctx, &evmtypes.QueryAccountRequest{Address: sender.Hex()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return msgs[i].AsTransaction().Nonce() < msgs[j].AsTransaction().Nonce()
+	})
+
+	next := res.Nonce
+	for _, msg := range msgs {
+		nonce := msg.AsTransaction().Nonce()
+		if nonce < next && pending[nonce] == nil {
+			continue
+		}
+
+		// use zero block values since it's not included in a block yet
+		rpcTx, err := rpctypes.NewTransactionFromMsg(msg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch {
+		case nonce == next:
+			pending[nonce] = rpcTx
+			next++
+		case nonce < next:
+			// replacement of an already pending nonce
+			pending[nonce] = rpcTx
+		default:
+			queued[nonce] = rpcTx
+		}
+	}
+
+	return pending, queued, nil
+}
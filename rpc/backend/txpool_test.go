@@ -0,0 +1,228 @@
+package backend
+
+import (
+	"math/big"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kato114/byte/v15/rpc/backend/mocks"
+	rpc "github.com/kato114/byte/v15/rpc/types"
+	"github.com/kato114/byte/v15/utils"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// buildSignedEthereumTx returns a legacy Ethereum transaction with the given
+// nonce signed by the suite's signer, together with its encoded bytes.
+func (suite *BackendTestSuite) buildSignedEthereumTx(nonce uint64) (*evmtypes.MsgEthereumTx, []byte) {
+	msgEthereumTx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.backend.chainID,
+		Nonce:    nonce,
+		To:       &common.Address{},
+		Amount:   big.NewInt(1),
+		GasLimit: 21000,
+		GasPrice: big.NewInt(1),
+	})
+	msgEthereumTx.From = suite.from.String()
+
+	ethSigner := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+	err := msgEthereumTx.Sign(ethSigner, suite.signer)
+	suite.Require().NoError(err)
+
+	tx, err := msgEthereumTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	suite.Require().NoError(err)
+
+	bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+
+	return msgEthereumTx, bz
+}
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	testCases := []struct {
+		name         string
+		nonces       []uint64
+		accNonce     uint64
+		registerMock func(txs types.Txs, accNonce uint64)
+		expPending   []uint64
+		expQueued    []uint64
+		expPass      bool
+	}{
+		{
+			"fail - pending transactions returns error",
+			nil,
+			0,
+			func(_ types.Txs, _ uint64) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, &unconfirmedTxsLimit)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - account query returns error",
+			[]uint64{0},
+			0,
+			func(txs types.Txs, _ uint64) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterUnconfirmedTxs(client, &unconfirmedTxsLimit, txs)
+				queryClient.On("Account", rpc.ContextWithHeight(1), &evmtypes.QueryAccountRequest{Address: suite.from.Hex()}).
+					Return(nil, evmtypes.ErrInvalidAccount)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			nil,
+			0,
+			func(_ types.Txs, _ uint64) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, &unconfirmedTxsLimit, nil)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - consecutive nonces are pending, nonces after a gap are queued",
+			[]uint64{3, 1, 2, 5},
+			1,
+			func(txs types.Txs, accNonce uint64) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterUnconfirmedTxs(client, &unconfirmedTxsLimit, txs)
+				queryClient.On("Account", rpc.ContextWithHeight(1), &evmtypes.QueryAccountRequest{Address: suite.from.Hex()}).
+					Return(&evmtypes.QueryAccountResponse{Balance: "0", Nonce: accNonce}, nil)
+			},
+			[]uint64{1, 2, 3},
+			[]uint64{5},
+			true,
+		},
+		{
+			"pass - stale nonces are omitted",
+			[]uint64{0, 1},
+			1,
+			func(txs types.Txs, accNonce uint64) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterUnconfirmedTxs(client, &unconfirmedTxsLimit, txs)
+				queryClient.On("Account", rpc.ContextWithHeight(1), &evmtypes.QueryAccountRequest{Address: suite.from.Hex()}).
+					Return(&evmtypes.QueryAccountResponse{Balance: "0", Nonce: accNonce}, nil)
+			},
+			[]uint64{1},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			txs := make(types.Txs, 0, len(tc.nonces))
+			expTxs := make(map[uint64]*evmtypes.MsgEthereumTx, len(tc.nonces))
+			for _, nonce := range tc.nonces {
+				msg, bz := suite.buildSignedEthereumTx(nonce)
+				txs = append(txs, bz)
+				expTxs[nonce] = msg
+			}
+			tc.registerMock(txs, tc.accNonce)
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			from, _, err := suite.backend.TxPoolContentFrom(suite.from)
+			suite.Require().NoError(err)
+			suite.Require().Len(from, len(tc.expPending))
+
+			suite.Require().Len(pending[suite.from], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				suite.Require().Equal(common.HexToHash(expTxs[nonce].Hash), pending[suite.from][nonce].Hash)
+			}
+			suite.Require().Len(queued[suite.from], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				suite.Require().Equal(common.HexToHash(expTxs[nonce].Hash), queued[suite.from][nonce].Hash)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTxPoolStatus() {
+	testCases := []struct {
+		name         string
+		nonces       []uint64
+		registerMock func(txs types.Txs)
+		expPending   uint64
+		expQueued    uint64
+		expPass      bool
+	}{
+		{
+			"fail - mempool size returns error",
+			nil,
+			func(_ types.Txs) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterNumUnconfirmedTxsError(client)
+			},
+			0,
+			0,
+			false,
+		},
+		{
+			"fail - pending transactions returns error",
+			nil,
+			func(_ types.Txs) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterNumUnconfirmedTxs(client, 1)
+				RegisterUnconfirmedTxsError(client, &unconfirmedTxsLimit)
+			},
+			0,
+			0,
+			false,
+		},
+		{
+			"pass - txs beyond the returned ones are pending",
+			[]uint64{1, 2, 5},
+			func(txs types.Txs) {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterNumUnconfirmedTxs(client, 250)
+				RegisterUnconfirmedTxs(client, &unconfirmedTxsLimit, txs)
+				queryClient.On("Account", rpc.ContextWithHeight(1), &evmtypes.QueryAccountRequest{Address: suite.from.Hex()}).
+					Return(&evmtypes.QueryAccountResponse{Balance: "0", Nonce: 1}, nil)
+			},
+			249,
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			txs := make(types.Txs, 0, len(tc.nonces))
+			for _, nonce := range tc.nonces {
+				_, bz := suite.buildSignedEthereumTx(nonce)
+				txs = append(txs, bz)
+			}
+			tc.registerMock(txs)
+
+			pending, queued, err := suite.backend.TxPoolStatus()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPending, pending)
+			suite.Require().Equal(tc.expQueued, queued)
+		})
+	}
+}
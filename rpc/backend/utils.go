@@ -156,30 +156,54 @@ func (b *Backend) processBlock(
 		targetOneFeeHistory.Reward[i] = big.NewInt(0)
 	}
 
-	// check tendermintTxs
+	sorter := b.blockGasAndRewards(tendermintBlock, tendermintBlockResult, blockBaseFee)
+
+	// return an all zero row if there are no transactions to gather data from
+	ethTxCount := len(sorter)
+	if ethTxCount == 0 {
+		return nil
+	}
+
+	var txIndex int
+	sumGasUsed := sorter[0].gasUsed
+
+	for i, p := range rewardPercentiles {
+		thresholdGasUsed := uint64(blockGasUsed * p / 100) // #nosec G701
+		for sumGasUsed < thresholdGasUsed && txIndex < ethTxCount-1 {
+			txIndex++
+			sumGasUsed += sorter[txIndex].gasUsed
+		}
+		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
+	}
+
+	return nil
+}
+
+// blockGasAndRewards returns the gas used and the effective tip of the ethereum
+// txs of the block, sorted by ascending tip.
+func (b *Backend) blockGasAndRewards(
+	tendermintBlock *tmrpctypes.ResultBlock,
+	tendermintBlockResult *tmrpctypes.ResultBlockResults,
+	baseFee *big.Int,
+) sortGasAndReward {
 	tendermintTxs := tendermintBlock.Block.Txs
 	tendermintTxResults := tendermintBlockResult.TxsResults
-	tendermintTxCount := len(tendermintTxs)
 
 	var sorter sortGasAndReward
-
-	for i := 0; i < tendermintTxCount; i++ {
-		eachTendermintTx := tendermintTxs[i]
-		eachTendermintTxResult := tendermintTxResults[i]
-
-		tx, err := b.clientCtx.TxConfig.TxDecoder()(eachTendermintTx)
+	for i := 0; i < len(tendermintTxs) && i < len(tendermintTxResults); i++ {
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(tendermintTxs[i])
 		if err != nil {
-			b.logger.Debug("failed to decode transaction in block", "height", blockHeight, "error", err.Error())
+			b.logger.Debug("failed to decode transaction in block", "height", tendermintBlock.Block.Height, "error", err.Error())
 			continue
 		}
-		txGasUsed := uint64(eachTendermintTxResult.GasUsed) // #nosec G701
+		txGasUsed := uint64(tendermintTxResults[i].GasUsed) // #nosec G701
 		for _, msg := range tx.GetMsgs() {
 			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
 			if !ok {
 				continue
 			}
 			tx := ethMsg.AsTransaction()
-			reward := tx.EffectiveGasTipValue(blockBaseFee)
+			reward := tx.EffectiveGasTipValue(baseFee)
 			if reward == nil {
 				reward = big.NewInt(0)
 			}
@@ -187,27 +211,8 @@ func (b *Backend) processBlock(
 		}
 	}
 
-	// return an all zero row if there are no transactions to gather data from
-	ethTxCount := len(sorter)
-	if ethTxCount == 0 {
-		return nil
-	}
-
 	sort.Sort(sorter)
-
-	var txIndex int
-	sumGasUsed := sorter[0].gasUsed
-
-	for i, p := range rewardPercentiles {
-		thresholdGasUsed := uint64(blockGasUsed * p / 100) // #nosec G701
-		for sumGasUsed < thresholdGasUsed && txIndex < ethTxCount-1 {
-			txIndex++
-			sumGasUsed += sorter[txIndex].gasUsed
-		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
-	}
-
-	return nil
+	return sorter
 }
 
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
@@ -300,3 +305,26 @@ func GetHexProofs(proof *crypto.ProofOps) []string {
 	}
 	return proofs
 }
+
+// GetStoreProof returns the proof of the key of the store, with the proof ops
+// returned by the ABCI query.
+func GetStoreProof(storeKey string, key, value []byte, proof *crypto.ProofOps) types.StoreProof {
+	storeProof := types.StoreProof{
+		StoreKey: storeKey,
+		Key:      key,
+		Value:    value,
+		ProofOps: []types.StoreProofOp{},
+	}
+	if proof == nil {
+		return storeProof
+	}
+
+	for _, op := range proof.Ops {
+		storeProof.ProofOps = append(storeProof.ProofOps, types.StoreProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		})
+	}
+	return storeProof
+}
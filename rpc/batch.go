@@ -0,0 +1,145 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/kato114/byte/v15/rpc/auth"
+)
+
+const (
+	// the errors of the batches exceeding the limits, as returned by geth
+	errCodeBatchTooLarge    = -32600
+	errMsgBatchTooLarge     = "batch too large"
+	errCodeResponseTooLarge = -32003
+	errMsgResponseTooLarge  = "response too large"
+)
+
+// batchLimitHandler enforces the batch limits on the JSON-RPC requests served by
+// the next handler.
+type batchLimitHandler struct {
+	next            http.Handler
+	requestLimit    int // max calls of a batch (unlimited = 0)
+	responseMaxSize int // max size in bytes of the responses of a batch (unlimited = 0)
+}
+
+// NewBatchLimitHandler returns the HTTP handler serving the JSON-RPC requests
+// with the next handler, and rejecting the batches with more calls than the
+// request limit. If the response max size is set, the calls of a batch are
+// served one at a time, and the calls left once the size of the responses
+// exceeds it get an error instead, as geth does. Returns the next handler if
+// no limit is set.
+func NewBatchLimitHandler(next http.Handler, requestLimit, responseMaxSize int) http.Handler {
+	if requestLimit == 0 && responseMaxSize == 0 {
+		return next
+	}
+
+	return &batchLimitHandler{
+		next:            next,
+		requestLimit:    requestLimit,
+		responseMaxSize: responseMaxSize,
+	}
+}
+
+func (h *batchLimitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parsed, r, err := auth.ReadRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the batch is split as the JSON-RPC server reads it, the bytes after it
+	// being ignored, and the requests that aren't batches or can't be parsed
+	// are left to the JSON-RPC server
+	batch, calls := parsed.Messages, parsed.Calls
+	if !parsed.Batch || len(batch) == 0 {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	if h.requestLimit != 0 && len(batch) > h.requestLimit {
+		// respond with a single error, with the id of the first call as there's
+		// no way to report an error for the entire batch
+		var id json.RawMessage
+		for _, call := range calls {
+			if call.IsCall() {
+				id = call.ID
+				break
+			}
+		}
+		writeBatchResponse(w, []json.RawMessage{errorResponse(id, errCodeBatchTooLarge, errMsgBatchTooLarge)})
+		return
+	}
+
+	if h.responseMaxSize == 0 {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	responses := make([]json.RawMessage, 0, len(batch))
+	size := 0
+	for i, msg := range batch {
+		if size > h.responseMaxSize {
+			if calls[i].IsCall() {
+				responses = append(responses, errorResponse(calls[i].ID, errCodeResponseTooLarge, errMsgResponseTooLarge))
+			}
+			continue
+		}
+
+		req := r.Clone(r.Context())
+		req.Body = io.NopCloser(bytes.NewReader(msg))
+		req.ContentLength = int64(len(msg))
+
+		res := newRPCResponseWriter()
+		h.next.ServeHTTP(res, req)
+		if res.status != http.StatusOK {
+			w.WriteHeader(res.status)
+			_, _ = w.Write(res.body.Bytes()) // #nosec G703
+			return
+		}
+
+		// notifications have no response
+		response := bytes.TrimSpace(res.body.Bytes())
+		if len(response) == 0 {
+			continue
+		}
+		responses = append(responses, response)
+		size += len(response)
+	}
+
+	writeBatchResponse(w, responses)
+}
+
+// errorResponse returns the JSON-RPC error response of the call with the id.
+func errorResponse(id json.RawMessage, code int, msg string) json.RawMessage {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+
+	type errorMessage struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	bz, _ := json.Marshal(struct { // #nosec G703
+		Jsonrpc string          `json:"jsonrpc"`
+		ID      json.RawMessage `json:"id"`
+		Error   errorMessage    `json:"error"`
+	}{"2.0", id, errorMessage{code, msg}})
+	return bz
+}
+
+// writeBatchResponse writes the responses of the calls of a batch, or nothing
+// if the batch only has notifications.
+func writeBatchResponse(w http.ResponseWriter, responses []json.RawMessage) {
+	w.Header().Set("Content-Type", "application/json")
+	if len(responses) == 0 {
+		return
+	}
+
+	bz, _ := json.Marshal(responses) // #nosec G703
+	_, _ = w.Write(bz)               // #nosec G703
+}
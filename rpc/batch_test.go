@@ -0,0 +1,191 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testCall returns a call of the test JSON-RPC server, a notification if the
+// id is 0.
+func testCall(id int, method string) string {
+	if id == 0 {
+		return fmt.Sprintf(`{"jsonrpc":"2.0","method":"%s","params":[]}`, method)
+	}
+	return fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"%s","params":[]}`, id, method)
+}
+
+// testBatch returns the batch of the calls.
+func testBatch(calls ...string) string {
+	return "[" + strings.Join(calls, ",") + "]"
+}
+
+// batchResponse is a response of a batch call.
+type batchResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// httpCall serves the body with the handler and returns the response body.
+func httpCall(t *testing.T, handler http.Handler, body string) []byte {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+	return w.Body.Bytes()
+}
+
+// parseBatchResponse parses the responses of a batch.
+func parseBatchResponse(t *testing.T, bz []byte) []batchResponse {
+	var responses []batchResponse
+	require.NoError(t, json.Unmarshal(bz, &responses), string(bz))
+	return responses
+}
+
+func TestNewBatchLimitHandler(t *testing.T) {
+	rpcServer, _ := newTestRPCServer(t)
+	require.Equal(t, http.Handler(rpcServer), NewBatchLimitHandler(rpcServer, 0, 0))
+}
+
+func TestBatchRequestLimit(t *testing.T) {
+	tooLarge := []string{
+		testBatch(testCall(0, "eth_blockNumber"), testCall(2, "eth_blockNumber"), testCall(3, "eth_blockNumber")),
+		// the JSON-RPC server ignores the bytes after the batch
+		testBatch(testCall(0, "eth_blockNumber"), testCall(2, "eth_blockNumber"), testCall(3, "eth_blockNumber")) + "x",
+	}
+	allowed := testBatch(testCall(1, "eth_blockNumber"), testCall(2, "eth_blockNumber")) + "x"
+
+	checkTooLarge := func(t *testing.T, bz []byte) {
+		// a single error with the id of the first call
+		responses := parseBatchResponse(t, bz)
+		require.Len(t, responses, 1)
+		require.Equal(t, "2", string(responses[0].ID))
+		require.Equal(t, errCodeBatchTooLarge, responses[0].Error.Code)
+		require.Equal(t, errMsgBatchTooLarge, responses[0].Error.Message)
+	}
+
+	t.Run("http", func(t *testing.T) {
+		rpcServer, calls := newTestRPCServer(t)
+		handler := NewBatchLimitHandler(rpcServer, 2, 0)
+
+		for _, body := range tooLarge {
+			checkTooLarge(t, httpCall(t, handler, body))
+		}
+		require.Empty(t, calls())
+
+		require.Len(t, parseBatchResponse(t, httpCall(t, handler, allowed)), 2)
+		require.Len(t, calls(), 2)
+	})
+
+	t.Run("websockets", func(t *testing.T) {
+		rpcServer, calls := newTestRPCServer(t)
+		conn := dialTestWebsocketsServer(t, &websocketsServer{rpcServer: NewBatchLimitHandler(rpcServer, 2, 0)})
+
+		for _, body := range tooLarge {
+			checkTooLarge(t, wsCall(t, conn, body))
+		}
+		require.Empty(t, calls())
+
+		require.Len(t, parseBatchResponse(t, wsCall(t, conn, allowed)), 2)
+		require.Len(t, calls(), 2)
+	})
+}
+
+func TestBatchResponseMaxSize(t *testing.T) {
+	rpcServer, calls := newTestRPCServer(t)
+	// a single trace response fits, the calls after the second one get an
+	// error
+	handler := NewBatchLimitHandler(rpcServer, 0, 150)
+
+	body := testBatch(
+		testCall(1, "debug_traceBlockByNumber"),
+		testCall(0, "debug_traceBlockByNumber"),
+		testCall(2, "debug_traceBlockByNumber"),
+		testCall(3, "debug_traceBlockByNumber"),
+		testCall(0, "eth_blockNumber"),
+		testCall(4, "eth_blockNumber"),
+	) + "x"
+
+	responses := parseBatchResponse(t, httpCall(t, handler, body))
+	require.Len(t, responses, 4)
+	for i, res := range responses[:2] {
+		require.Equal(t, fmt.Sprint(i+1), string(res.ID))
+		require.Nil(t, res.Error)
+		require.Equal(t, `"`+strings.Repeat("a", 100)+`"`, string(res.Result))
+	}
+	for i, res := range responses[2:] {
+		require.Equal(t, fmt.Sprint(i+3), string(res.ID))
+		require.Equal(t, errCodeResponseTooLarge, res.Error.Code)
+		require.Equal(t, errMsgResponseTooLarge, res.Error.Message)
+	}
+	// the notification before the limit is served
+	require.Equal(t, []string{"debug_traceBlockByNumber", "debug_traceBlockByNumber", "debug_traceBlockByNumber"}, calls())
+}
+
+func TestBatchNotifications(t *testing.T) {
+	rpcServer, calls := newTestRPCServer(t)
+	handler := NewBatchLimitHandler(rpcServer, 2, 1000)
+
+	// notifications count in the request limit
+	responses := parseBatchResponse(t, httpCall(t, handler, testBatch(
+		testCall(0, "eth_blockNumber"), testCall(0, "eth_blockNumber"), testCall(1, "eth_blockNumber"),
+	)))
+	require.Len(t, responses, 1)
+	require.Equal(t, errCodeBatchTooLarge, responses[0].Error.Code)
+	require.Empty(t, calls())
+
+	// only the calls have a response
+	responses = parseBatchResponse(t, httpCall(t, handler, testBatch(testCall(0, "eth_blockNumber"), testCall(1, "eth_blockNumber"))))
+	require.Len(t, responses, 1)
+	require.Equal(t, "1", string(responses[0].ID))
+	require.Nil(t, responses[0].Error)
+
+	// nothing is written for the batches of notifications
+	require.Empty(t, httpCall(t, handler, testBatch(testCall(0, "eth_blockNumber"), testCall(0, "eth_blockNumber"))))
+	require.Len(t, calls(), 4)
+}
+
+func TestBatchMalformed(t *testing.T) {
+	rpcServer, calls := newTestRPCServer(t)
+	handler := NewBatchLimitHandler(rpcServer, 2, 1000)
+
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"invalid json", `[{"jsonrpc":"2.0","id":1,"method":`},
+		{"empty batch", `[]`},
+		{"invalid calls", `[1,2]`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the JSON-RPC server responds with the errors
+			bz := httpCall(t, handler, tc.body)
+			var responses []batchResponse
+			if json.Unmarshal(bz, &responses) != nil {
+				responses = []batchResponse{{}}
+				require.NoError(t, json.Unmarshal(bz, &responses[0]))
+			}
+			require.NotEmpty(t, responses)
+			for _, res := range responses {
+				require.NotNil(t, res.Error)
+				require.NotEqual(t, errMsgBatchTooLarge, res.Error.Message)
+			}
+		})
+	}
+
+	// the single requests aren't batches
+	require.NotContains(t, string(httpCall(t, handler, testCall(1, "eth_blockNumber")+"x")), "error")
+	require.Equal(t, []string{"eth_blockNumber"}, calls())
+}
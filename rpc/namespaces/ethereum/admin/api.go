@@ -0,0 +1,188 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package admin
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	ethp2p "github.com/ethereum/go-ethereum/p2p"
+)
+
+// protocolName is the name of the protocol of the node and peers info, as
+// the peers speak CometBFT instead of the ethereum sub-protocols.
+const protocolName = "cometbft"
+
+// PeerDialer is implemented by the clients able to dial peers, as the local
+// client of the node.
+type PeerDialer interface {
+	DialPeers(ctx context.Context, peers []string, persistent, unconditional, private bool) (*coretypes.ResultDialPeers, error)
+}
+
+// PeerRemover is implemented by the clients able to disconnect from peers,
+// which CometBFT doesn't expose over RPC.
+type PeerRemover interface {
+	RemovePeer(ctx context.Context, id p2p.ID) (bool, error)
+}
+
+// API is the admin prefixed set of APIs of geth, mapped to the peers and
+// node info of CometBFT.
+type API struct {
+	ctx      *server.Context
+	logger   log.Logger
+	tmClient rpcclient.Client
+}
+
+// NewAPI creates an instance of the Admin API.
+func NewAPI(ctx *server.Context, clientCtx client.Context) *API {
+	return &API{
+		ctx:      ctx,
+		logger:   ctx.Logger.With("api", "admin"),
+		tmClient: clientCtx.Client.(rpcclient.Client),
+	}
+}
+
+// NodeInfo returns the info of the node, from the CometBFT status.
+func (a *API) NodeInfo() (*ethp2p.NodeInfo, error) {
+	a.logger.Debug("admin_nodeInfo")
+
+	status, err := a.tmClient.Status(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo := status.NodeInfo
+	info := &ethp2p.NodeInfo{
+		ID:         string(nodeInfo.ID()),
+		Name:       nodeInfo.Moniker,
+		Enode:      p2p.IDAddressString(nodeInfo.ID(), nodeInfo.ListenAddr),
+		ListenAddr: trimProtocol(nodeInfo.ListenAddr),
+		Protocols:  protocolInfo(nodeInfo),
+	}
+	info.IP, info.Ports.Listener = splitHostPort(nodeInfo.ListenAddr)
+	return info, nil
+}
+
+// Peers returns the info of the connected peers, from the CometBFT net info.
+func (a *API) Peers() ([]*ethp2p.PeerInfo, error) {
+	a.logger.Debug("admin_peers")
+
+	netInfo, err := a.tmClient.NetInfo(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*ethp2p.PeerInfo, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		nodeInfo := peer.NodeInfo
+
+		// the peers listen on the port they advertise, at their remote IP
+		_, port := splitHostPort(nodeInfo.ListenAddr)
+		remoteAddr := net.JoinHostPort(peer.RemoteIP, strconv.Itoa(port))
+
+		info := &ethp2p.PeerInfo{
+			Enode:     p2p.IDAddressString(nodeInfo.ID(), remoteAddr),
+			ID:        string(nodeInfo.ID()),
+			Name:      nodeInfo.Moniker,
+			Caps:      []string{fmt.Sprintf("%s/%d", protocolName, nodeInfo.ProtocolVersion.P2P)},
+			Protocols: protocolInfo(nodeInfo),
+		}
+		info.Network.RemoteAddress = remoteAddr
+		info.Network.Inbound = !peer.IsOutbound
+		peers = append(peers, info)
+	}
+	return peers, nil
+}
+
+// AddPeer dials the peer of the url, with the `<id>@<host>:<port>` format of
+// CometBFT. The peer isn't persistent, unlike the static nodes of geth, as
+// CometBFT can't remove the persistent peers.
+func (a *API) AddPeer(url string) (bool, error) {
+	a.logger.Debug("admin_addPeer", "url", url)
+
+	dialer, ok := a.tmClient.(PeerDialer)
+	if !ok {
+		return false, errors.New("adding peers is not supported by the node client")
+	}
+
+	if _, err := p2p.NewNetAddressString(url); err != nil {
+		return false, fmt.Errorf("invalid peer url: %w", err)
+	}
+
+	if _, err := dialer.DialPeers(context.Background(), []string{url}, false, false, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RemovePeer disconnects from the peer of the url, with the
+// `<id>@<host>:<port>` format of CometBFT or the peer id alone. Returns false
+// if the peer isn't connected.
+func (a *API) RemovePeer(url string) (bool, error) {
+	a.logger.Debug("admin_removePeer", "url", url)
+
+	remover, ok := a.tmClient.(PeerRemover)
+	if !ok {
+		return false, errors.New("removing peers is not supported by the node client")
+	}
+
+	id, _, _ := strings.Cut(trimProtocol(url), "@")
+	if bz, err := hex.DecodeString(id); err != nil || len(bz) != p2p.IDByteLength {
+		return false, fmt.Errorf("invalid peer url %s, expect: <id>@<host>:<port>", url)
+	}
+
+	return remover.RemovePeer(context.Background(), p2p.ID(id))
+}
+
+// Datadir returns the home directory of the node.
+func (a *API) Datadir() string {
+	a.logger.Debug("admin_datadir")
+	return a.ctx.Config.RootDir
+}
+
+// protocolInfo returns the protocol info of the node.
+func protocolInfo(nodeInfo p2p.DefaultNodeInfo) map[string]interface{} {
+	return map[string]interface{}{
+		protocolName: map[string]interface{}{
+			"network": nodeInfo.Network,
+			"version": nodeInfo.Version,
+			"p2p":     nodeInfo.ProtocolVersion.P2P,
+			"block":   nodeInfo.ProtocolVersion.Block,
+			"app":     nodeInfo.ProtocolVersion.App,
+		},
+	}
+}
+
+// splitHostPort returns the host and port of the address, with an optional
+// protocol prefix. Returns a zero port if the address can't be parsed.
+func splitHostPort(addr string) (string, int) {
+	host, portStr, err := net.SplitHostPort(trimProtocol(addr))
+	if err != nil {
+		return "", 0
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return host, 0
+	}
+	return host, port
+}
+
+// trimProtocol removes the protocol prefix of the address, eg. `tcp://`.
+func trimProtocol(addr string) string {
+	if _, rest, found := strings.Cut(addr, "://"); found {
+		return rest
+	}
+	return addr
+}
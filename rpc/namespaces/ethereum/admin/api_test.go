@@ -0,0 +1,94 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	nodeID = p2p.ID(strings.Repeat("ab", p2p.IDByteLength))
+	peerID = p2p.ID(strings.Repeat("cd", p2p.IDByteLength))
+)
+
+// nodeClient is the node client stub, dialing and removing peers.
+type nodeClient struct {
+	rpcclient.Client
+	dialed  []string
+	removed []p2p.ID
+}
+
+func (c *nodeClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{NodeInfo: p2p.DefaultNodeInfo{
+		DefaultNodeID: nodeID,
+		ListenAddr:    "tcp://0.0.0.0:26656",
+		Network:       "byte_9000-1",
+		Moniker:       "node0",
+	}}, nil
+}
+
+func (c *nodeClient) NetInfo(context.Context) (*coretypes.ResultNetInfo, error) {
+	return &coretypes.ResultNetInfo{Peers: []coretypes.Peer{{
+		NodeInfo: p2p.DefaultNodeInfo{
+			DefaultNodeID: peerID,
+			ListenAddr:    "tcp://0.0.0.0:26656",
+			Moniker:       "node1",
+		},
+		RemoteIP: "10.0.0.2",
+	}}}, nil
+}
+
+func (c *nodeClient) DialPeers(_ context.Context, peers []string, _, _, _ bool) (*coretypes.ResultDialPeers, error) {
+	c.dialed = append(c.dialed, peers...)
+	return &coretypes.ResultDialPeers{}, nil
+}
+
+func (c *nodeClient) RemovePeer(_ context.Context, id p2p.ID) (bool, error) {
+	c.removed = append(c.removed, id)
+	return id == peerID, nil
+}
+
+func TestAPI(t *testing.T) {
+	client := &nodeClient{}
+	api := &API{logger: log.NewNopLogger(), tmClient: client}
+
+	info, err := api.NodeInfo()
+	require.NoError(t, err)
+	require.Equal(t, string(nodeID), info.ID)
+	require.Equal(t, string(nodeID)+"@0.0.0.0:26656", info.Enode)
+	require.Equal(t, "0.0.0.0", info.IP)
+	require.Equal(t, 26656, info.Ports.Listener)
+
+	peers, err := api.Peers()
+	require.NoError(t, err)
+	require.Len(t, peers, 1)
+	require.Equal(t, string(peerID)+"@10.0.0.2:26656", peers[0].Enode)
+	require.Equal(t, "10.0.0.2:26656", peers[0].Network.RemoteAddress)
+	require.True(t, peers[0].Network.Inbound)
+
+	ok, err := api.AddPeer(peers[0].Enode)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []string{peers[0].Enode}, client.dialed)
+
+	_, err = api.AddPeer("10.0.0.2:26656")
+	require.Error(t, err)
+
+	ok, err = api.RemovePeer(peers[0].Enode)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = api.RemovePeer(string(nodeID))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = api.RemovePeer("node1")
+	require.Error(t, err)
+	require.Equal(t, []p2p.ID{peerID, nodeID}, client.removed)
+}
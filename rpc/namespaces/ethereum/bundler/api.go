@@ -0,0 +1,89 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package bundler
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PublicAPI is the set of ERC-4337 methods of the eth namespace served by
+// the bundler.
+type PublicAPI struct {
+	logger  log.Logger
+	bundler *Bundler
+}
+
+// NewPublicAPI creates an instance of the ERC-4337 eth API.
+func NewPublicAPI(logger log.Logger, bundler *Bundler) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("api", "bundler"),
+		bundler: bundler,
+	}
+}
+
+// SendUserOperation validates the user operation and adds it to the mempool
+// of the bundler. It returns the hash of the operation.
+func (api *PublicAPI) SendUserOperation(op UserOperation, entryPoint common.Address) (common.Hash, error) {
+	api.logger.Debug("eth_sendUserOperation", "sender", op.Sender.Hex(), "entry-point", entryPoint.Hex())
+	return api.bundler.SendUserOperation(op, entryPoint)
+}
+
+// EstimateUserOperationGas estimates the gas limits of the user operation.
+func (api *PublicAPI) EstimateUserOperationGas(op UserOperation, entryPoint common.Address) (*UserOperationGasEstimate, error) {
+	api.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender.Hex(), "entry-point", entryPoint.Hex())
+	return api.bundler.EstimateUserOperationGas(op, entryPoint)
+}
+
+// GetUserOperationByHash returns the user operation of the given hash.
+func (api *PublicAPI) GetUserOperationByHash(hash common.Hash) (*UserOperationByHash, error) {
+	api.logger.Debug("eth_getUserOperationByHash", "hash", hash.Hex())
+	return api.bundler.GetUserOperationByHash(hash)
+}
+
+// GetUserOperationReceipt returns the receipt of the user operation of the
+// given hash.
+func (api *PublicAPI) GetUserOperationReceipt(hash common.Hash) (*UserOperationReceipt, error) {
+	api.logger.Debug("eth_getUserOperationReceipt", "hash", hash.Hex())
+	return api.bundler.GetUserOperationReceipt(hash)
+}
+
+// SupportedEntryPoints returns the EntryPoints served by the bundler.
+func (api *PublicAPI) SupportedEntryPoints() []common.Address {
+	api.logger.Debug("eth_supportedEntryPoints")
+	return api.bundler.SupportedEntryPoints()
+}
+
+// API is the bundler prefixed set of methods managing the bundler, as the
+// debug methods of the reference bundler.
+type API struct {
+	logger  log.Logger
+	bundler *Bundler
+}
+
+// NewAPI creates an instance of the bundler API.
+func NewAPI(logger log.Logger, bundler *Bundler) *API {
+	return &API{
+		logger:  logger.With("api", "bundler"),
+		bundler: bundler,
+	}
+}
+
+// DumpMempool returns the user operations of the EntryPoint in the mempool.
+func (api *API) DumpMempool(entryPoint common.Address) ([]UserOperation, error) {
+	api.logger.Debug("bundler_dumpMempool", "entry-point", entryPoint.Hex())
+	return api.bundler.DumpMempool(entryPoint)
+}
+
+// SendBundleNow submits the pending user operations without waiting for the
+// bundler interval. It returns the hashes of the bundle transactions.
+func (api *API) SendBundleNow() ([]common.Hash, error) {
+	api.logger.Debug("bundler_sendBundleNow")
+	return api.bundler.SendBundleNow()
+}
+
+// ClearMempool drops all the user operations of the mempool.
+func (api *API) ClearMempool() {
+	api.logger.Debug("bundler_clearMempool")
+	api.bundler.ClearMempool()
+}
@@ -0,0 +1,558 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/eth/filters"
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	"github.com/kato114/byte/v15/server/config"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+const (
+	// minValidity is the min time in seconds the v0.6 operations must remain
+	// valid to be accepted in the mempool
+	minValidity = 30
+	// maxEstimationGas is the max verification gas of the gas estimation
+	maxEstimationGas = 10_000_000
+	// estimationTolerance is the precision of the verification gas found by
+	// the binary search of the v0.7 gas estimation
+	estimationTolerance = 1000
+)
+
+// Backend defines the methods of the JSON-RPC backend used by the bundler.
+type Backend interface {
+	filters.Backend
+
+	BlockNumber() (hexutil.Uint64, error)
+	ChainID() (*hexutil.Big, error)
+	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (*evmtypes.MsgEthereumTxResponse, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
+	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
+	GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error)
+	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	RPCGasCap() uint64
+}
+
+// Config defines the settings of the bundler.
+type Config struct {
+	// EntryPoints are the served EntryPoints
+	EntryPoints []common.Address
+	// Signer is the address of the keyring key signing the bundles
+	Signer common.Address
+	// Beneficiary is the address receiving the fees of the bundles
+	Beneficiary common.Address
+	// Interval is the interval between the bundles
+	Interval time.Duration
+	// MaxBundleSize is the max number of operations of a bundle
+	MaxBundleSize int
+	// MempoolSize is the max number of operations in the mempool
+	MempoolSize int
+}
+
+// NewConfig returns the bundler settings of the JSON-RPC configuration,
+// resolving the address of the bundler key in the keyring.
+func NewConfig(cfg config.JSONRPCConfig, kr keyring.Keyring) (Config, error) {
+	if kr == nil {
+		return Config{}, errors.New("the bundler requires a keyring")
+	}
+
+	record, err := kr.Key(cfg.BundlerKey)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to find the bundler key %s: %w", cfg.BundlerKey, err)
+	}
+
+	addr, err := record.GetAddress()
+	if err != nil {
+		return Config{}, err
+	}
+
+	signer := common.BytesToAddress(addr)
+	beneficiary := signer
+	if cfg.BundlerBeneficiary != "" {
+		beneficiary = common.HexToAddress(cfg.BundlerBeneficiary)
+	}
+
+	entryPoints := make([]common.Address, len(cfg.BundlerEntryPoints))
+	for i, entryPoint := range cfg.BundlerEntryPoints {
+		entryPoints[i] = common.HexToAddress(entryPoint)
+	}
+
+	return Config{
+		EntryPoints:   entryPoints,
+		Signer:        signer,
+		Beneficiary:   beneficiary,
+		Interval:      cfg.BundlerInterval,
+		MaxBundleSize: cfg.BundlerMaxBundleSize,
+		MempoolSize:   cfg.BundlerMempoolSize,
+	}, nil
+}
+
+// Bundler validates the ERC-4337 user operations by simulating them against
+// the EntryPoints, keeps them in its mempool and periodically submits them
+// in handleOps bundles signed by the bundler key of the node keyring.
+type Bundler struct {
+	logger      log.Logger
+	backend     Backend
+	cfg         Config
+	entryPoints map[common.Address]*entryPoint
+	mempool     *mempool
+
+	// bundleMu serializes the bundles, so that an operation isn't submitted
+	// twice
+	bundleMu sync.Mutex
+}
+
+// NewBundler creates a bundler of the given EntryPoints, which must be
+// deployed at their canonical addresses.
+func NewBundler(logger log.Logger, backend Backend, cfg Config) (*Bundler, error) {
+	entryPoints := make(map[common.Address]*entryPoint, len(cfg.EntryPoints))
+	for _, address := range cfg.EntryPoints {
+		ep, err := newEntryPoint(address)
+		if err != nil {
+			return nil, err
+		}
+		entryPoints[address] = ep
+	}
+
+	return &Bundler{
+		logger:      logger.With("module", "bundler"),
+		backend:     backend,
+		cfg:         cfg,
+		entryPoints: entryPoints,
+		mempool:     newMempool(cfg.MempoolSize),
+	}, nil
+}
+
+// Start submits the pending operations at each bundler interval.
+func (b *Bundler) Start() {
+	go func() {
+		ticker := time.NewTicker(b.cfg.Interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if _, err := b.SendBundleNow(); err != nil {
+				b.logger.Error("failed to send bundle", "error", err.Error())
+			}
+		}
+	}()
+}
+
+// SupportedEntryPoints returns the EntryPoints served by the bundler.
+func (b *Bundler) SupportedEntryPoints() []common.Address {
+	return b.cfg.EntryPoints
+}
+
+// entryPoint returns the served EntryPoint of the given address.
+func (b *Bundler) entryPoint(address common.Address) (*entryPoint, error) {
+	ep, ok := b.entryPoints[address]
+	if !ok {
+		return nil, newRPCError(ErrCodeInvalidFields, fmt.Sprintf("unsupported EntryPoint %s", address))
+	}
+	return ep, nil
+}
+
+// chainID returns the EIP-155 chain ID signed by the operations.
+func (b *Bundler) chainID() (*big.Int, error) {
+	chainID, err := b.backend.ChainID()
+	if err != nil {
+		return nil, err
+	}
+	return chainID.ToInt(), nil
+}
+
+// SendUserOperation validates the operation and adds it to the mempool. It
+// returns the hash of the operation.
+func (b *Bundler) SendUserOperation(op UserOperation, entryPointAddr common.Address) (common.Hash, error) {
+	ep, err := b.entryPoint(entryPointAddr)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := b.validateUserOperation(ep, op); err != nil {
+		return common.Hash{}, err
+	}
+
+	chainID, err := b.chainID()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	hash := ep.hash(op, chainID)
+	if err := b.mempool.add(&mempoolEntry{op: op, entryPoint: ep.address, hash: hash}); err != nil {
+		return common.Hash{}, newRPCError(ErrCodeInvalidFields, err.Error())
+	}
+
+	b.logger.Debug("user operation added to the mempool", "hash", hash.Hex(), "sender", op.Sender.Hex())
+	return hash, nil
+}
+
+// validateUserOperation returns an error if the fields of the operation are
+// invalid or if the EntryPoint rejects it. The v0.6 operations are validated
+// with simulateValidation, the v0.7 ones by simulating their handleOps
+// bundle, as the EntryPointSimulations of v0.7 isn't deployed on-chain.
+func (b *Bundler) validateUserOperation(ep *entryPoint, op UserOperation) error {
+	if err := op.ValidateBasic(ep.version, true); err != nil {
+		return newRPCError(ErrCodeInvalidFields, err.Error())
+	}
+
+	preVerificationGas, err := ep.preVerificationGas(op)
+	if err != nil {
+		return newRPCError(ErrCodeInvalidFields, err.Error())
+	}
+
+	if op.PreVerificationGas.ToInt().Cmp(preVerificationGas) < 0 {
+		return newRPCError(ErrCodeInvalidFields, fmt.Sprintf("preVerificationGas too low, expected at least %s", preVerificationGas))
+	}
+
+	if ep.version == evmtypes.EntryPointV07 {
+		return b.simulateHandleOps(ep, []UserOperation{op})
+	}
+
+	result, err := b.simulateValidation(ep, op)
+	if err != nil {
+		return err
+	}
+
+	if result.SigFailed {
+		return newRPCError(ErrCodeInvalidSignature, "invalid UserOperation signature or paymaster signature")
+	}
+
+	now := time.Now().Unix()
+	if result.ValidAfter.Int64() > now {
+		return newRPCError(ErrCodeExpiresShortly, "user operation is not valid yet")
+	}
+
+	if result.ValidUntil.Sign() > 0 && result.ValidUntil.Int64() < now+minValidity {
+		return newRPCError(ErrCodeExpiresShortly, "user operation expires shortly")
+	}
+
+	return nil
+}
+
+// simulateValidation returns the validation result of simulateValidation
+// for the v0.6 operation, which always reverts.
+func (b *Bundler) simulateValidation(ep *entryPoint, op UserOperation) (*validationResult, error) {
+	data, err := ep.packSimulateValidation(op)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = b.call(ep, data)
+	if err == nil {
+		return nil, errors.New("simulateValidation didn't revert with the validation result")
+	}
+
+	revert, ok := revertData(err)
+	if !ok {
+		return nil, err
+	}
+
+	result, ok := ep.unpackValidationResult(revert)
+	if !ok {
+		return nil, ep.revertError(revert)
+	}
+
+	return result, nil
+}
+
+// simulateHandleOps returns an error if the bundle of the given operations
+// reverts.
+func (b *Bundler) simulateHandleOps(ep *entryPoint, ops []UserOperation) error {
+	data, err := ep.packHandleOps(ops, b.cfg.Beneficiary)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.call(ep, data)
+	if revert, ok := revertData(err); ok {
+		return ep.revertError(revert)
+	}
+
+	return err
+}
+
+// call simulates the call of the EntryPoint from the bundler key on the
+// latest block.
+func (b *Bundler) call(ep *entryPoint, data []byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	input := hexutil.Bytes(data)
+	args := evmtypes.TransactionArgs{
+		From:  &b.cfg.Signer,
+		To:    &ep.address,
+		Input: &input,
+	}
+	return b.backend.DoCall(args, rpctypes.EthLatestBlockNumber, nil)
+}
+
+// revertData returns the revert data of the EVM revert error.
+func revertData(err error) ([]byte, bool) {
+	var revertErr *evmtypes.RevertError
+	if !errors.As(err, &revertErr) {
+		return nil, false
+	}
+
+	reason, ok := revertErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+
+	data, err := hexutil.Decode(reason)
+	return data, err == nil
+}
+
+// UserOperationGasEstimate is the result of eth_estimateUserOperationGas.
+type UserOperationGasEstimate struct {
+	PreVerificationGas            *hexutil.Big `json:"preVerificationGas"`
+	VerificationGasLimit          *hexutil.Big `json:"verificationGasLimit"`
+	CallGasLimit                  *hexutil.Big `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *hexutil.Big `json:"paymasterVerificationGasLimit,omitempty"`
+}
+
+// EstimateUserOperationGas estimates the gas limits of the operation, whose
+// gas fields are optional. The call gas is estimated by calling the sender
+// from the EntryPoint, as the reference bundler. The v0.6 verification gas
+// is the pre-operation gas of simulateValidation, which ignores the
+// signature, while the v0.7 one is searched by simulating the handleOps
+// bundle, so the v0.7 operations need a signature passing the validation.
+func (b *Bundler) EstimateUserOperationGas(op UserOperation, entryPointAddr common.Address) (*UserOperationGasEstimate, error) {
+	ep, err := b.entryPoint(entryPointAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := op.ValidateBasic(ep.version, false); err != nil {
+		return nil, newRPCError(ErrCodeInvalidFields, err.Error())
+	}
+
+	// without fees, the simulations don't require the sender to prefund the
+	// operation
+	op.MaxFeePerGas = (*hexutil.Big)(new(big.Int))
+	op.MaxPriorityFeePerGas = (*hexutil.Big)(new(big.Int))
+
+	preVerificationGas, err := ep.preVerificationGas(op)
+	if err != nil {
+		return nil, newRPCError(ErrCodeInvalidFields, err.Error())
+	}
+	op.PreVerificationGas = (*hexutil.Big)(preVerificationGas)
+
+	callGasLimit, err := b.estimateCallGas(ep, op)
+	if err != nil {
+		return nil, err
+	}
+	op.CallGasLimit = (*hexutil.Big)(callGasLimit)
+
+	estimate := &UserOperationGasEstimate{
+		PreVerificationGas: op.PreVerificationGas,
+		CallGasLimit:       op.CallGasLimit,
+	}
+
+	maxGas := uint64(maxEstimationGas)
+	if gasCap := b.backend.RPCGasCap(); gasCap > 0 && gasCap/2 < maxGas {
+		maxGas = gasCap / 2
+	}
+
+	if ep.version == evmtypes.EntryPointV06 {
+		op.VerificationGasLimit = (*hexutil.Big)(new(big.Int).SetUint64(maxGas))
+		result, err := b.simulateValidation(ep, op)
+		if err != nil {
+			return nil, err
+		}
+		estimate.VerificationGasLimit = (*hexutil.Big)(result.PreOpGas)
+		return estimate, nil
+	}
+
+	verificationGasLimit, err := b.searchVerificationGas(ep, op, maxGas)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate.VerificationGasLimit = (*hexutil.Big)(verificationGasLimit)
+	if op.Paymaster != nil && op.PaymasterVerificationGasLimit == nil {
+		estimate.PaymasterVerificationGasLimit = estimate.VerificationGasLimit
+	}
+	return estimate, nil
+}
+
+// estimateCallGas estimates the gas of the call of the operation sender
+// from the EntryPoint.
+func (b *Bundler) estimateCallGas(ep *entryPoint, op UserOperation) (*big.Int, error) {
+	if len(op.CallData) == 0 {
+		return new(big.Int), nil
+	}
+
+	args := evmtypes.TransactionArgs{
+		From:  &ep.address,
+		To:    &op.Sender,
+		Input: &op.CallData,
+	}
+
+	gas, err := b.backend.EstimateGas(args, nil, nil)
+	if err != nil {
+		return nil, newRPCError(ErrCodeExecutionReverted, err.Error())
+	}
+
+	return new(big.Int).SetUint64(uint64(gas)), nil
+}
+
+// searchVerificationGas returns the lowest verification gas limit, within
+// the estimation tolerance, for which the handleOps bundle of the v0.7
+// operation succeeds. Without gas limits, the paymaster uses the same
+// verification gas limit.
+func (b *Bundler) searchVerificationGas(ep *entryPoint, op UserOperation, maxGas uint64) (*big.Int, error) {
+	setPaymasterGas := op.Paymaster != nil && op.PaymasterVerificationGasLimit == nil
+	if op.Paymaster != nil && op.PaymasterPostOpGasLimit == nil {
+		op.PaymasterPostOpGasLimit = (*hexutil.Big)(new(big.Int))
+	}
+
+	simulate := func(gas uint64) error {
+		op.VerificationGasLimit = (*hexutil.Big)(new(big.Int).SetUint64(gas))
+		if setPaymasterGas {
+			op.PaymasterVerificationGasLimit = op.VerificationGasLimit
+		}
+		return b.simulateHandleOps(ep, []UserOperation{op})
+	}
+
+	if err := simulate(maxGas); err != nil {
+		return nil, err
+	}
+
+	lo, hi := uint64(0), maxGas
+	for hi-lo > estimationTolerance {
+		mid := lo + (hi-lo)/2
+		if simulate(mid) == nil {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	return new(big.Int).SetUint64(hi), nil
+}
+
+// SendBundleNow submits the pending operations of each EntryPoint, and
+// returns the hashes of the bundle transactions.
+func (b *Bundler) SendBundleNow() ([]common.Hash, error) {
+	b.bundleMu.Lock()
+	defer b.bundleMu.Unlock()
+
+	var (
+		txHashes []common.Hash
+		lastErr  error
+	)
+
+	for _, address := range b.cfg.EntryPoints {
+		txHash, err := b.sendBundle(b.entryPoints[address])
+		if err != nil {
+			lastErr = fmt.Errorf("EntryPoint %s: %w", address, err)
+			continue
+		}
+		if txHash != (common.Hash{}) {
+			txHashes = append(txHashes, txHash)
+		}
+	}
+
+	return txHashes, lastErr
+}
+
+// sendBundle submits a handleOps bundle of the pending operations of the
+// EntryPoint, after dropping the operations that don't pass the simulation
+// anymore. It returns an empty hash if there is nothing to bundle.
+func (b *Bundler) sendBundle(ep *entryPoint) (common.Hash, error) {
+	entries := b.mempool.pending(ep.address, b.cfg.MaxBundleSize)
+
+	ops := make([]UserOperation, 0, len(entries))
+	hashes := make([]common.Hash, 0, len(entries))
+	for _, entry := range entries {
+		if err := b.simulateHandleOps(ep, []UserOperation{entry.op}); err != nil {
+			b.logger.Debug("dropping invalid user operation", "hash", entry.hash.Hex(), "error", err.Error())
+			b.mempool.remove(entry.hash)
+			continue
+		}
+		ops = append(ops, entry.op)
+		hashes = append(hashes, entry.hash)
+	}
+
+	// the operations passing the simulation alone may still fail in the
+	// bundle, such as the operations sharing a paymaster deposit, so the
+	// operations rejected by the bundle are dropped until it passes
+	var data []byte
+	for len(ops) > 0 {
+		var err error
+		data, err = ep.packHandleOps(ops, b.cfg.Beneficiary)
+		if err != nil {
+			return common.Hash{}, err
+		}
+
+		_, err = b.call(ep, data)
+		if err == nil {
+			break
+		}
+
+		revert, ok := revertData(err)
+		if !ok {
+			return common.Hash{}, fmt.Errorf("failed to simulate the bundle: %w", err)
+		}
+
+		index, ok := ep.failedOpIndex(revert)
+		if !ok || index < 0 || index >= len(ops) {
+			return common.Hash{}, fmt.Errorf("failed to simulate the bundle: %w", ep.revertError(revert))
+		}
+
+		b.logger.Debug("dropping user operation failing in the bundle", "hash", hashes[index].Hex(), "error", ep.revertError(revert).Error())
+		b.mempool.remove(hashes[index])
+		ops = append(ops[:index], ops[index+1:]...)
+		hashes = append(hashes[:index], hashes[index+1:]...)
+	}
+
+	if len(ops) == 0 {
+		return common.Hash{}, nil
+	}
+
+	input := hexutil.Bytes(data)
+	args := evmtypes.TransactionArgs{
+		From:  &b.cfg.Signer,
+		To:    &ep.address,
+		Input: &input,
+	}
+
+	// the bundle is estimated on the block it was simulated on
+	blockNr := rpctypes.EthLatestBlockNumber
+	gas, err := b.backend.EstimateGas(args, &blockNr, nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to estimate the bundle gas: %w", err)
+	}
+	args.Gas = &gas
+
+	txHash, err := b.backend.SendTransaction(args)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send the bundle: %w", err)
+	}
+
+	b.mempool.remove(hashes...)
+	b.logger.Info("bundle sent", "entry-point", ep.address.Hex(), "tx-hash", txHash.Hex(), "user-operations", len(ops))
+	return txHash, nil
+}
+
+// DumpMempool returns the operations of the EntryPoint in the mempool.
+func (b *Bundler) DumpMempool(entryPointAddr common.Address) ([]UserOperation, error) {
+	if _, err := b.entryPoint(entryPointAddr); err != nil {
+		return nil, err
+	}
+	return b.mempool.dump(entryPointAddr), nil
+}
+
+// ClearMempool drops all the operations of the mempool.
+func (b *Bundler) ClearMempool() {
+	b.mempool.clear()
+}
@@ -0,0 +1,343 @@
+package bundler
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// mockBackend is a bundler backend whose EntryPoint calls are handled by the
+// call function, and which records the sent bundles.
+type mockBackend struct {
+	Backend
+
+	call func(data []byte) error
+	sent []evmtypes.TransactionArgs
+}
+
+func (m *mockBackend) ChainID() (*hexutil.Big, error) {
+	return hexBig(1), nil
+}
+
+func (m *mockBackend) RPCGasCap() uint64 {
+	return 25_000_000
+}
+
+func (m *mockBackend) DoCall(args evmtypes.TransactionArgs, _ rpctypes.BlockNumber, _ *rpctypes.StateOverride) (*evmtypes.MsgEthereumTxResponse, error) {
+	if err := m.call(*args.Input); err != nil {
+		return nil, err
+	}
+	return &evmtypes.MsgEthereumTxResponse{}, nil
+}
+
+func (m *mockBackend) EstimateGas(_ evmtypes.TransactionArgs, _ *rpctypes.BlockNumber, _ *rpctypes.StateOverride) (hexutil.Uint64, error) {
+	return 1_000_000, nil
+}
+
+func (m *mockBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	m.sent = append(m.sent, args)
+	return common.BigToHash(big.NewInt(int64(len(m.sent)))), nil
+}
+
+// newTestBundler returns a bundler of the EntryPoint using the mock backend.
+func newTestBundler(t *testing.T, address common.Address, backend *mockBackend) (*Bundler, *entryPoint) {
+	b, err := NewBundler(log.NewNopLogger(), backend, Config{
+		EntryPoints:   []common.Address{address},
+		Signer:        common.HexToAddress("0x6000000000000000000000000000000000000006"),
+		Beneficiary:   common.HexToAddress("0x6000000000000000000000000000000000000006"),
+		Interval:      time.Second,
+		MaxBundleSize: 10,
+		MempoolSize:   10,
+	})
+	require.NoError(t, err)
+	return b, b.entryPoints[address]
+}
+
+// revertWith returns the EVM revert error of the given EntryPoint error.
+func revertWith(t *testing.T, ep *entryPoint, name string, args ...interface{}) error {
+	abiErr := ep.abi.Errors[name]
+	bz, err := abiErr.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return evmtypes.NewExecErrorWithReason(append(abiErr.ID[:4], bz...))
+}
+
+// validationResultRevert returns the ValidationResult revert of the v0.6
+// simulateValidation.
+func validationResultRevert(t *testing.T, ep *entryPoint, sigFailed bool, validUntil int64) error {
+	type returnInfo struct {
+		PreOpGas         *big.Int
+		Prefund          *big.Int
+		SigFailed        bool
+		ValidAfter       *big.Int
+		ValidUntil       *big.Int
+		PaymasterContext []byte
+	}
+	type stakeInfo struct {
+		Stake           *big.Int
+		UnstakeDelaySec *big.Int
+	}
+
+	stake := stakeInfo{Stake: new(big.Int), UnstakeDelaySec: new(big.Int)}
+	info := returnInfo{
+		PreOpGas:         big.NewInt(80000),
+		Prefund:          new(big.Int),
+		SigFailed:        sigFailed,
+		ValidAfter:       new(big.Int),
+		ValidUntil:       big.NewInt(validUntil),
+		PaymasterContext: []byte{},
+	}
+	return revertWith(t, ep, "ValidationResult", info, stake, stake, stake)
+}
+
+func TestValidateUserOperation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		address  common.Address
+		malleate func(ep *entryPoint) (UserOperation, func(data []byte) error)
+		expCode  int
+	}{
+		{
+			"pass - v0.6",
+			evmtypes.EntryPointV06Address,
+			func(ep *entryPoint) (UserOperation, func(data []byte) error) {
+				return userOpV06(1, 10), func([]byte) error {
+					return validationResultRevert(t, ep, false, 0)
+				}
+			},
+			0,
+		},
+		{
+			"fail - v0.6 signature failed",
+			evmtypes.EntryPointV06Address,
+			func(ep *entryPoint) (UserOperation, func(data []byte) error) {
+				return userOpV06(1, 10), func([]byte) error {
+					return validationResultRevert(t, ep, true, 0)
+				}
+			},
+			ErrCodeInvalidSignature,
+		},
+		{
+			"fail - v0.6 expires shortly",
+			evmtypes.EntryPointV06Address,
+			func(ep *entryPoint) (UserOperation, func(data []byte) error) {
+				return userOpV06(1, 10), func([]byte) error {
+					return validationResultRevert(t, ep, false, time.Now().Unix()+minValidity/2)
+				}
+			},
+			ErrCodeExpiresShortly,
+		},
+		{
+			"fail - v0.6 rejected by the account",
+			evmtypes.EntryPointV06Address,
+			func(ep *entryPoint) (UserOperation, func(data []byte) error) {
+				return userOpV06(1, 10), func([]byte) error {
+					return revertWith(t, ep, "FailedOp", big.NewInt(0), "AA23 reverted")
+				}
+			},
+			ErrCodeSimulateValidation,
+		},
+		{
+			"pass - v0.7",
+			evmtypes.EntryPointV07Address,
+			func(*entryPoint) (UserOperation, func(data []byte) error) {
+				return userOpV07(), func([]byte) error { return nil }
+			},
+			0,
+		},
+		{
+			"fail - v0.7 rejected by the paymaster",
+			evmtypes.EntryPointV07Address,
+			func(ep *entryPoint) (UserOperation, func(data []byte) error) {
+				return userOpV07(), func([]byte) error {
+					return revertWith(t, ep, "FailedOp", big.NewInt(0), "AA31 paymaster deposit too low")
+				}
+			},
+			ErrCodeSimulatePaymasterValidation,
+		},
+		{
+			"fail - pre-verification gas too low",
+			evmtypes.EntryPointV07Address,
+			func(*entryPoint) (UserOperation, func(data []byte) error) {
+				op := userOpV07()
+				op.PreVerificationGas = hexBig(1000)
+				return op, func([]byte) error { return nil }
+			},
+			ErrCodeInvalidFields,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &mockBackend{}
+			b, ep := newTestBundler(t, tc.address, backend)
+			op, call := tc.malleate(ep)
+			backend.call = call
+
+			err := b.validateUserOperation(ep, op)
+			if tc.expCode == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var rpcErr *rpcError
+			require.True(t, errors.As(err, &rpcErr), err)
+			require.Equal(t, tc.expCode, rpcErr.ErrorCode())
+		})
+	}
+}
+
+func TestSearchVerificationGas(t *testing.T) {
+	const requiredGas = 123456
+
+	backend := &mockBackend{}
+	b, ep := newTestBundler(t, evmtypes.EntryPointV07Address, backend)
+
+	// the bundle fails below the required verification gas
+	backend.call = func(data []byte) error {
+		ops, err := ep.unpackHandleOps(data)
+		require.NoError(t, err)
+		if ops[0].VerificationGasLimit.ToInt().Int64() < requiredGas {
+			return revertWith(t, ep, "FailedOp", big.NewInt(0), "AA13 initCode failed or OOG")
+		}
+		return nil
+	}
+
+	op := userOpV07()
+	op.PaymasterVerificationGasLimit = nil
+	gas, err := b.searchVerificationGas(ep, op, maxEstimationGas)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, gas.Int64(), int64(requiredGas))
+	require.LessOrEqual(t, gas.Int64(), int64(requiredGas+estimationTolerance))
+
+	// the bundle fails with the max gas
+	_, err = b.searchVerificationGas(ep, op, requiredGas-1)
+	require.Error(t, err)
+}
+
+func TestSendBundle(t *testing.T) {
+	other := common.HexToAddress("0x5000000000000000000000000000000000000005")
+	third := common.HexToAddress("0x7000000000000000000000000000000000000007")
+
+	// opOf returns the mempool entry of the v0.7 operation of the sender
+	opOf := func(sender common.Address) *mempoolEntry {
+		op := userOpV07()
+		op.Sender = sender
+		return &mempoolEntry{
+			op:         op,
+			entryPoint: evmtypes.EntryPointV07Address,
+			hash:       op.Hash(evmtypes.EntryPointV07, evmtypes.EntryPointV07Address, common.Big1),
+		}
+	}
+
+	testCases := []struct {
+		name string
+		// call returns the error of the bundle of the given senders
+		call       func(ep *entryPoint, senders []common.Address) error
+		expErr     bool
+		expBundle  []common.Address
+		expMempool []common.Address
+	}{
+		{
+			"pass - all the operations bundled",
+			func(*entryPoint, []common.Address) error { return nil },
+			false,
+			[]common.Address{sender, other, third},
+			nil,
+		},
+		{
+			"pass - invalid operation dropped",
+			func(ep *entryPoint, senders []common.Address) error {
+				for i, s := range senders {
+					if s == other {
+						return revertWith(t, ep, "FailedOp", big.NewInt(int64(i)), "AA23 reverted")
+					}
+				}
+				return nil
+			},
+			false,
+			[]common.Address{sender, third},
+			nil,
+		},
+		{
+			"pass - operations conflicting in the bundle dropped",
+			func(ep *entryPoint, senders []common.Address) error {
+				// the operations after the first one exhaust the paymaster
+				// deposit
+				if len(senders) > 1 {
+					return revertWith(t, ep, "FailedOp", big.NewInt(1), "AA31 paymaster deposit too low")
+				}
+				return nil
+			},
+			false,
+			[]common.Address{sender},
+			nil,
+		},
+		{
+			"fail - bundle reverted without failed operation",
+			func(ep *entryPoint, senders []common.Address) error {
+				if len(senders) > 1 {
+					return evmtypes.NewExecErrorWithReason([]byte{0x01, 0x02, 0x03, 0x04})
+				}
+				return nil
+			},
+			true,
+			nil,
+			[]common.Address{sender, other, third},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &mockBackend{}
+			b, ep := newTestBundler(t, evmtypes.EntryPointV07Address, backend)
+			backend.call = func(data []byte) error {
+				ops, err := ep.unpackHandleOps(data)
+				require.NoError(t, err)
+
+				senders := make([]common.Address, len(ops))
+				for i, op := range ops {
+					senders[i] = op.Sender
+				}
+				return tc.call(ep, senders)
+			}
+
+			for _, s := range []common.Address{sender, other, third} {
+				require.NoError(t, b.mempool.add(opOf(s)))
+			}
+
+			txHash, err := b.sendBundle(ep)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Empty(t, backend.sent)
+			} else {
+				require.NoError(t, err)
+				require.NotEqual(t, common.Hash{}, txHash)
+				require.Len(t, backend.sent, 1)
+
+				ops, err := ep.unpackHandleOps(*backend.sent[0].Input)
+				require.NoError(t, err)
+				senders := make([]common.Address, len(ops))
+				for i, op := range ops {
+					senders[i] = op.Sender
+				}
+				require.Equal(t, tc.expBundle, senders)
+			}
+
+			// the bundled and failed operations are removed from the mempool
+			var mempool []common.Address
+			for _, op := range b.mempool.dump(ep.address) {
+				mempool = append(mempool, op.Sender)
+			}
+			require.ElementsMatch(t, tc.expMempool, mempool)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package bundler
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+//go:embed abi/*.json
+var abiFS embed.FS
+
+// entryPoint is an ERC-4337 EntryPoint served by the bundler.
+type entryPoint struct {
+	address common.Address
+	version evmtypes.EntryPointVersion
+	abi     abi.ABI
+}
+
+// validationResult is the ReturnInfo of the ValidationResult error returned
+// by the simulateValidation method of the EntryPoint v0.6.
+type validationResult struct {
+	PreOpGas         *big.Int
+	Prefund          *big.Int
+	SigFailed        bool
+	ValidAfter       *big.Int
+	ValidUntil       *big.Int
+	PaymasterContext []byte
+}
+
+// newEntryPoint returns the EntryPoint deployed at the given canonical
+// address.
+func newEntryPoint(address common.Address) (*entryPoint, error) {
+	version, ok := evmtypes.EntryPointVersionOf(address)
+	if !ok {
+		return nil, fmt.Errorf(
+			"unsupported EntryPoint %s, expected %s (%s) or %s (%s)", address,
+			evmtypes.EntryPointV06Address, evmtypes.EntryPointV06, evmtypes.EntryPointV07Address, evmtypes.EntryPointV07,
+		)
+	}
+
+	name := fmt.Sprintf("abi/entrypoint_%s.json", strings.ReplaceAll(string(version), ".", ""))
+	bz, err := abiFS.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	entryPointABI, err := abi.JSON(bytes.NewReader(bz))
+	if err != nil {
+		return nil, fmt.Errorf("invalid EntryPoint %s ABI: %w", version, err)
+	}
+
+	return &entryPoint{
+		address: address,
+		version: version,
+		abi:     entryPointABI,
+	}, nil
+}
+
+// opStruct returns the struct of the operation encoded by the EntryPoint
+// ABI.
+func (ep *entryPoint) opStruct(op UserOperation) interface{} {
+	if ep.version == evmtypes.EntryPointV06 {
+		return op.toV06()
+	}
+	return op.toPacked()
+}
+
+// hash returns the hash of the operation on the given chain.
+func (ep *entryPoint) hash(op UserOperation, chainID *big.Int) common.Hash {
+	return op.Hash(ep.version, ep.address, chainID)
+}
+
+// packHandleOps returns the call data of handleOps for the given operations.
+func (ep *entryPoint) packHandleOps(ops []UserOperation, beneficiary common.Address) ([]byte, error) {
+	if ep.version == evmtypes.EntryPointV06 {
+		structs := make([]userOperationV06, len(ops))
+		for i, op := range ops {
+			structs[i] = op.toV06()
+		}
+		return ep.abi.Pack("handleOps", structs, beneficiary)
+	}
+
+	structs := make([]packedUserOperation, len(ops))
+	for i, op := range ops {
+		structs[i] = op.toPacked()
+	}
+	return ep.abi.Pack("handleOps", structs, beneficiary)
+}
+
+// unpackHandleOps returns the operations of the given handleOps call data.
+func (ep *entryPoint) unpackHandleOps(input []byte) ([]UserOperation, error) {
+	method := ep.abi.Methods["handleOps"]
+	if len(input) < 4 || !bytes.Equal(input[:4], method.ID) {
+		return nil, errors.New("not a handleOps call")
+	}
+
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	if ep.version == evmtypes.EntryPointV06 {
+		structs := *abi.ConvertType(values[0], new([]userOperationV06)).(*[]userOperationV06)
+		ops := make([]UserOperation, len(structs))
+		for i, op := range structs {
+			ops[i] = fromV06(op)
+		}
+		return ops, nil
+	}
+
+	structs := *abi.ConvertType(values[0], new([]packedUserOperation)).(*[]packedUserOperation)
+	ops := make([]UserOperation, len(structs))
+	for i, op := range structs {
+		ops[i] = fromPacked(op)
+	}
+	return ops, nil
+}
+
+// packSimulateValidation returns the call data of simulateValidation for the
+// given operation, only available on the EntryPoint v0.6.
+func (ep *entryPoint) packSimulateValidation(op UserOperation) ([]byte, error) {
+	if _, ok := ep.abi.Methods["simulateValidation"]; !ok {
+		return nil, fmt.Errorf("simulateValidation is not available on the EntryPoint %s", ep.version)
+	}
+	return ep.abi.Pack("simulateValidation", op.toV06())
+}
+
+// unpackValidationResult returns the ReturnInfo of the ValidationResult
+// revert of simulateValidation. The bool is false if the revert data isn't a
+// validation result.
+func (ep *entryPoint) unpackValidationResult(data []byte) (*validationResult, bool) {
+	for _, name := range []string{"ValidationResult", "ValidationResultWithAggregation"} {
+		abiErr, ok := ep.abi.Errors[name]
+		if !ok {
+			continue
+		}
+
+		unpacked, err := abiErr.Unpack(data)
+		if err != nil {
+			continue
+		}
+
+		values, ok := unpacked.([]interface{})
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		return abi.ConvertType(values[0], new(validationResult)).(*validationResult), true
+	}
+	return nil, false
+}
+
+// preVerificationGas returns the gas of the operation not metered by the
+// EntryPoint, paid for its call data and share of the bundle transaction,
+// as computed by the reference bundler.
+func (ep *entryPoint) preVerificationGas(op UserOperation) (*big.Int, error) {
+	op.PreVerificationGas = (*hexutil.Big)(big.NewInt(fixedGasOverhead))
+	if len(op.Signature) == 0 {
+		op.Signature = bytes.Repeat([]byte{1}, dummySignatureSize)
+	}
+
+	opType := ep.abi.Methods["handleOps"].Inputs[0].Type.Elem
+	packed, err := abi.Arguments{{Type: *opType}}.Pack(ep.opStruct(op))
+	if err != nil {
+		return nil, err
+	}
+
+	gas := uint64(fixedGasOverhead + perUserOpGasOverhead)
+	gas += perUserOpWordGas * uint64((len(packed)+31)/32)
+	for _, b := range packed {
+		if b == 0 {
+			gas += zeroByteGas
+		} else {
+			gas += nonZeroByteGas
+		}
+	}
+	return new(big.Int).SetUint64(gas), nil
+}
+
+// failedOpIndex returns the index in the bundle of the operation rejected by
+// the FailedOp errors of the given EntryPoint revert data. The bool is false
+// if the revert data isn't a FailedOp error.
+func (ep *entryPoint) failedOpIndex(data []byte) (int, bool) {
+	for _, name := range []string{"FailedOp", "FailedOpWithRevert"} {
+		abiErr, ok := ep.abi.Errors[name]
+		if !ok {
+			continue
+		}
+
+		unpacked, err := abiErr.Unpack(data)
+		if err != nil {
+			continue
+		}
+
+		index, ok := unpacked.([]interface{})[0].(*big.Int)
+		if !ok || !index.IsInt64() {
+			return 0, false
+		}
+		return int(index.Int64()), true
+	}
+	return 0, false
+}
+
+// revertError returns the JSON-RPC error of the given EntryPoint revert
+// data, decoding the FailedOp errors of the rejected operations.
+func (ep *entryPoint) revertError(data []byte) error {
+	for _, name := range []string{"FailedOp", "FailedOpWithRevert"} {
+		abiErr, ok := ep.abi.Errors[name]
+		if !ok {
+			continue
+		}
+
+		unpacked, err := abiErr.Unpack(data)
+		if err != nil {
+			continue
+		}
+
+		values := unpacked.([]interface{})
+		reason, _ := values[1].(string)
+
+		code := ErrCodeSimulateValidation
+		// the AA3x errors are raised by the paymaster validation
+		if strings.HasPrefix(reason, "AA3") {
+			code = ErrCodeSimulatePaymasterValidation
+		}
+		if strings.HasPrefix(reason, "AA24") || strings.HasPrefix(reason, "AA34") {
+			code = ErrCodeInvalidSignature
+		}
+
+		rpcErr := newRPCError(code, reason)
+		if len(values) > 2 {
+			inner, _ := values[2].([]byte)
+			rpcErr.data = hexutil.Encode(inner)
+		}
+		return rpcErr
+	}
+
+	if abiErr, ok := ep.abi.Errors["SignatureValidationFailed"]; ok {
+		if _, err := abiErr.Unpack(data); err == nil {
+			return newRPCError(ErrCodeInvalidSignature, "invalid signature of the aggregator")
+		}
+	}
+
+	rpcErr := newRPCError(ErrCodeSimulateValidation, "execution reverted")
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		rpcErr.message = fmt.Sprintf("execution reverted: %s", reason)
+	}
+	rpcErr.data = hexutil.Encode(data)
+	return rpcErr
+}
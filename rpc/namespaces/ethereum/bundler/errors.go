@@ -0,0 +1,51 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package bundler
+
+// JSON-RPC error codes of the ERC-4337 bundler API.
+const (
+	// ErrCodeInvalidFields is returned for the invalid user operation fields
+	ErrCodeInvalidFields = -32602
+	// ErrCodeSimulateValidation is returned when the EntryPoint rejects the
+	// user operation in the validation of the account or its creation
+	ErrCodeSimulateValidation = -32500
+	// ErrCodeSimulatePaymasterValidation is returned when the EntryPoint
+	// rejects the user operation in the validation of the paymaster
+	ErrCodeSimulatePaymasterValidation = -32501
+	// ErrCodeExpiresShortly is returned when the user operation is out of its
+	// validity time range
+	ErrCodeExpiresShortly = -32503
+	// ErrCodeInvalidSignature is returned for the invalid signatures of the
+	// account or paymaster
+	ErrCodeInvalidSignature = -32507
+	// ErrCodeExecutionReverted is returned when the call of the user
+	// operation reverts in the gas estimation
+	ErrCodeExecutionReverted = -32521
+)
+
+// rpcError is a JSON-RPC error with a code and optional data.
+type rpcError struct {
+	code    int
+	message string
+	data    interface{}
+}
+
+// newRPCError returns a JSON-RPC error with the given code and message.
+func newRPCError(code int, message string) *rpcError {
+	return &rpcError{code: code, message: message}
+}
+
+// Error implements the error interface.
+func (e *rpcError) Error() string {
+	return e.message
+}
+
+// ErrorCode returns the JSON-RPC error code.
+func (e *rpcError) ErrorCode() int {
+	return e.code
+}
+
+// ErrorData returns the JSON-RPC error data.
+func (e *rpcError) ErrorData() interface{} {
+	return e.data
+}
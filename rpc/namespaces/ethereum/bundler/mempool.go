@@ -0,0 +1,175 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package bundler
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// maxOpsPerSender is the max number of operations of a sender in the
+	// mempool (SAME_SENDER_MEMPOOL_COUNT of ERC-7562)
+	maxOpsPerSender = 4
+	// replacementFeeBump is the min fee bump in percents of the operations
+	// replacing an operation with the same sender and nonce
+	replacementFeeBump = 10
+)
+
+var (
+	errAlreadyKnown         = errors.New("user operation already known")
+	errReplaceUnderpriced   = errors.New("replacement user operation underpriced")
+	errMempoolFull          = errors.New("user operation mempool is full")
+	errSenderLimitExhausted = errors.New("too many user operations of the sender in the mempool")
+)
+
+// mempoolEntry is a user operation waiting to be bundled.
+type mempoolEntry struct {
+	op         UserOperation
+	entryPoint common.Address
+	hash       common.Hash
+}
+
+// mempool holds the validated user operations in their arrival order.
+type mempool struct {
+	mu      sync.Mutex
+	maxSize int
+	entries []*mempoolEntry
+}
+
+// newMempool returns an empty mempool of the given max size.
+func newMempool(maxSize int) *mempool {
+	return &mempool{maxSize: maxSize}
+}
+
+// add adds the entry to the mempool, replacing the operation with the same
+// EntryPoint, sender and nonce if its fees are bumped enough.
+func (m *mempool) add(entry *mempoolEntry) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	senderOps := 0
+	for i, existing := range m.entries {
+		if existing.hash == entry.hash {
+			return errAlreadyKnown
+		}
+
+		if existing.entryPoint != entry.entryPoint || existing.op.Sender != entry.op.Sender {
+			continue
+		}
+
+		if bigOrZero(existing.op.Nonce).Cmp(bigOrZero(entry.op.Nonce)) == 0 {
+			if !isFeeBumped(existing.op, entry.op) {
+				return errReplaceUnderpriced
+			}
+			m.entries[i] = entry
+			return nil
+		}
+		senderOps++
+	}
+
+	if senderOps >= maxOpsPerSender {
+		return errSenderLimitExhausted
+	}
+
+	if len(m.entries) >= m.maxSize {
+		return errMempoolFull
+	}
+
+	m.entries = append(m.entries, entry)
+	return nil
+}
+
+// isFeeBumped returns true if both fees of the replacement are bumped by at
+// least replacementFeeBump percents.
+func isFeeBumped(existing, replacement UserOperation) bool {
+	bumped := func(fee, replacementFee *big.Int) bool {
+		minFee := new(big.Int).Mul(fee, big.NewInt(100+replacementFeeBump))
+		return new(big.Int).Mul(replacementFee, big.NewInt(100)).Cmp(minFee) >= 0
+	}
+	return bumped(bigOrZero(existing.MaxFeePerGas), bigOrZero(replacement.MaxFeePerGas)) &&
+		bumped(bigOrZero(existing.MaxPriorityFeePerGas), bigOrZero(replacement.MaxPriorityFeePerGas))
+}
+
+// get returns the entry of the given operation hash.
+func (m *mempool) get(hash common.Hash) (*mempoolEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, entry := range m.entries {
+		if entry.hash == hash {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+// remove removes the entries of the given operation hashes.
+func (m *mempool) remove(hashes ...common.Hash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	removed := make(map[common.Hash]bool, len(hashes))
+	for _, hash := range hashes {
+		removed[hash] = true
+	}
+
+	entries := m.entries[:0]
+	for _, entry := range m.entries {
+		if !removed[entry.hash] {
+			entries = append(entries, entry)
+		}
+	}
+	// release the removed entries
+	for i := len(entries); i < len(m.entries); i++ {
+		m.entries[i] = nil
+	}
+	m.entries = entries
+}
+
+// pending returns up to max entries of the EntryPoint to bundle, in their
+// arrival order. A bundle holds a single operation of each sender, so the
+// next operations of the senders wait for the following bundles.
+func (m *mempool) pending(entryPoint common.Address, max int) []*mempoolEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var pending []*mempoolEntry
+	senders := make(map[common.Address]bool)
+	for _, entry := range m.entries {
+		if len(pending) >= max {
+			break
+		}
+		if entry.entryPoint != entryPoint || senders[entry.op.Sender] {
+			continue
+		}
+		senders[entry.op.Sender] = true
+		pending = append(pending, entry)
+	}
+	return pending
+}
+
+// dump returns the operations of the EntryPoint in their arrival order.
+func (m *mempool) dump(entryPoint common.Address) []UserOperation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ops := []UserOperation{}
+	for _, entry := range m.entries {
+		if entry.entryPoint == entryPoint {
+			ops = append(ops, entry.op)
+		}
+	}
+	return ops
+}
+
+// clear removes all the entries.
+func (m *mempool) clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries = nil
+}
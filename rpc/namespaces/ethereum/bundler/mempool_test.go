@@ -0,0 +1,75 @@
+package bundler
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// newEntry returns a mempool entry of the v0.6 operation of the sender.
+func newEntry(sender common.Address, nonce, fee int64) *mempoolEntry {
+	op := userOpV06(nonce, fee)
+	op.Sender = sender
+	return &mempoolEntry{
+		op:         op,
+		entryPoint: evmtypes.EntryPointV06Address,
+		hash:       op.Hash(evmtypes.EntryPointV06, evmtypes.EntryPointV06Address, common.Big1),
+	}
+}
+
+func TestMempoolAdd(t *testing.T) {
+	other := common.HexToAddress("0x5000000000000000000000000000000000000005")
+	pool := newMempool(6)
+
+	entry := newEntry(sender, 1, 100)
+	require.NoError(t, pool.add(entry))
+	require.ErrorIs(t, pool.add(entry), errAlreadyKnown)
+
+	// the replacements bump both fees by 10%
+	require.ErrorIs(t, pool.add(newEntry(sender, 1, 109)), errReplaceUnderpriced)
+	replacement := newEntry(sender, 1, 110)
+	require.NoError(t, pool.add(replacement))
+
+	_, found := pool.get(entry.hash)
+	require.False(t, found)
+	got, found := pool.get(replacement.hash)
+	require.True(t, found)
+	require.Equal(t, replacement, got)
+
+	// the senders hold a limited number of operations
+	for nonce := int64(2); nonce <= maxOpsPerSender; nonce++ {
+		require.NoError(t, pool.add(newEntry(sender, nonce, 10)))
+	}
+	require.ErrorIs(t, pool.add(newEntry(sender, maxOpsPerSender+1, 10)), errSenderLimitExhausted)
+
+	require.NoError(t, pool.add(newEntry(other, 1, 10)))
+	require.NoError(t, pool.add(newEntry(other, 2, 10)))
+	require.ErrorIs(t, pool.add(newEntry(other, 3, 10)), errMempoolFull)
+}
+
+func TestMempoolPending(t *testing.T) {
+	other := common.HexToAddress("0x5000000000000000000000000000000000000005")
+	pool := newMempool(10)
+
+	first := newEntry(sender, 1, 10)
+	second := newEntry(sender, 2, 10)
+	third := newEntry(other, 1, 10)
+	for _, entry := range []*mempoolEntry{first, second, third} {
+		require.NoError(t, pool.add(entry))
+	}
+
+	// a single operation of each sender per bundle
+	require.Equal(t, []*mempoolEntry{first, third}, pool.pending(evmtypes.EntryPointV06Address, 10))
+	require.Equal(t, []*mempoolEntry{first}, pool.pending(evmtypes.EntryPointV06Address, 1))
+	require.Empty(t, pool.pending(evmtypes.EntryPointV07Address, 10))
+
+	pool.remove(first.hash, third.hash)
+	require.Equal(t, []*mempoolEntry{second}, pool.pending(evmtypes.EntryPointV06Address, 10))
+	require.Equal(t, []UserOperation{second.op}, pool.dump(evmtypes.EntryPointV06Address))
+
+	pool.clear()
+	require.Empty(t, pool.dump(evmtypes.EntryPointV06Address))
+}
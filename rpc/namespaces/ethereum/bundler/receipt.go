@@ -0,0 +1,222 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package bundler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/kato114/byte/v15/rpc/namespaces/ethereum/eth/filters"
+)
+
+// UserOperationByHash is the result of eth_getUserOperationByHash. The
+// block and transaction fields are null while the operation is pending.
+type UserOperationByHash struct {
+	UserOperation   UserOperation  `json:"userOperation"`
+	EntryPoint      common.Address `json:"entryPoint"`
+	BlockNumber     *hexutil.Big   `json:"blockNumber"`
+	BlockHash       *common.Hash   `json:"blockHash"`
+	TransactionHash *common.Hash   `json:"transactionHash"`
+}
+
+// UserOperationReceipt is the result of eth_getUserOperationReceipt.
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash            `json:"userOpHash"`
+	EntryPoint    common.Address         `json:"entryPoint"`
+	Sender        common.Address         `json:"sender"`
+	Nonce         *hexutil.Big           `json:"nonce"`
+	Paymaster     common.Address         `json:"paymaster"`
+	ActualGasCost *hexutil.Big           `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big           `json:"actualGasUsed"`
+	Success       bool                   `json:"success"`
+	Reason        string                 `json:"reason,omitempty"`
+	Logs          []*ethtypes.Log        `json:"logs"`
+	Receipt       map[string]interface{} `json:"receipt"`
+}
+
+// userOperationEventID is the topic of the UserOperationEvent, which has the
+// same signature on all the EntryPoint versions.
+var userOperationEventID = crypto.Keccak256Hash([]byte("UserOperationEvent(bytes32,address,address,uint256,bool,uint256,uint256)"))
+
+// userOperationEvent is the data of the UserOperationEvent log.
+type userOperationEvent struct {
+	Nonce         *big.Int
+	Success       bool
+	ActualGasCost *big.Int
+	ActualGasUsed *big.Int
+}
+
+// findUserOperationEvent returns the UserOperationEvent log of the given
+// operation hash, searched in the block range cap of the latest blocks. It
+// returns nil if the operation isn't found.
+func (b *Bundler) findUserOperationEvent(hash common.Hash) (*ethtypes.Log, *entryPoint, error) {
+	head, err := b.backend.BlockNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockRange := int64(b.backend.RPCBlockRangeCap())
+	begin := int64(head) - blockRange
+	if begin < 1 {
+		begin = 1
+	}
+
+	topics := [][]common.Hash{{userOperationEventID}, {hash}}
+	filter := filters.NewRangeFilter(b.logger, b.backend, begin, int64(head), b.cfg.EntryPoints, topics)
+	logs, err := filter.Logs(context.Background(), int(b.backend.RPCLogsCap()), blockRange)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, log := range logs {
+		if ep, ok := b.entryPoints[log.Address]; ok && !log.Removed {
+			return log, ep, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// GetUserOperationByHash returns the operation of the given hash, from the
+// mempool or the handleOps transaction that included it. It returns nil if
+// the operation isn't found.
+func (b *Bundler) GetUserOperationByHash(hash common.Hash) (*UserOperationByHash, error) {
+	if entry, ok := b.mempool.get(hash); ok {
+		return &UserOperationByHash{
+			UserOperation: entry.op,
+			EntryPoint:    entry.entryPoint,
+		}, nil
+	}
+
+	log, ep, err := b.findUserOperationEvent(hash)
+	if err != nil || log == nil {
+		return nil, err
+	}
+
+	tx, err := b.backend.GetTransactionByHash(log.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, nil
+	}
+
+	// the operation may also have been bundled by a contract calling the
+	// EntryPoint, whose input isn't a handleOps call
+	ops, err := ep.unpackHandleOps(tx.Input)
+	if err != nil {
+		return nil, nil
+	}
+
+	chainID, err := b.chainID()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range ops {
+		if ep.hash(op, chainID) != hash {
+			continue
+		}
+
+		blockHash, txHash := log.BlockHash, log.TxHash
+		return &UserOperationByHash{
+			UserOperation:   op,
+			EntryPoint:      ep.address,
+			BlockNumber:     (*hexutil.Big)(new(big.Int).SetUint64(log.BlockNumber)),
+			BlockHash:       &blockHash,
+			TransactionHash: &txHash,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// GetUserOperationReceipt returns the receipt of the included operation of
+// the given hash. It returns nil if the operation isn't found.
+func (b *Bundler) GetUserOperationReceipt(hash common.Hash) (*UserOperationReceipt, error) {
+	log, ep, err := b.findUserOperationEvent(hash)
+	if err != nil || log == nil {
+		return nil, err
+	}
+
+	if len(log.Topics) != 4 {
+		return nil, errors.New("invalid UserOperationEvent topics")
+	}
+
+	var event userOperationEvent
+	if err := ep.abi.UnpackIntoInterface(&event, "UserOperationEvent", log.Data); err != nil {
+		return nil, fmt.Errorf("invalid UserOperationEvent data: %w", err)
+	}
+
+	receipt, err := b.backend.GetTransactionReceipt(log.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, nil
+	}
+
+	txLogs, _ := receipt["logs"].([]*ethtypes.Log)
+	opLogs := userOperationLogs(ep, txLogs, log.Index)
+
+	result := &UserOperationReceipt{
+		UserOpHash:    hash,
+		EntryPoint:    ep.address,
+		Sender:        common.BytesToAddress(log.Topics[2].Bytes()),
+		Nonce:         (*hexutil.Big)(event.Nonce),
+		Paymaster:     common.BytesToAddress(log.Topics[3].Bytes()),
+		ActualGasCost: (*hexutil.Big)(event.ActualGasCost),
+		ActualGasUsed: (*hexutil.Big)(event.ActualGasUsed),
+		Success:       event.Success,
+		Logs:          opLogs,
+		Receipt:       receipt,
+	}
+
+	revertReason := ep.abi.Events["UserOperationRevertReason"]
+	for _, opLog := range opLogs {
+		if opLog.Address != ep.address || len(opLog.Topics) < 2 ||
+			opLog.Topics[0] != revertReason.ID || opLog.Topics[1] != hash {
+			continue
+		}
+
+		values, err := revertReason.Inputs.NonIndexed().Unpack(opLog.Data)
+		if err == nil && len(values) == 2 {
+			reason, _ := values[1].([]byte)
+			result.Reason = hexutil.Encode(reason)
+		}
+	}
+
+	return result, nil
+}
+
+// userOperationLogs returns the logs of the bundle transaction emitted by
+// the operation whose UserOperationEvent has the given index: the logs
+// following the BeforeExecution event or the event of the previous
+// operation.
+func userOperationLogs(ep *entryPoint, txLogs []*ethtypes.Log, eventIndex uint) []*ethtypes.Log {
+	beforeExecution := ep.abi.Events["BeforeExecution"].ID
+
+	opLogs := []*ethtypes.Log{}
+	for _, log := range txLogs {
+		if log.Index >= eventIndex {
+			break
+		}
+
+		if log.Address == ep.address && len(log.Topics) > 0 &&
+			(log.Topics[0] == userOperationEventID || log.Topics[0] == beforeExecution) {
+			opLogs = []*ethtypes.Log{}
+			continue
+		}
+
+		opLogs = append(opLogs, log)
+	}
+
+	return opLogs
+}
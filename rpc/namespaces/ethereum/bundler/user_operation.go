@@ -0,0 +1,310 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+const (
+	// gas overheads of the pre-verification gas, from the reference bundler
+	fixedGasOverhead     = 21000
+	perUserOpGasOverhead = 18300
+	perUserOpWordGas     = 4
+	zeroByteGas          = 4
+	nonZeroByteGas       = 16
+	// dummySignatureSize is the size of the signature assumed by the
+	// pre-verification gas of the operations without signature
+	dummySignatureSize = 65
+)
+
+// maxUint128 is the max value of the gas fields packed in 16 bytes by the
+// EntryPoint v0.7.
+var maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// UserOperation is the ERC-4337 user operation of the JSON-RPC API. The
+// v0.6 operations set the initCode and paymasterAndData fields, the v0.7
+// operations set their unpacked factory and paymaster fields instead.
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	Signature            hexutil.Bytes  `json:"signature"`
+
+	// v0.6 fields
+	InitCode         hexutil.Bytes `json:"initCode,omitempty"`
+	PaymasterAndData hexutil.Bytes `json:"paymasterAndData,omitempty"`
+
+	// v0.7 fields
+	Factory                       *common.Address `json:"factory,omitempty"`
+	FactoryData                   hexutil.Bytes   `json:"factoryData,omitempty"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+}
+
+// userOperationV06 is the UserOperation struct of the EntryPoint v0.6.
+type userOperationV06 struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// packedUserOperation is the PackedUserOperation struct of the EntryPoint
+// v0.7.
+type packedUserOperation struct {
+	Sender             common.Address
+	Nonce              *big.Int
+	InitCode           []byte
+	CallData           []byte
+	AccountGasLimits   [32]byte
+	PreVerificationGas *big.Int
+	GasFees            [32]byte
+	PaymasterAndData   []byte
+	Signature          []byte
+}
+
+// bigOrZero returns the value of the given field, 0 if unset.
+func bigOrZero(v *hexutil.Big) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v.ToInt()
+}
+
+// ValidateBasic returns an error if the required fields of the given
+// EntryPoint version are missing, or if the fields of the other version are
+// set. The gas fields are optional for the gas estimation.
+func (op UserOperation) ValidateBasic(version evmtypes.EntryPointVersion, requireGas bool) error {
+	if op.Nonce == nil || op.CallData == nil || op.Signature == nil {
+		return errors.New("missing nonce, callData or signature")
+	}
+
+	gasFields := []*hexutil.Big{op.CallGasLimit, op.VerificationGasLimit, op.PreVerificationGas, op.MaxFeePerGas, op.MaxPriorityFeePerGas}
+	for _, field := range gasFields {
+		if field == nil {
+			if requireGas {
+				return errors.New("missing gas limit or fee")
+			}
+			continue
+		}
+		if field.ToInt().Sign() < 0 {
+			return errors.New("negative gas limit or fee")
+		}
+	}
+
+	if op.MaxFeePerGas != nil && op.MaxPriorityFeePerGas != nil &&
+		op.MaxPriorityFeePerGas.ToInt().Cmp(op.MaxFeePerGas.ToInt()) > 0 {
+		return errors.New("maxPriorityFeePerGas is higher than maxFeePerGas")
+	}
+
+	switch version {
+	case evmtypes.EntryPointV06:
+		if op.Factory != nil || op.FactoryData != nil || op.Paymaster != nil || op.PaymasterData != nil ||
+			op.PaymasterVerificationGasLimit != nil || op.PaymasterPostOpGasLimit != nil {
+			return fmt.Errorf("factory and paymaster fields are not supported by the EntryPoint %s, use initCode and paymasterAndData", version)
+		}
+	case evmtypes.EntryPointV07:
+		if op.InitCode != nil || op.PaymasterAndData != nil {
+			return fmt.Errorf("initCode and paymasterAndData are not supported by the EntryPoint %s, use the factory and paymaster fields", version)
+		}
+		if op.Factory == nil && len(op.FactoryData) > 0 {
+			return errors.New("factoryData without factory")
+		}
+		if op.Paymaster == nil && (len(op.PaymasterData) > 0 || op.PaymasterVerificationGasLimit != nil || op.PaymasterPostOpGasLimit != nil) {
+			return errors.New("paymaster fields without paymaster")
+		}
+		if op.Paymaster != nil && requireGas && (op.PaymasterVerificationGasLimit == nil || op.PaymasterPostOpGasLimit == nil) {
+			return errors.New("missing paymaster gas limits")
+		}
+		packed := []*hexutil.Big{
+			op.CallGasLimit, op.VerificationGasLimit, op.MaxFeePerGas, op.MaxPriorityFeePerGas,
+			op.PaymasterVerificationGasLimit, op.PaymasterPostOpGasLimit,
+		}
+		for _, field := range packed {
+			if field != nil && field.ToInt().Cmp(maxUint128) > 0 {
+				return errors.New("gas limit or fee overflows 128 bits")
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported EntryPoint version %s", version)
+	}
+
+	return nil
+}
+
+// initCode returns the init code of the operation, which is the factory
+// address followed by the factory data in v0.7.
+func (op UserOperation) initCode() []byte {
+	if op.Factory == nil {
+		return op.InitCode
+	}
+	return append(op.Factory.Bytes(), op.FactoryData...)
+}
+
+// paymasterAndData returns the paymaster data of the operation, which packs
+// the paymaster address, its 16 bytes gas limits and data in v0.7.
+func (op UserOperation) paymasterAndData() []byte {
+	if op.Paymaster == nil {
+		return op.PaymasterAndData
+	}
+	bz := op.Paymaster.Bytes()
+	bz = append(bz, common.LeftPadBytes(bigOrZero(op.PaymasterVerificationGasLimit).Bytes(), 16)...)
+	bz = append(bz, common.LeftPadBytes(bigOrZero(op.PaymasterPostOpGasLimit).Bytes(), 16)...)
+	return append(bz, op.PaymasterData...)
+}
+
+// toV06 returns the v0.6 UserOperation struct of the operation.
+func (op UserOperation) toV06() userOperationV06 {
+	return userOperationV06{
+		Sender:               op.Sender,
+		Nonce:                bigOrZero(op.Nonce),
+		InitCode:             op.initCode(),
+		CallData:             op.CallData,
+		CallGasLimit:         bigOrZero(op.CallGasLimit),
+		VerificationGasLimit: bigOrZero(op.VerificationGasLimit),
+		PreVerificationGas:   bigOrZero(op.PreVerificationGas),
+		MaxFeePerGas:         bigOrZero(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: bigOrZero(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     op.paymasterAndData(),
+		Signature:            op.Signature,
+	}
+}
+
+// toPacked returns the v0.7 PackedUserOperation struct of the operation.
+func (op UserOperation) toPacked() packedUserOperation {
+	return packedUserOperation{
+		Sender:             op.Sender,
+		Nonce:              bigOrZero(op.Nonce),
+		InitCode:           op.initCode(),
+		CallData:           op.CallData,
+		AccountGasLimits:   pack128(bigOrZero(op.VerificationGasLimit), bigOrZero(op.CallGasLimit)),
+		PreVerificationGas: bigOrZero(op.PreVerificationGas),
+		GasFees:            pack128(bigOrZero(op.MaxPriorityFeePerGas), bigOrZero(op.MaxFeePerGas)),
+		PaymasterAndData:   op.paymasterAndData(),
+		Signature:          op.Signature,
+	}
+}
+
+// fromV06 returns the operation of the given v0.6 UserOperation struct.
+func fromV06(op userOperationV06) UserOperation {
+	return UserOperation{
+		Sender:               op.Sender,
+		Nonce:                (*hexutil.Big)(op.Nonce),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         (*hexutil.Big)(op.CallGasLimit),
+		VerificationGasLimit: (*hexutil.Big)(op.VerificationGasLimit),
+		PreVerificationGas:   (*hexutil.Big)(op.PreVerificationGas),
+		MaxFeePerGas:         (*hexutil.Big)(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: (*hexutil.Big)(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+// fromPacked returns the unpacked operation of the given v0.7
+// PackedUserOperation struct.
+func fromPacked(packed packedUserOperation) UserOperation {
+	verificationGasLimit, callGasLimit := unpack128(packed.AccountGasLimits)
+	maxPriorityFeePerGas, maxFeePerGas := unpack128(packed.GasFees)
+
+	op := UserOperation{
+		Sender:               packed.Sender,
+		Nonce:                (*hexutil.Big)(packed.Nonce),
+		CallData:             packed.CallData,
+		CallGasLimit:         (*hexutil.Big)(callGasLimit),
+		VerificationGasLimit: (*hexutil.Big)(verificationGasLimit),
+		PreVerificationGas:   (*hexutil.Big)(packed.PreVerificationGas),
+		MaxFeePerGas:         (*hexutil.Big)(maxFeePerGas),
+		MaxPriorityFeePerGas: (*hexutil.Big)(maxPriorityFeePerGas),
+		Signature:            packed.Signature,
+	}
+
+	if len(packed.InitCode) >= common.AddressLength {
+		factory := common.BytesToAddress(packed.InitCode[:common.AddressLength])
+		op.Factory = &factory
+		op.FactoryData = packed.InitCode[common.AddressLength:]
+	}
+
+	// the paymaster is followed by its verification and post-op gas limits
+	if len(packed.PaymasterAndData) >= common.AddressLength+32 {
+		paymaster := common.BytesToAddress(packed.PaymasterAndData[:common.AddressLength])
+		var limits [32]byte
+		copy(limits[:], packed.PaymasterAndData[common.AddressLength:common.AddressLength+32])
+		verificationGasLimit, postOpGasLimit := unpack128(limits)
+		op.Paymaster = &paymaster
+		op.PaymasterVerificationGasLimit = (*hexutil.Big)(verificationGasLimit)
+		op.PaymasterPostOpGasLimit = (*hexutil.Big)(postOpGasLimit)
+		op.PaymasterData = packed.PaymasterAndData[common.AddressLength+32:]
+	}
+
+	return op
+}
+
+// pack128 packs the given values in the high and low 16 bytes of a word.
+func pack128(high, low *big.Int) [32]byte {
+	var word [32]byte
+	copy(word[:16], common.LeftPadBytes(high.Bytes(), 16))
+	copy(word[16:], common.LeftPadBytes(low.Bytes(), 16))
+	return word
+}
+
+// unpack128 returns the values packed in the high and low 16 bytes of a word.
+func unpack128(word [32]byte) (*big.Int, *big.Int) {
+	return new(big.Int).SetBytes(word[:16]), new(big.Int).SetBytes(word[16:])
+}
+
+// Hash returns the hash of the operation for the given EntryPoint version,
+// EntryPoint address and chain ID, as returned by its getUserOpHash method.
+func (op UserOperation) Hash(version evmtypes.EntryPointVersion, entryPoint common.Address, chainID *big.Int) common.Hash {
+	word := func(v *big.Int) []byte { return common.LeftPadBytes(v.Bytes(), 32) }
+
+	var encoded []byte
+	encoded = append(encoded, common.LeftPadBytes(op.Sender.Bytes(), 32)...)
+	encoded = append(encoded, word(bigOrZero(op.Nonce))...)
+	encoded = append(encoded, crypto.Keccak256(op.initCode())...)
+	encoded = append(encoded, crypto.Keccak256(op.CallData)...)
+
+	if version == evmtypes.EntryPointV06 {
+		encoded = append(encoded, word(bigOrZero(op.CallGasLimit))...)
+		encoded = append(encoded, word(bigOrZero(op.VerificationGasLimit))...)
+		encoded = append(encoded, word(bigOrZero(op.PreVerificationGas))...)
+		encoded = append(encoded, word(bigOrZero(op.MaxFeePerGas))...)
+		encoded = append(encoded, word(bigOrZero(op.MaxPriorityFeePerGas))...)
+	} else {
+		packed := op.toPacked()
+		encoded = append(encoded, packed.AccountGasLimits[:]...)
+		encoded = append(encoded, word(packed.PreVerificationGas)...)
+		encoded = append(encoded, packed.GasFees[:]...)
+	}
+	encoded = append(encoded, crypto.Keccak256(op.paymasterAndData())...)
+
+	return crypto.Keccak256Hash(
+		crypto.Keccak256(encoded),
+		common.LeftPadBytes(entryPoint.Bytes(), 32),
+		word(chainID),
+	)
+}
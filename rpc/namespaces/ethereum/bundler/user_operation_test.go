@@ -0,0 +1,338 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+var (
+	sender    = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	factory   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	paymaster = common.HexToAddress("0x3000000000000000000000000000000000000003")
+)
+
+func hexBig(v int64) *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(v))
+}
+
+// userOpV06 returns a v0.6 user operation of the given nonce and fees.
+func userOpV06(nonce, fee int64) UserOperation {
+	return UserOperation{
+		Sender:               sender,
+		Nonce:                hexBig(nonce),
+		InitCode:             append(factory.Bytes(), 0xaa),
+		CallData:             hexutil.Bytes{0x01, 0x02},
+		CallGasLimit:         hexBig(100000),
+		VerificationGasLimit: hexBig(200000),
+		PreVerificationGas:   hexBig(50000),
+		MaxFeePerGas:         hexBig(fee),
+		MaxPriorityFeePerGas: hexBig(fee),
+		PaymasterAndData:     hexutil.Bytes{},
+		Signature:            hexutil.Bytes{0x05},
+	}
+}
+
+// userOpV07 returns a v0.7 user operation with a factory and a paymaster.
+func userOpV07() UserOperation {
+	return UserOperation{
+		Sender:                        sender,
+		Nonce:                         hexBig(3),
+		Factory:                       &factory,
+		FactoryData:                   hexutil.Bytes{0xaa},
+		CallData:                      hexutil.Bytes{0x01, 0x02},
+		CallGasLimit:                  hexBig(100000),
+		VerificationGasLimit:          hexBig(200000),
+		PreVerificationGas:            hexBig(50000),
+		MaxFeePerGas:                  hexBig(20),
+		MaxPriorityFeePerGas:          hexBig(10),
+		Paymaster:                     &paymaster,
+		PaymasterVerificationGasLimit: hexBig(30000),
+		PaymasterPostOpGasLimit:       hexBig(40000),
+		PaymasterData:                 hexutil.Bytes{0xbb},
+		Signature:                     hexutil.Bytes{0x05},
+	}
+}
+
+func TestUserOperationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		version    evmtypes.EntryPointVersion
+		malleate   func() UserOperation
+		requireGas bool
+		expPass    bool
+	}{
+		{
+			"pass - v0.6",
+			evmtypes.EntryPointV06,
+			func() UserOperation { return userOpV06(1, 10) },
+			true,
+			true,
+		},
+		{
+			"pass - v0.7",
+			evmtypes.EntryPointV07,
+			userOpV07,
+			true,
+			true,
+		},
+		{
+			"pass - estimation without gas fields",
+			evmtypes.EntryPointV07,
+			func() UserOperation {
+				op := userOpV07()
+				op.CallGasLimit, op.VerificationGasLimit, op.PreVerificationGas = nil, nil, nil
+				op.PaymasterVerificationGasLimit, op.PaymasterPostOpGasLimit = nil, nil
+				return op
+			},
+			false,
+			true,
+		},
+		{
+			"fail - missing gas fields",
+			evmtypes.EntryPointV06,
+			func() UserOperation {
+				op := userOpV06(1, 10)
+				op.CallGasLimit = nil
+				return op
+			},
+			true,
+			false,
+		},
+		{
+			"fail - missing signature",
+			evmtypes.EntryPointV06,
+			func() UserOperation {
+				op := userOpV06(1, 10)
+				op.Signature = nil
+				return op
+			},
+			true,
+			false,
+		},
+		{
+			"fail - priority fee higher than max fee",
+			evmtypes.EntryPointV06,
+			func() UserOperation {
+				op := userOpV06(1, 10)
+				op.MaxPriorityFeePerGas = hexBig(11)
+				return op
+			},
+			true,
+			false,
+		},
+		{
+			"fail - v0.7 fields on v0.6",
+			evmtypes.EntryPointV06,
+			userOpV07,
+			true,
+			false,
+		},
+		{
+			"fail - v0.6 fields on v0.7",
+			evmtypes.EntryPointV07,
+			func() UserOperation { return userOpV06(1, 10) },
+			true,
+			false,
+		},
+		{
+			"fail - paymaster data without paymaster",
+			evmtypes.EntryPointV07,
+			func() UserOperation {
+				op := userOpV07()
+				op.Paymaster = nil
+				return op
+			},
+			true,
+			false,
+		},
+		{
+			"fail - gas limit overflows 128 bits",
+			evmtypes.EntryPointV07,
+			func() UserOperation {
+				op := userOpV07()
+				op.CallGasLimit = (*hexutil.Big)(new(big.Int).Lsh(big.NewInt(1), 128))
+				return op
+			},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.malleate().ValidateBasic(tc.version, tc.requireGas)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPackedUserOperation(t *testing.T) {
+	op := userOpV07()
+	packed := op.toPacked()
+
+	// the verification and priority fields are in the high 16 bytes
+	require.Equal(t, common.LeftPadBytes(big.NewInt(200000).Bytes(), 16), packed.AccountGasLimits[:16])
+	require.Equal(t, common.LeftPadBytes(big.NewInt(100000).Bytes(), 16), packed.AccountGasLimits[16:])
+	require.Equal(t, common.LeftPadBytes(big.NewInt(10).Bytes(), 16), packed.GasFees[:16])
+	require.Equal(t, common.LeftPadBytes(big.NewInt(20).Bytes(), 16), packed.GasFees[16:])
+	require.Equal(t, append(factory.Bytes(), 0xaa), packed.InitCode)
+	require.Len(t, packed.PaymasterAndData, common.AddressLength+32+1)
+
+	require.Equal(t, op, fromPacked(packed))
+}
+
+func TestUserOperationHash(t *testing.T) {
+	chainID := big.NewInt(9000)
+	entryPoint := evmtypes.EntryPointV07Address
+
+	mustType := func(name string) abi.Type {
+		typ, err := abi.NewType(name, "", nil)
+		require.NoError(t, err)
+		return typ
+	}
+	encode := func(types []string, values ...interface{}) []byte {
+		args := make(abi.Arguments, len(types))
+		for i, typ := range types {
+			args[i] = abi.Argument{Type: mustType(typ)}
+		}
+		bz, err := args.Pack(values...)
+		require.NoError(t, err)
+		return bz
+	}
+
+	op := userOpV07()
+	packed := op.toPacked()
+	opHash := crypto.Keccak256Hash(encode(
+		[]string{"address", "uint256", "bytes32", "bytes32", "bytes32", "uint256", "bytes32", "bytes32"},
+		packed.Sender, packed.Nonce, crypto.Keccak256Hash(packed.InitCode), crypto.Keccak256Hash(packed.CallData),
+		packed.AccountGasLimits, packed.PreVerificationGas, packed.GasFees, crypto.Keccak256Hash(packed.PaymasterAndData),
+	))
+	expected := crypto.Keccak256Hash(encode([]string{"bytes32", "address", "uint256"}, opHash, entryPoint, chainID))
+	require.Equal(t, expected, op.Hash(evmtypes.EntryPointV07, entryPoint, chainID))
+
+	// the signature isn't hashed
+	op.Signature = hexutil.Bytes{0x06}
+	require.Equal(t, expected, op.Hash(evmtypes.EntryPointV07, entryPoint, chainID))
+
+	// the hash depends on the chain and EntryPoint
+	require.NotEqual(t, expected, op.Hash(evmtypes.EntryPointV07, entryPoint, big.NewInt(9001)))
+	require.NotEqual(t, expected, op.Hash(evmtypes.EntryPointV07, evmtypes.EntryPointV06Address, chainID))
+
+	opV06 := userOpV06(1, 10)
+	v06 := opV06.toV06()
+	opHash = crypto.Keccak256Hash(encode(
+		[]string{"address", "uint256", "bytes32", "bytes32", "uint256", "uint256", "uint256", "uint256", "uint256", "bytes32"},
+		v06.Sender, v06.Nonce, crypto.Keccak256Hash(v06.InitCode), crypto.Keccak256Hash(v06.CallData),
+		v06.CallGasLimit, v06.VerificationGasLimit, v06.PreVerificationGas, v06.MaxFeePerGas, v06.MaxPriorityFeePerGas,
+		crypto.Keccak256Hash(v06.PaymasterAndData),
+	))
+	expected = crypto.Keccak256Hash(encode([]string{"bytes32", "address", "uint256"}, opHash, evmtypes.EntryPointV06Address, chainID))
+	require.Equal(t, expected, opV06.Hash(evmtypes.EntryPointV06, evmtypes.EntryPointV06Address, chainID))
+}
+
+func TestHandleOps(t *testing.T) {
+	beneficiary := common.HexToAddress("0x4000000000000000000000000000000000000004")
+
+	testCases := []struct {
+		address common.Address
+		ops     []UserOperation
+	}{
+		{evmtypes.EntryPointV06Address, []UserOperation{userOpV06(1, 10), userOpV06(2, 20)}},
+		{evmtypes.EntryPointV07Address, []UserOperation{userOpV07()}},
+	}
+
+	for _, tc := range testCases {
+		ep, err := newEntryPoint(tc.address)
+		require.NoError(t, err)
+
+		data, err := ep.packHandleOps(tc.ops, beneficiary)
+		require.NoError(t, err)
+
+		ops, err := ep.unpackHandleOps(data)
+		require.NoError(t, err)
+		require.Equal(t, tc.ops, ops)
+
+		_, err = ep.unpackHandleOps(data[4:])
+		require.Error(t, err)
+
+		// the pre-verification gas covers the call data of the operation
+		preVerificationGas, err := ep.preVerificationGas(tc.ops[0])
+		require.NoError(t, err)
+		require.Greater(t, preVerificationGas.Uint64(), uint64(fixedGasOverhead+perUserOpGasOverhead))
+	}
+
+	_, err := newEntryPoint(beneficiary)
+	require.Error(t, err)
+}
+
+func TestRevertError(t *testing.T) {
+	ep, err := newEntryPoint(evmtypes.EntryPointV07Address)
+	require.NoError(t, err)
+
+	failedOp := func(reason string) []byte {
+		abiErr := ep.abi.Errors["FailedOp"]
+		bz, err := abiErr.Inputs.Pack(big.NewInt(0), reason)
+		require.NoError(t, err)
+		return append(abiErr.ID[:4], bz...)
+	}
+
+	testCases := []struct {
+		name    string
+		data    []byte
+		expCode int
+		expMsg  string
+	}{
+		{"account validation", failedOp("AA23 reverted"), ErrCodeSimulateValidation, "AA23 reverted"},
+		{"paymaster validation", failedOp("AA31 paymaster deposit too low"), ErrCodeSimulatePaymasterValidation, "AA31 paymaster deposit too low"},
+		{"signature", failedOp("AA24 signature error"), ErrCodeInvalidSignature, "AA24 signature error"},
+		{"other revert", []byte{0x01, 0x02, 0x03, 0x04}, ErrCodeSimulateValidation, "execution reverted"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ep.revertError(tc.data)
+
+			rpcErr, ok := err.(*rpcError)
+			require.True(t, ok)
+			require.Equal(t, tc.expCode, rpcErr.ErrorCode())
+			require.Equal(t, tc.expMsg, rpcErr.Error())
+		})
+	}
+}
+
+func TestUserOperationLogs(t *testing.T) {
+	ep, err := newEntryPoint(evmtypes.EntryPointV06Address)
+	require.NoError(t, err)
+
+	entryPointLog := func(index uint, topic common.Hash) *ethtypes.Log {
+		return &ethtypes.Log{Address: ep.address, Index: index, Topics: []common.Hash{topic}}
+	}
+	accountLog := func(index uint) *ethtypes.Log {
+		return &ethtypes.Log{Address: sender, Index: index}
+	}
+
+	txLogs := []*ethtypes.Log{
+		entryPointLog(0, ep.abi.Events["BeforeExecution"].ID),
+		accountLog(1),
+		entryPointLog(2, userOperationEventID),
+		accountLog(3),
+		accountLog(4),
+		entryPointLog(5, userOperationEventID),
+	}
+
+	require.Equal(t, []*ethtypes.Log{txLogs[1]}, userOperationLogs(ep, txLogs, 2))
+	require.Equal(t, []*ethtypes.Log{txLogs[3], txLogs[4]}, userOperationLogs(ep, txLogs, 5))
+}
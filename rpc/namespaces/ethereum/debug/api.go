@@ -26,6 +26,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/kato114/byte/v15/rpc/backend"
@@ -69,6 +71,18 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceTransaction(hash, config)
 }
 
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object.
+func (a *API) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *evmtypes.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args, "block number or hash", blockNrOrHash)
+	return a.backend.TraceCall(args, blockNrOrHash, config)
+}
+
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
 func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
@@ -105,6 +119,34 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
 }
 
+// StorageRangeAt returns the storage of the contract right after the
+// transaction of the given index within the block, starting from the given
+// key. Unlike geth, the entries are ordered by storage key instead of hashed
+// storage key.
+func (a *API) StorageRangeAt(
+	blockHash common.Hash,
+	txIndex int,
+	contractAddress common.Address,
+	keyStart hexutil.Bytes,
+	maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "index", txIndex, "address", contractAddress)
+	return a.backend.StorageRangeAt(blockHash, txIndex, contractAddress, keyStart, maxResult)
+}
+
+// AccountRange returns a page of the ethereum accounts at the given block,
+// starting from the given address. The accounts are always returned with their
+// address, so the incompletes flag of geth is ignored.
+func (a *API) AccountRange(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	start hexutil.Bytes,
+	maxResults int,
+	nocode, nostorage, _ bool,
+) (state.IteratorDump, error) {
+	a.logger.Debug("debug_accountRange", "block number or hash", blockNrOrHash, "start", start, "max results", maxResults)
+	return a.backend.AccountRange(blockNrOrHash, start, maxResults, nocode, nostorage)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
@@ -309,6 +351,56 @@ func (a *API) GetBlockRlp(number uint64) (hexutil.Bytes, error) {
 	return rlp.EncodeToBytes(block)
 }
 
+// GetRawHeader returns the RLP encoding of the Ethereum header of the block,
+// as included in the block returned by GetRawBlock.
+func (a *API) GetRawHeader(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawHeader", "block number or hash", blockNrOrHash)
+
+	block, err := a.ethBlock(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(block.Header())
+}
+
+// GetRawBlock returns the RLP encoding of the Ethereum block, with the header
+// and the signed Ethereum transactions.
+func (a *API) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawBlock", "block number or hash", blockNrOrHash)
+
+	block, err := a.ethBlock(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return rlp.EncodeToBytes(block)
+}
+
+// GetRawReceipts returns the consensus encoding of the receipts of the
+// Ethereum transactions of the block.
+func (a *API) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawReceipts", "block number or hash", blockNrOrHash)
+	return a.backend.GetRawReceipts(blockNrOrHash)
+}
+
+// GetRawTransaction returns the signed RLP encoding of the Ethereum
+// transaction, or nil if the transaction isn't found.
+func (a *API) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_getRawTransaction", "hash", hash)
+	return a.backend.GetRawTransaction(hash)
+}
+
+// ethBlock returns the Ethereum block of the block number or hash.
+func (a *API) ethBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (*ethtypes.Block, error) {
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.backend.EthBlockByNumber(blockNum)
+}
+
 // PrintBlock retrieves a block and returns its pretty printed form.
 func (a *API) PrintBlock(number uint64) (string, error) {
 	block, err := a.backend.EthBlockByNumber(rpctypes.BlockNumber(number))
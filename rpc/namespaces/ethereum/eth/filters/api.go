@@ -22,6 +22,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	evmostypes "github.com/kato114/byte/v15/types"
 	evmtypes "github.com/kato114/byte/v15/x/evm/types"
 )
 
@@ -48,6 +49,8 @@ type Backend interface {
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	LogIndexRange() (int64, int64, error)
+	GetLogPositions(from, to int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]evmostypes.LogPosition, error)
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
@@ -142,6 +142,16 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
+	// the log index lifts the block range limit of the queries filtered by
+	// address
+	indexed, ok, err := f.indexedLogs(head, logLimit, blockLimit)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return indexed, nil
+	}
+
 	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
@@ -182,6 +192,78 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// indexedLogs returns the logs matching the filter criteria from the log index
+// of the EVM indexer. The bool is false if the log index doesn't cover the
+// block range, the criteria has no address nor topic, or the criteria has no
+// address and the block range exceeds the block limit, in which case the
+// blocks have to be scanned.
+func (f *Filter) indexedLogs(head int64, logLimit int, blockLimit int64) ([]*ethtypes.Log, bool, error) {
+	if len(f.criteria.Addresses) == 0 && !hasTopics(f.criteria.Topics) {
+		return nil, false, nil
+	}
+	// the topics such as the ERC-20 transfers match too many logs to be
+	// looked up over any block range
+	if len(f.criteria.Addresses) == 0 && f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
+		return nil, false, nil
+	}
+
+	from := f.criteria.FromBlock.Int64()
+	to := f.criteria.ToBlock.Int64()
+	if to > head {
+		to = head
+	}
+
+	first, last, err := f.backend.LogIndexRange()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || from > to || from < first || to > last {
+		return nil, false, nil
+	}
+
+	positions, err := f.backend.GetLogPositions(from, to, f.criteria.Addresses, f.criteria.Topics, logLimit)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(positions) > logLimit {
+		return nil, false, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+
+	logs := []*ethtypes.Log{}
+	for i, position := range positions {
+		if i > 0 && position.Height == positions[i-1].Height {
+			continue
+		}
+
+		height := position.Height
+		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to fetch block result %d", height)
+		}
+
+		logsList, err := backend.GetLogsFromBlockResults(blockRes)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "failed to fetch logs block number %d", height)
+		}
+
+		for _, txLogs := range logsList {
+			logs = append(logs, FilterLogs(txLogs, nil, nil, f.criteria.Addresses, f.criteria.Topics)...)
+		}
+	}
+	return logs, true, nil
+}
+
+// hasTopics returns true if any topic position of the criteria is not a
+// wildcard.
+func hasTopics(topics [][]common.Hash) bool {
+	for _, topicList := range topics {
+		if len(topicList) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -0,0 +1,306 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package ots
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/kato114/byte/v15/rpc/backend"
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	evmostypes "github.com/kato114/byte/v15/types"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// nonceSearchPageSize is the number of txs fetched at once from the address
+// index when searching a tx by sender and nonce.
+const nonceSearchPageSize = 100
+
+// API is the Otterscan `ots` namespace. The internal operations are built from
+// the output of the callTracer, and the searches by address use the address
+// index of the EVM indexer, which must be enabled.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the Otterscan namespace.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		logger:  ctx.Logger.With("module", "ots"),
+		backend: backend,
+	}
+}
+
+// GetApiLevel returns the version of the Otterscan API implemented by the
+// node.
+func (a *API) GetApiLevel() uint64 { //nolint: golint, stylecheck, revive
+	a.logger.Debug("ots_getApiLevel")
+	return APILevel
+}
+
+// GetInternalOperations returns the value transfers, contract creations and
+// self-destructs made by the sub calls of the given transaction.
+func (a *API) GetInternalOperations(hash common.Hash) ([]*InternalOperation, error) {
+	a.logger.Debug("ots_getInternalOperations", "hash", hash)
+
+	frame, err := a.traceCalls(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return internalOperations(*frame, []*InternalOperation{}), nil
+}
+
+// TraceTransaction returns the flat list of the call frames of the given
+// transaction, with their depth in the call tree.
+func (a *API) TraceTransaction(hash common.Hash) ([]*TraceEntry, error) {
+	a.logger.Debug("ots_traceTransaction", "hash", hash)
+
+	frame, err := a.traceCalls(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return traceEntries(*frame, 0, nil), nil
+}
+
+// SearchTransactionsBefore returns the transactions of the address in the
+// blocks before the given block number, in descending order. A block number of
+// 0 returns the most recent transactions. The page holds at least pageSize
+// transactions if available, and never splits the transactions of a block.
+func (a *API) SearchTransactionsBefore(address common.Address, blockNum uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", address, "block number", blockNum, "page size", pageSize)
+
+	hashes, more, err := a.backend.GetTxHashesByAddress(address, nil, int64(blockNum), true, int(pageSize)) // #nosec G701 -- block numbers fit in int64
+	if err != nil {
+		return nil, err
+	}
+
+	return a.transactionsWithReceipts(hashes, blockNum == 0, !more)
+}
+
+// SearchTransactionsAfter returns the transactions of the address in the
+// blocks after the given block number, in descending order. A block number of
+// 0 returns the oldest transactions. The page holds at least pageSize
+// transactions if available, and never splits the transactions of a block.
+func (a *API) SearchTransactionsAfter(address common.Address, blockNum uint64, pageSize uint16) (*TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", address, "block number", blockNum, "page size", pageSize)
+
+	hashes, more, err := a.backend.GetTxHashesByAddress(address, nil, int64(blockNum), false, int(pageSize)) // #nosec G701 -- block numbers fit in int64
+	if err != nil {
+		return nil, err
+	}
+
+	// the pages are always in descending order
+	for i, j := 0, len(hashes)-1; i < j; i, j = i+1, j-1 {
+		hashes[i], hashes[j] = hashes[j], hashes[i]
+	}
+
+	return a.transactionsWithReceipts(hashes, !more, blockNum == 0)
+}
+
+// GetTransactionBySenderAndNonce returns the hash of the transaction sent by
+// the address with the given nonce, or nil if there is none.
+func (a *API) GetTransactionBySenderAndNonce(address common.Address, nonce hexutil.Uint64) (*common.Hash, error) {
+	a.logger.Debug("ots_getTransactionBySenderAndNonce", "address", address, "nonce", nonce)
+
+	count, err := a.backend.GetTransactionCount(address, rpctypes.EthLatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if count == nil || uint64(*count) <= uint64(nonce) {
+		return nil, nil
+	}
+
+	var (
+		senderRole = []evmostypes.AddressRole{evmostypes.AddressRoleSender}
+		height     int64
+	)
+	for {
+		hashes, more, err := a.backend.GetTxHashesByAddress(address, senderRole, height, false, nonceSearchPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hash := range hashes {
+			tx, err := a.backend.GetTransactionByHash(hash)
+			if err != nil {
+				return nil, err
+			}
+			if tx == nil || tx.From != address {
+				continue
+			}
+
+			switch {
+			case tx.Nonce == nonce:
+				return &hash, nil
+			case tx.Nonce > nonce:
+				// the nonces of the sender only increase
+				return nil, nil
+			}
+		}
+
+		if !more || len(hashes) == 0 {
+			return nil, nil
+		}
+
+		// the pages never split a block, so the next page starts after the
+		// block of the last tx
+		tx, err := a.backend.GetTxByEthHash(hashes[len(hashes)-1])
+		if err != nil {
+			return nil, err
+		}
+		height = tx.Height
+	}
+}
+
+// GetContractCreator returns the transaction and the address that created the
+// given contract, or nil if the address is not a contract. The creation block
+// is the first block after which the address has code, found with a binary
+// search over the historical state, and the creation is looked up in the call
+// traces of its transactions, so that the contracts created by other contracts
+// are found too. It requires the state of the creation block.
+func (a *API) GetContractCreator(address common.Address) (*ContractCreator, error) {
+	a.logger.Debug("ots_getContractCreator", "address", address)
+
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	hasCode := func(height int64) (bool, error) {
+		blockNum := rpctypes.BlockNumber(height)
+		code, err := a.backend.GetCode(address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+		return len(code) > 0, err
+	}
+
+	ok, err := hasCode(int64(latest)) // #nosec G701 -- block numbers fit in int64
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	height, err := searchFirstBlock(1, int64(latest), hasCode) // #nosec G701 -- block numbers fit in int64
+	if err != nil {
+		return nil, err
+	}
+
+	blockNum := rpctypes.BlockNumber(height)
+	block, err := a.backend.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if block == nil || block.Block == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+
+	results, err := a.backend.TraceBlock(blockNum, &evmtypes.TraceConfig{Tracer: evmtracers.CallTracer}, block)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.Error != "" || result.TxHash == nil {
+			continue
+		}
+
+		frame, err := decodeCallFrame(result.Result)
+		if err != nil {
+			return nil, err
+		}
+		if creator, ok := txCreator(*frame, address); ok {
+			return &ContractCreator{Hash: *result.TxHash, Creator: creator}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("creation of %s not found in block %d", address.Hex(), height)
+}
+
+// traceCalls returns the call frames of the given transaction.
+func (a *API) traceCalls(hash common.Hash) (*evmtracers.CallFrame, error) {
+	res, err := a.backend.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: evmtracers.CallTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeCallFrame(res)
+}
+
+// decodeCallFrame decodes the output of the callTracer.
+func decodeCallFrame(res interface{}) (*evmtracers.CallFrame, error) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var frame evmtracers.CallFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+
+	return &frame, nil
+}
+
+// transactionsWithReceipts returns the page with the transactions and
+// receipts of the given hashes. The receipts are extended with the timestamp
+// of their block.
+func (a *API) transactionsWithReceipts(hashes []common.Hash, firstPage, lastPage bool) (*TransactionsWithReceipts, error) {
+	var (
+		page = &TransactionsWithReceipts{
+			Txs:       make([]*rpctypes.RPCTransaction, 0, len(hashes)),
+			Receipts:  make([]map[string]interface{}, 0, len(hashes)),
+			FirstPage: firstPage,
+			LastPage:  lastPage,
+		}
+		timestamps = make(map[int64]hexutil.Uint64)
+	)
+
+	for _, hash := range hashes {
+		tx, err := a.backend.GetTransactionByHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || tx.BlockNumber == nil {
+			return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+		}
+
+		receipt, err := a.backend.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt == nil {
+			return nil, fmt.Errorf("receipt of transaction %s not found", hash.Hex())
+		}
+
+		height := tx.BlockNumber.ToInt().Int64()
+		timestamp, ok := timestamps[height]
+		if !ok {
+			resBlock, err := a.backend.TendermintBlockByNumber(rpctypes.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil {
+				return nil, errors.New("block not found")
+			}
+
+			timestamp = hexutil.Uint64(resBlock.Block.Time.Unix()) // #nosec G701 -- block time is always positive
+			timestamps[height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		page.Txs = append(page.Txs, tx)
+		page.Receipts = append(page.Receipts, receipt)
+	}
+
+	return page, nil
+}
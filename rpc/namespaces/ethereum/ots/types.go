@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package ots
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+)
+
+// APILevel is the version of the Otterscan JSON-RPC API that is implemented.
+const APILevel = 8
+
+// Types of the internal operations.
+const (
+	OpTransfer     = 0
+	OpSelfDestruct = 1
+	OpCreate       = 2
+	OpCreate2      = 3
+)
+
+// InternalOperation is a value transfer, contract creation or self-destruct
+// that happened within a transaction.
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceEntry is a call frame of a transaction, as returned by
+// `ots_traceTransaction`.
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// ContractCreator is the transaction and the address that created a contract.
+type ContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// TransactionsWithReceipts is a page of the transactions of an address, in
+// descending order. The first page holds the most recent transactions.
+type TransactionsWithReceipts struct {
+	Txs       []*rpctypes.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{}   `json:"receipts"`
+	FirstPage bool                       `json:"firstPage"`
+	LastPage  bool                       `json:"lastPage"`
+}
+
+// internalOperations returns the internal operations of the sub calls of the
+// frame that were not reverted, in execution order.
+func internalOperations(frame evmtracers.CallFrame, ops []*InternalOperation) []*InternalOperation {
+	for _, call := range frame.Calls {
+		if call.Error != "" {
+			continue
+		}
+
+		switch vm.StringToOp(call.Type) {
+		case vm.CALL:
+			if call.Value != nil && call.Value.ToInt().Sign() > 0 {
+				ops = append(ops, &InternalOperation{Type: OpTransfer, From: call.From, To: call.To, Value: call.Value})
+			}
+		case vm.CREATE:
+			ops = append(ops, &InternalOperation{Type: OpCreate, From: call.From, To: call.To, Value: call.Value})
+		case vm.CREATE2:
+			ops = append(ops, &InternalOperation{Type: OpCreate2, From: call.From, To: call.To, Value: call.Value})
+		case vm.SELFDESTRUCT:
+			ops = append(ops, &InternalOperation{Type: OpSelfDestruct, From: call.From, To: call.To, Value: call.Value})
+		}
+
+		ops = internalOperations(call, ops)
+	}
+
+	return ops
+}
+
+// traceEntries returns the frame and its sub calls as a flat list, in
+// execution order.
+func traceEntries(frame evmtracers.CallFrame, depth int, entries []*TraceEntry) []*TraceEntry {
+	entries = append(entries, &TraceEntry{
+		Type:   frame.Type,
+		Depth:  depth,
+		From:   frame.From,
+		To:     frame.To,
+		Value:  frame.Value,
+		Input:  frame.Input,
+		Output: frame.Output,
+	})
+
+	for _, call := range frame.Calls {
+		entries = traceEntries(call, depth+1, entries)
+	}
+
+	return entries
+}
+
+// txCreator returns the address that created the given contract in the
+// transaction of the call frame, either the sender of a contract creation
+// transaction or a contract of its sub calls.
+func txCreator(frame evmtracers.CallFrame, address common.Address) (common.Address, bool) {
+	if frame.Error != "" {
+		return common.Address{}, false
+	}
+	if op := vm.StringToOp(frame.Type); (op == vm.CREATE || op == vm.CREATE2) && frame.To == address {
+		return frame.From, true
+	}
+	return findCreator(frame, address)
+}
+
+// searchFirstBlock returns the first block between from and to for which the
+// predicate is true, the predicate being true for to and for all the blocks
+// after the returned one.
+func searchFirstBlock(from, to int64, predicate func(int64) (bool, error)) (int64, error) {
+	for from < to {
+		mid := from + (to-from)/2
+		ok, err := predicate(mid)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			to = mid
+		} else {
+			from = mid + 1
+		}
+	}
+	return to, nil
+}
+
+// findCreator returns the address that created the given contract within the
+// sub calls of the frame, if any.
+func findCreator(frame evmtracers.CallFrame, address common.Address) (common.Address, bool) {
+	for _, call := range frame.Calls {
+		if call.Error != "" {
+			continue
+		}
+
+		if op := vm.StringToOp(call.Type); (op == vm.CREATE || op == vm.CREATE2) && call.To == address {
+			return call.From, true
+		}
+
+		if creator, ok := findCreator(call, address); ok {
+			return creator, true
+		}
+	}
+
+	return common.Address{}, false
+}
@@ -0,0 +1,111 @@
+package ots
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+)
+
+var (
+	sender   = common.HexToAddress("0x1")
+	contract = common.HexToAddress("0x2")
+	factory  = common.HexToAddress("0x3")
+	created  = common.HexToAddress("0x4")
+	value    = (*hexutil.Big)(big.NewInt(10))
+	zero     = (*hexutil.Big)(new(big.Int))
+)
+
+// testFrame is a call to a contract that calls a factory, which creates a
+// contract and sends value to the sender. The contract then self-destructs
+// after a reverted transfer.
+var testFrame = evmtracers.CallFrame{
+	Type:  "CALL",
+	From:  sender,
+	To:    contract,
+	Value: zero,
+	Calls: []evmtracers.CallFrame{
+		{
+			Type:  "CALL",
+			From:  contract,
+			To:    factory,
+			Value: zero,
+			Calls: []evmtracers.CallFrame{
+				{Type: "CREATE2", From: factory, To: created, Value: zero},
+				{Type: "CALL", From: factory, To: sender, Value: value},
+			},
+		},
+		{Type: "CALL", From: contract, To: sender, Value: value, Error: "execution reverted"},
+		{Type: "SELFDESTRUCT", From: contract, To: sender, Value: value},
+	},
+}
+
+func TestInternalOperations(t *testing.T) {
+	ops := internalOperations(testFrame, []*InternalOperation{})
+	require.Equal(t, []*InternalOperation{
+		{Type: OpCreate2, From: factory, To: created, Value: zero},
+		{Type: OpTransfer, From: factory, To: sender, Value: value},
+		{Type: OpSelfDestruct, From: contract, To: sender, Value: value},
+	}, ops)
+}
+
+func TestTraceEntries(t *testing.T) {
+	entries := traceEntries(testFrame, 0, nil)
+	require.Len(t, entries, 6)
+
+	depths := make([]int, len(entries))
+	for i, entry := range entries {
+		depths[i] = entry.Depth
+	}
+	require.Equal(t, []int{0, 1, 2, 2, 1, 1}, depths)
+	require.Equal(t, &TraceEntry{Type: "CREATE2", Depth: 2, From: factory, To: created, Value: zero}, entries[2])
+}
+
+func TestFindCreator(t *testing.T) {
+	creator, ok := findCreator(testFrame, created)
+	require.True(t, ok)
+	require.Equal(t, factory, creator)
+
+	_, ok = findCreator(testFrame, contract)
+	require.False(t, ok)
+}
+
+func TestTxCreator(t *testing.T) {
+	creator, ok := txCreator(testFrame, created)
+	require.True(t, ok)
+	require.Equal(t, factory, creator)
+
+	// contract creation transaction
+	creator, ok = txCreator(evmtracers.CallFrame{Type: "CREATE", From: sender, To: contract}, contract)
+	require.True(t, ok)
+	require.Equal(t, sender, creator)
+
+	// reverted transaction
+	reverted := testFrame
+	reverted.Error = "execution reverted"
+	_, ok = txCreator(reverted, created)
+	require.False(t, ok)
+}
+
+func TestSearchFirstBlock(t *testing.T) {
+	for _, first := range []int64{1, 2, 500, 999, 1000} {
+		var calls int
+		height, err := searchFirstBlock(1, 1000, func(height int64) (bool, error) {
+			calls++
+			return height >= first, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, first, height)
+		require.LessOrEqual(t, calls, 10)
+	}
+
+	_, err := searchFirstBlock(1, 1000, func(int64) (bool, error) {
+		return false, errors.New("state pruned")
+	})
+	require.Error(t, err)
+}
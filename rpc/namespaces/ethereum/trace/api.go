@@ -0,0 +1,409 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package trace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/kato114/byte/v15/rpc/backend"
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// MaxFilterBlockRange is the maximum number of blocks that can be searched on
+// a single `trace_filter` request.
+const MaxFilterBlockRange = 100
+
+// stateDiffTracerConfig is the prestateTracer config that returns the state
+// before and after each transaction.
+const stateDiffTracerConfig = `{"diffMode":true}`
+
+// API is the Parity/OpenEthereum compatible `trace` namespace. The traces are
+// built from the outputs of the native tracers run through the EVM module
+// TraceTx and TraceBlock queries.
+type API struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the trace namespace.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the call traces of all the transactions of the given block.
+func (a *API) Block(blockNr rpctypes.BlockNumber) ([]*Trace, error) {
+	a.logger.Debug("trace_block", "number", blockNr)
+
+	resBlock, err := a.getBlock(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	if err != nil {
+		return nil, err
+	}
+
+	return a.blockTraces(resBlock)
+}
+
+// Transaction returns the call traces of the given transaction.
+func (a *API) Transaction(hash common.Hash) ([]*Trace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+
+	res, err := a.backend.GetTxByEthHash(hash)
+	if err != nil {
+		a.logger.Debug("tx not found", "hash", hash)
+		return nil, err
+	}
+
+	resBlock, err := a.backend.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		a.logger.Debug("block not found", "height", res.Height)
+		return nil, err
+	}
+
+	call, err := a.backend.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: evmtracers.CallTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	var frame evmtracers.CallFrame
+	if err := decodeTraceResult(call, &frame); err != nil {
+		return nil, err
+	}
+
+	var (
+		blockHash   = common.BytesToHash(resBlock.Block.Hash())
+		blockNumber = uint64(res.Height) // #nosec G701 -- block height is always positive
+		position    = uint64(res.TxIndex)
+	)
+	if res.EthTxIndex >= 0 {
+		position = uint64(res.EthTxIndex)
+	}
+
+	traces := flattenCallFrame(frame, []int{}, nil)
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &hash
+		trace.TransactionPosition = &position
+	}
+
+	return traces, nil
+}
+
+// Filter returns the call traces of the given block range that match the
+// from and to addresses. The traces are returned in block order, skipping the
+// first `after` traces and up to `count` traces.
+func (a *API) Filter(args TraceFilterArgs) ([]*Trace, error) {
+	a.logger.Debug("trace_filter", "args", args)
+
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	fromBlock, toBlock := uint64(1), uint64(latest)
+	if args.FromBlock != nil {
+		fromBlock = uint64(*args.FromBlock)
+	}
+	if args.ToBlock != nil {
+		toBlock = uint64(*args.ToBlock)
+	}
+
+	switch {
+	case fromBlock == 0:
+		return nil, errors.New("genesis is not traceable")
+	case fromBlock > toBlock:
+		return nil, fmt.Errorf("invalid block range: from block %d is greater than to block %d", fromBlock, toBlock)
+	case toBlock > uint64(latest):
+		return nil, fmt.Errorf("invalid block range: to block %d is greater than latest block %d", toBlock, latest)
+	case toBlock-fromBlock >= MaxFilterBlockRange:
+		return nil, fmt.Errorf("block range too large: %d > %d", toBlock-fromBlock+1, MaxFilterBlockRange)
+	}
+
+	var (
+		fromAddresses = addressSet(args.FromAddress)
+		toAddresses   = addressSet(args.ToAddress)
+		skipped       uint64
+		traces        = []*Trace{}
+	)
+
+	for height := fromBlock; height <= toBlock; height++ {
+		blockNr := rpctypes.BlockNumber(height) // #nosec G701 -- bounded by the latest block number
+		resBlock, err := a.getBlock(rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+		if err != nil {
+			return nil, err
+		}
+
+		blockTraces, err := a.blockTraces(resBlock)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			from, to := traceAddresses(trace)
+			if !matchAddress(fromAddresses, from) || !matchAddress(toAddresses, to) {
+				continue
+			}
+
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and
+// returns the requested trace types ("trace", "stateDiff" and "vmTrace") of
+// each of them.
+func (a *API) ReplayBlockTransactions(blockNrOrHash rpctypes.BlockNumberOrHash, traceTypes []string) ([]*TraceResults, error) {
+	a.logger.Debug("trace_replayBlockTransactions", "block number or hash", blockNrOrHash, "trace types", traceTypes)
+
+	resBlock, err := a.getBlock(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var txHashes []*common.Hash
+	results, err := replay(traceTypes, func(tracer, tracerConfig string) ([]interface{}, error) {
+		txResults, err := a.traceBlock(resBlock, tracer, tracerConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		txHashes = make([]*common.Hash, len(txResults))
+		traces := make([]interface{}, len(txResults))
+		for i, txResult := range txResults {
+			if txResult.Error != "" {
+				return nil, fmt.Errorf("failed to trace transaction %d of block %d: %s", i, resBlock.Block.Height, txResult.Error)
+			}
+			txHashes[i] = txResult.TxHash
+			traces[i] = txResult.Result
+		}
+		return traces, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, result := range results {
+		result.TransactionHash = txHashes[i]
+	}
+
+	return results, nil
+}
+
+// ReplayTransaction replays the given transaction and returns the requested
+// trace types ("trace", "stateDiff" and "vmTrace").
+func (a *API) ReplayTransaction(hash common.Hash, traceTypes []string) (*TraceResults, error) {
+	a.logger.Debug("trace_replayTransaction", "hash", hash, "trace types", traceTypes)
+
+	results, err := replay(traceTypes, func(tracer, tracerConfig string) ([]interface{}, error) {
+		result, err := a.backend.TraceTransaction(hash, &evmtypes.TraceConfig{
+			Tracer:           tracer,
+			TracerJsonConfig: tracerConfig,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{result}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results[0], nil
+}
+
+// getBlock returns the Tendermint block of the given number or hash.
+func (a *API) getBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (*tmrpctypes.ResultBlock, error) {
+	blockNr, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if blockNr == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		a.logger.Debug("get block failed", "number", blockNr, "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block %d not found", blockNr)
+	}
+
+	return resBlock, nil
+}
+
+// traceBlock traces the transactions of the block with the given tracer. The
+// transactions that can't be traced have the error set on their result.
+func (a *API) traceBlock(resBlock *tmrpctypes.ResultBlock, tracer, tracerConfig string) ([]*evmtypes.TxTraceResult, error) {
+	config := &evmtypes.TraceConfig{
+		Tracer:           tracer,
+		TracerJsonConfig: tracerConfig,
+	}
+
+	return a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
+}
+
+// blockTraces returns the call traces of all the transactions of the block.
+// A transaction that can't be traced gets a single trace with the error,
+// instead of failing the whole block.
+func (a *API) blockTraces(resBlock *tmrpctypes.ResultBlock) ([]*Trace, error) {
+	results, err := a.traceBlock(resBlock, evmtracers.CallTracer, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		blockHash   = common.BytesToHash(resBlock.Block.Hash())
+		blockNumber = uint64(resBlock.Block.Height) // #nosec G701 -- block height is always positive
+		traces      = []*Trace{}
+	)
+
+	for i, result := range results {
+		position := uint64(i)
+		txTraces := resultTraces(result)
+		for _, trace := range txTraces {
+			trace.BlockHash = &blockHash
+			trace.BlockNumber = &blockNumber
+			trace.TransactionHash = result.TxHash
+			trace.TransactionPosition = &position
+		}
+		traces = append(traces, txTraces...)
+	}
+
+	return traces, nil
+}
+
+// replay returns the requested trace types of the replayed transactions. The
+// trace function runs the given tracer on each of the transactions.
+func replay(traceTypes []string, trace func(tracer, tracerConfig string) ([]interface{}, error)) ([]*TraceResults, error) {
+	var withTrace, withStateDiff, withVMTrace bool
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace:
+			withTrace = true
+		case TraceTypeStateDiff:
+			withStateDiff = true
+		case TraceTypeVMTrace:
+			withVMTrace = true
+		default:
+			return nil, fmt.Errorf("invalid trace type %q", traceType)
+		}
+	}
+
+	// the call tracer is always run to get the output of the transactions
+	calls, err := trace(evmtracers.CallTracer, "")
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*TraceResults, len(calls))
+	for i, call := range calls {
+		var frame evmtracers.CallFrame
+		if err := decodeTraceResult(call, &frame); err != nil {
+			return nil, err
+		}
+
+		results[i] = &TraceResults{Output: frame.Output}
+		if withTrace {
+			results[i].Trace = flattenCallFrame(frame, []int{}, nil)
+		}
+	}
+
+	if withStateDiff {
+		diffs, err := trace(evmtracers.PrestateTracer, stateDiffTracerConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, diff := range diffs {
+			var prestate prestateDiff
+			if err := decodeTraceResult(diff, &prestate); err != nil {
+				return nil, err
+			}
+			results[i].StateDiff = newStateDiff(prestate)
+		}
+	}
+
+	if withVMTrace {
+		vmTraces, err := trace(evmtracers.VMTraceTracer, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for i, vmTrace := range vmTraces {
+			results[i].VMTrace = new(evmtracers.VMTrace)
+			if err := decodeTraceResult(vmTrace, results[i].VMTrace); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// decodeTraceResult decodes the generic tracer output into the given value.
+func decodeTraceResult(result interface{}, v interface{}) error {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
+
+// traceAddresses returns the sender and recipient of the trace. The recipient
+// of a contract creation is the created contract.
+func traceAddresses(trace *Trace) (from, to common.Address) {
+	switch action := trace.Action.(type) {
+	case CallAction:
+		return action.From, action.To
+	case CreateAction:
+		if result, ok := trace.Result.(CreateResult); ok {
+			return action.From, result.Address
+		}
+		return action.From, common.Address{}
+	case SuicideAction:
+		return action.Address, action.RefundAddress
+	default:
+		return common.Address{}, common.Address{}
+	}
+}
+
+func addressSet(addresses []common.Address) map[common.Address]bool {
+	set := make(map[common.Address]bool, len(addresses))
+	for _, addr := range addresses {
+		set[addr] = true
+	}
+	return set
+}
+
+// matchAddress returns true if the address is in the set or the set is empty.
+func matchAddress(set map[common.Address]bool, addr common.Address) bool {
+	return len(set) == 0 || set[addr]
+}
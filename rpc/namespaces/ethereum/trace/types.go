@@ -0,0 +1,362 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package trace
+
+import (
+	"bytes"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+const (
+	// TraceTypeTrace requests the flat list of call traces.
+	TraceTypeTrace = "trace"
+	// TraceTypeStateDiff requests the state changes made by the transaction.
+	TraceTypeStateDiff = "stateDiff"
+	// TraceTypeVMTrace requests the trace of the executed operations.
+	TraceTypeVMTrace = "vmTrace"
+)
+
+// Trace is a single call trace in the Parity/OpenEthereum format. The block
+// and transaction fields are omitted on the replay methods.
+type Trace struct {
+	Action              interface{}  `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              interface{}  `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+// CallAction is the action of a call trace.
+type CallAction struct {
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	To       common.Address `json:"to"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Input    hexutil.Bytes  `json:"input"`
+	Value    *hexutil.Big   `json:"value"`
+}
+
+// CreateAction is the action of a contract creation trace.
+type CreateAction struct {
+	From  common.Address `json:"from"`
+	Gas   hexutil.Uint64 `json:"gas"`
+	Init  hexutil.Bytes  `json:"init"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// SuicideAction is the action of a self-destruct trace.
+type SuicideAction struct {
+	Address       common.Address `json:"address"`
+	RefundAddress common.Address `json:"refundAddress"`
+	Balance       *hexutil.Big   `json:"balance"`
+}
+
+// CallResult is the result of a successful call trace.
+type CallResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+// CreateResult is the result of a successful contract creation trace.
+type CreateResult struct {
+	Address common.Address `json:"address"`
+	Code    hexutil.Bytes  `json:"code"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+// TraceResults are the traces of a replayed transaction. Only the requested
+// trace types are set.
+type TraceResults struct {
+	Output          hexutil.Bytes       `json:"output"`
+	StateDiff       StateDiff           `json:"stateDiff"`
+	Trace           []*Trace            `json:"trace"`
+	VMTrace         *evmtracers.VMTrace `json:"vmTrace"`
+	TransactionHash *common.Hash        `json:"transactionHash,omitempty"`
+}
+
+// TraceFilterArgs are the arguments of the `trace_filter` JSON-RPC method.
+// A transaction matches the filter if any of its traces is sent from one of
+// the from addresses and to one of the to addresses. Empty address lists
+// match any address.
+type TraceFilterArgs struct {
+	FromBlock   *hexutil.Uint64  `json:"fromBlock"`
+	ToBlock     *hexutil.Uint64  `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// StateDiff is the Parity/OpenEthereum state diff of a transaction, keyed by
+// account address.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff holds the changes of each field of an account. Each change is
+// either "=" (unchanged), {"+": value} (created), {"-": value} (deleted) or
+// {"*": {"from": value, "to": value}} (modified).
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// diffChange is a modified field of an account diff.
+type diffChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+const diffUnchanged = "="
+
+// prestateAccount is an account of the prestateTracer output in diff mode.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// prestateDiff is the output of the prestateTracer native tracer in diff mode.
+type prestateDiff struct {
+	Pre  map[common.Address]*prestateAccount `json:"pre"`
+	Post map[common.Address]*prestateAccount `json:"post"`
+}
+
+// parityErrors maps the go-ethereum execution errors to the Parity/OpenEthereum
+// error messages.
+var parityErrors = map[string]string{
+	vm.ErrExecutionReverted.Error():        "Reverted",
+	vm.ErrOutOfGas.Error():                 "Out of gas",
+	vm.ErrCodeStoreOutOfGas.Error():        "Out of gas",
+	vm.ErrGasUintOverflow.Error():          "Out of gas",
+	vm.ErrMaxCodeSizeExceeded.Error():      "Out of gas",
+	vm.ErrInvalidJump.Error():              "Bad jump destination",
+	vm.ErrDepth.Error():                    "Out of stack",
+	vm.ErrInsufficientBalance.Error():      "Insufficient balance",
+	vm.ErrWriteProtection.Error():          "Mutable Call In Static Context",
+	vm.ErrContractAddressCollision.Error(): "Contract address collision",
+	vm.ErrReturnDataOutOfBounds.Error():    "Out of bounds",
+}
+
+// parityErrorPrefixes maps the go-ethereum execution errors that include
+// details about the failure to the Parity/OpenEthereum error messages.
+var parityErrorPrefixes = []struct {
+	prefix, msg string
+}{
+	{"stack underflow", "Stack underflow"},
+	{"stack limit reached", "Out of stack"},
+	{"invalid opcode", "Bad instruction"},
+}
+
+// parityError returns the Parity/OpenEthereum message of an execution error.
+func parityError(err string) string {
+	if msg, ok := parityErrors[err]; ok {
+		return msg
+	}
+	for _, e := range parityErrorPrefixes {
+		if strings.HasPrefix(err, e.prefix) {
+			return e.msg
+		}
+	}
+	return err
+}
+
+// resultTraces returns the call traces of a transaction from the result of
+// the call tracer. If the transaction couldn't be traced, it returns a single
+// trace with the error and no action nor result.
+func resultTraces(result *evmtypes.TxTraceResult) []*Trace {
+	if result.Error != "" {
+		return []*Trace{{Error: result.Error, TraceAddress: []int{}}}
+	}
+
+	var frame evmtracers.CallFrame
+	if err := decodeTraceResult(result.Result, &frame); err != nil {
+		return []*Trace{{Error: err.Error(), TraceAddress: []int{}}}
+	}
+
+	return flattenCallFrame(frame, []int{}, nil)
+}
+
+// flattenCallFrame appends the traces of the call frame and its sub calls, in
+// depth-first order, to the given traces.
+func flattenCallFrame(frame evmtracers.CallFrame, traceAddress []int, traces []*Trace) []*Trace {
+	trace := &Trace{
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+
+	value := frame.Value
+	if value == nil {
+		value = (*hexutil.Big)(new(big.Int))
+	}
+
+	switch typ := vm.StringToOp(frame.Type); typ {
+	case vm.CREATE, vm.CREATE2:
+		trace.Type = "create"
+		trace.Action = CreateAction{
+			From:  frame.From,
+			Gas:   frame.Gas,
+			Init:  frame.Input,
+			Value: value,
+		}
+		if frame.Error == "" {
+			trace.Result = CreateResult{
+				Address: frame.To,
+				Code:    frame.Output,
+				GasUsed: frame.GasUsed,
+			}
+		}
+	case vm.SELFDESTRUCT:
+		trace.Type = "suicide"
+		trace.Action = SuicideAction{
+			Address:       frame.From,
+			RefundAddress: frame.To,
+			Balance:       value,
+		}
+	default:
+		trace.Type = "call"
+		trace.Action = CallAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     frame.From,
+			To:       frame.To,
+			Gas:      frame.Gas,
+			Input:    frame.Input,
+			Value:    value,
+		}
+		if frame.Error == "" {
+			trace.Result = CallResult{
+				GasUsed: frame.GasUsed,
+				Output:  frame.Output,
+			}
+		}
+	}
+
+	if frame.Error != "" {
+		trace.Error = parityError(frame.Error)
+	}
+
+	traces = append(traces, trace)
+	for i, call := range frame.Calls {
+		subAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(subAddress, traceAddress)
+		traces = flattenCallFrame(call, append(subAddress, i), traces)
+	}
+
+	return traces
+}
+
+// newStateDiff converts the diff mode output of the prestateTracer into the
+// Parity/OpenEthereum state diff. The accounts that are only present in the
+// pre state were deleted, and the ones that are only present in the post
+// state were created.
+func newStateDiff(diff prestateDiff) StateDiff {
+	stateDiff := make(StateDiff)
+
+	for addr, pre := range diff.Pre {
+		post, ok := diff.Post[addr]
+		if !ok {
+			stateDiff[addr] = deletedAccountDiff(pre)
+			continue
+		}
+		stateDiff[addr] = modifiedAccountDiff(pre, post)
+	}
+
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; !ok {
+			stateDiff[addr] = createdAccountDiff(post)
+		}
+	}
+
+	return stateDiff
+}
+
+func createdAccountDiff(post *prestateAccount) *AccountDiff {
+	accountDiff := &AccountDiff{
+		Balance: map[string]interface{}{"+": accountBalance(post)},
+		Code:    map[string]interface{}{"+": accountCode(post)},
+		Nonce:   map[string]interface{}{"+": hexutil.Uint64(post.Nonce)},
+		Storage: make(map[common.Hash]interface{}),
+	}
+	for key, val := range post.Storage {
+		accountDiff.Storage[key] = map[string]interface{}{"+": val}
+	}
+	return accountDiff
+}
+
+func deletedAccountDiff(pre *prestateAccount) *AccountDiff {
+	accountDiff := &AccountDiff{
+		Balance: map[string]interface{}{"-": accountBalance(pre)},
+		Code:    map[string]interface{}{"-": accountCode(pre)},
+		Nonce:   map[string]interface{}{"-": hexutil.Uint64(pre.Nonce)},
+		Storage: make(map[common.Hash]interface{}),
+	}
+	for key, val := range pre.Storage {
+		accountDiff.Storage[key] = map[string]interface{}{"-": val}
+	}
+	return accountDiff
+}
+
+// modifiedAccountDiff returns the diff of an existing account. The post state
+// only holds the modified fields and the storage slots that are not cleared.
+func modifiedAccountDiff(pre, post *prestateAccount) *AccountDiff {
+	accountDiff := &AccountDiff{
+		Balance: diffUnchanged,
+		Code:    diffUnchanged,
+		Nonce:   diffUnchanged,
+		Storage: make(map[common.Hash]interface{}),
+	}
+
+	if post.Balance != nil && post.Balance.ToInt().Cmp(accountBalance(pre).ToInt()) != 0 {
+		accountDiff.Balance = modified(accountBalance(pre), post.Balance)
+	}
+	if len(post.Code) > 0 && !bytes.Equal(post.Code, pre.Code) {
+		accountDiff.Code = modified(accountCode(pre), post.Code)
+	}
+	if post.Nonce != 0 && post.Nonce != pre.Nonce {
+		accountDiff.Nonce = modified(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+	}
+
+	for key, val := range post.Storage {
+		if pre.Storage[key] != val {
+			accountDiff.Storage[key] = modified(pre.Storage[key], val)
+		}
+	}
+	for key, val := range pre.Storage {
+		if _, ok := post.Storage[key]; !ok {
+			accountDiff.Storage[key] = modified(val, common.Hash{})
+		}
+	}
+
+	return accountDiff
+}
+
+func modified(from, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": diffChange{From: from, To: to}}
+}
+
+func accountBalance(account *prestateAccount) *hexutil.Big {
+	if account.Balance == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return account.Balance
+}
+
+func accountCode(account *prestateAccount) hexutil.Bytes {
+	if account.Code == nil {
+		return hexutil.Bytes{}
+	}
+	return account.Code
+}
@@ -0,0 +1,226 @@
+package trace
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+func TestFlattenCallFrame(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1")
+		contract = common.HexToAddress("0x2")
+		created  = common.HexToAddress("0x3")
+		value    = (*hexutil.Big)(big.NewInt(10))
+		zero     = (*hexutil.Big)(new(big.Int))
+	)
+
+	frame := evmtracers.CallFrame{
+		Type:    "CALL",
+		From:    sender,
+		To:      contract,
+		Value:   value,
+		Gas:     100000,
+		GasUsed: 50000,
+		Input:   hexutil.Bytes{0x01},
+		Output:  hexutil.Bytes{0x02},
+		Calls: []evmtracers.CallFrame{
+			{
+				Type:    "CREATE2",
+				From:    contract,
+				To:      created,
+				Gas:     60000,
+				GasUsed: 30000,
+				Input:   hexutil.Bytes{0x60},
+				Output:  hexutil.Bytes{0x00},
+				Calls: []evmtracers.CallFrame{
+					{Type: "STATICCALL", From: created, To: contract, Error: "execution reverted"},
+				},
+			},
+			{Type: "SELFDESTRUCT", From: contract, To: sender, Value: value},
+		},
+	}
+
+	traces := flattenCallFrame(frame, []int{}, nil)
+	require.Equal(t, []*Trace{
+		{
+			Action: CallAction{
+				CallType: "call",
+				From:     sender,
+				To:       contract,
+				Gas:      100000,
+				Input:    hexutil.Bytes{0x01},
+				Value:    value,
+			},
+			Result:       CallResult{GasUsed: 50000, Output: hexutil.Bytes{0x02}},
+			Subtraces:    2,
+			TraceAddress: []int{},
+			Type:         "call",
+		},
+		{
+			Action: CreateAction{
+				From:  contract,
+				Gas:   60000,
+				Init:  hexutil.Bytes{0x60},
+				Value: zero,
+			},
+			Result:       CreateResult{Address: created, Code: hexutil.Bytes{0x00}, GasUsed: 30000},
+			Subtraces:    1,
+			TraceAddress: []int{0},
+			Type:         "create",
+		},
+		{
+			Action: CallAction{
+				CallType: "staticcall",
+				From:     created,
+				To:       contract,
+				Value:    zero,
+			},
+			Error:        "Reverted",
+			TraceAddress: []int{0, 0},
+			Type:         "call",
+		},
+		{
+			Action: SuicideAction{
+				Address:       contract,
+				RefundAddress: sender,
+				Balance:       value,
+			},
+			TraceAddress: []int{1},
+			Type:         "suicide",
+		},
+	}, traces)
+}
+
+func TestResultTraces(t *testing.T) {
+	var (
+		sender   = common.HexToAddress("0x1")
+		contract = common.HexToAddress("0x2")
+		zero     = (*hexutil.Big)(new(big.Int))
+	)
+
+	testCases := []struct {
+		name      string
+		result    *evmtypes.TxTraceResult
+		expTraces []*Trace
+	}{
+		{
+			"traced transaction",
+			&evmtypes.TxTraceResult{Result: map[string]interface{}{"type": "CALL", "from": sender.Hex(), "to": contract.Hex()}},
+			[]*Trace{
+				{
+					Action:       CallAction{CallType: "call", From: sender, To: contract, Value: zero},
+					Result:       CallResult{},
+					TraceAddress: []int{},
+					Type:         "call",
+				},
+			},
+		},
+		{
+			"transaction that failed to trace",
+			&evmtypes.TxTraceResult{Error: "execution timeout"},
+			[]*Trace{{Error: "execution timeout", TraceAddress: []int{}}},
+		},
+		{
+			"invalid trace result",
+			&evmtypes.TxTraceResult{Result: "invalid"},
+			[]*Trace{{Error: "json: cannot unmarshal string into Go value of type tracers.CallFrame", TraceAddress: []int{}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expTraces, resultTraces(tc.result))
+		})
+	}
+}
+
+func TestParityError(t *testing.T) {
+	testCases := []struct {
+		err    string
+		expMsg string
+	}{
+		{"execution reverted", "Reverted"},
+		{"out of gas", "Out of gas"},
+		{"invalid jump destination", "Bad jump destination"},
+		{"invalid opcode: opcode 0xfe not defined", "Bad instruction"},
+		{"stack underflow (0 <=> 1)", "Stack underflow"},
+		{"unknown error", "unknown error"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expMsg, parityError(tc.err), tc.err)
+	}
+}
+
+func TestNewStateDiff(t *testing.T) {
+	var (
+		modifiedAddr = common.HexToAddress("0x1")
+		createdAddr  = common.HexToAddress("0x2")
+		deletedAddr  = common.HexToAddress("0x3")
+		slot1        = common.HexToHash("0x1")
+		slot2        = common.HexToHash("0x2")
+		val1         = common.HexToHash("0x11")
+		val2         = common.HexToHash("0x22")
+	)
+
+	diff := prestateDiff{
+		Pre: map[common.Address]*prestateAccount{
+			modifiedAddr: {
+				Balance: (*hexutil.Big)(big.NewInt(100)),
+				Nonce:   1,
+				Code:    hexutil.Bytes{0x60},
+				Storage: map[common.Hash]common.Hash{slot1: val1},
+			},
+			deletedAddr: {
+				Balance: (*hexutil.Big)(big.NewInt(5)),
+				Code:    hexutil.Bytes{0x61},
+				Nonce:   1,
+			},
+		},
+		Post: map[common.Address]*prestateAccount{
+			modifiedAddr: {
+				Balance: (*hexutil.Big)(big.NewInt(90)),
+				Storage: map[common.Hash]common.Hash{slot2: val2},
+			},
+			createdAddr: {
+				Code:    hexutil.Bytes{0x62},
+				Nonce:   1,
+				Storage: map[common.Hash]common.Hash{slot1: val1},
+			},
+		},
+	}
+
+	stateDiff := newStateDiff(diff)
+	require.Len(t, stateDiff, 3)
+
+	require.Equal(t, &AccountDiff{
+		Balance: modified((*hexutil.Big)(big.NewInt(100)), (*hexutil.Big)(big.NewInt(90))),
+		Code:    diffUnchanged,
+		Nonce:   diffUnchanged,
+		Storage: map[common.Hash]interface{}{
+			slot1: modified(val1, common.Hash{}),
+			slot2: modified(common.Hash{}, val2),
+		},
+	}, stateDiff[modifiedAddr])
+
+	require.Equal(t, &AccountDiff{
+		Balance: map[string]interface{}{"+": (*hexutil.Big)(new(big.Int))},
+		Code:    map[string]interface{}{"+": hexutil.Bytes{0x62}},
+		Nonce:   map[string]interface{}{"+": hexutil.Uint64(1)},
+		Storage: map[common.Hash]interface{}{slot1: map[string]interface{}{"+": val1}},
+	}, stateDiff[createdAddr])
+
+	require.Equal(t, &AccountDiff{
+		Balance: map[string]interface{}{"-": (*hexutil.Big)(big.NewInt(5))},
+		Code:    map[string]interface{}{"-": hexutil.Bytes{0x61}},
+		Nonce:   map[string]interface{}{"-": hexutil.Uint64(1)},
+		Storage: map[common.Hash]interface{}{},
+	}, stateDiff[deletedAddr])
+}
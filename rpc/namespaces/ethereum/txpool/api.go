@@ -3,23 +3,29 @@
 package txpool
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/kato114/byte/v15/rpc/backend"
 	"github.com/kato114/byte/v15/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
+// The pool content is read from the unconfirmed txs of the CometBFT mempool.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
@@ -30,24 +36,97 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = formatTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = formatTxs(txs)
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// that were sent by the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+
+	pending, queued, err := api.backend.TxPoolContentFrom(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatTxs(pending),
+		"queued":  formatTxs(queued),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = inspectTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = inspectTxs(txs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	numPending, numQueued, err := api.backend.TxPoolStatus()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(numPending),
+		"queued":  hexutil.Uint(numQueued),
+	}, nil
+}
+
+// formatTxs keys the transactions by their decimal nonce.
+func formatTxs(txs backend.TxPoolTxs) map[string]*types.RPCTransaction {
+	result := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = tx
+	}
+	return result
+}
+
+// inspectTxs summarizes the transactions keyed by their decimal nonce.
+func inspectTxs(txs backend.TxPoolTxs) map[string]string {
+	result := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = inspectTx(tx)
+	}
+	return result
+}
+
+// inspectTx returns a one-line summary of the transaction.
+func inspectTx(tx *types.RPCTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %s wei + %d gas × %s wei", tx.To.Hex(), tx.Value.ToInt(), tx.Gas, tx.GasPrice.ToInt())
 	}
+	return fmt.Sprintf("contract creation: %s wei + %d gas × %s wei", tx.Value.ToInt(), tx.Gas, tx.GasPrice.ToInt())
 }
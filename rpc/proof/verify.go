@@ -0,0 +1,158 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+
+// Package proof verifies the eth_getProof responses against the AppHash of a
+// block. The account state isn't committed to an ethereum state root but to
+// the multistore, so the response carries the ICS-23 proofs of the store
+// entries making up the account at the proof height:
+//
+//   - the account in the auth store, holding the nonce and the code hash
+//   - the evm params in the evm store, holding the denom of the balance
+//   - the balance of the evm denom in the bank store
+//   - each requested storage slot in the evm store
+//
+// Each store proof has the key and value of the entry, with an empty value for
+// an absent entry, and the proof ops of the key in the store and of the store
+// in the multistore. The state at a height is committed to the AppHash of the
+// header of the next block.
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	"github.com/kato114/byte/v15/types"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// Verify checks that the account and the storage slots of the eth_getProof
+// response are proved by its store proofs against the AppHash, which must be
+// the AppHash of the header following the proof height. The codec must have
+// the account types of the chain registered.
+func Verify(cdc codec.Codec, appHash []byte, res *rpctypes.AccountResult) error {
+	if res == nil || res.StoreProofs == nil {
+		return fmt.Errorf("response has no store proofs")
+	}
+	proofs := res.StoreProofs
+
+	// nonce and code hash
+	accountKey := authtypes.AddressStoreKey(sdk.AccAddress(res.Address.Bytes()))
+	if err := VerifyStoreProof(appHash, authtypes.StoreKey, accountKey, proofs.Account); err != nil {
+		return fmt.Errorf("invalid account proof: %w", err)
+	}
+
+	nonce, codeHash, err := decodeAccount(cdc, proofs.Account.Value)
+	if err != nil {
+		return err
+	}
+	if uint64(res.Nonce) != nonce {
+		return fmt.Errorf("nonce mismatch, expected %d, got %d", nonce, uint64(res.Nonce))
+	}
+	if res.CodeHash != codeHash {
+		return fmt.Errorf("code hash mismatch, expected %s, got %s", codeHash, res.CodeHash)
+	}
+
+	// balance
+	if err := VerifyStoreProof(appHash, evmtypes.StoreKey, evmtypes.KeyPrefixParams, proofs.Params); err != nil {
+		return fmt.Errorf("invalid params proof: %w", err)
+	}
+
+	var params evmtypes.Params
+	if len(proofs.Params.Value) == 0 {
+		return fmt.Errorf("evm params not found in store")
+	}
+	if err := cdc.Unmarshal(proofs.Params.Value, &params); err != nil {
+		return fmt.Errorf("invalid evm params: %w", err)
+	}
+
+	balanceKey := banktypes.CreatePrefixedAccountStoreKey(res.Address.Bytes(), []byte(params.EvmDenom))
+	if err := VerifyStoreProof(appHash, banktypes.StoreKey, balanceKey, proofs.Balance); err != nil {
+		return fmt.Errorf("invalid balance proof: %w", err)
+	}
+
+	balance := sdkmath.ZeroInt()
+	if len(proofs.Balance.Value) > 0 {
+		if err := balance.Unmarshal(proofs.Balance.Value); err != nil {
+			return fmt.Errorf("invalid balance: %w", err)
+		}
+	}
+	if res.Balance == nil || res.Balance.ToInt().Cmp(balance.BigInt()) != 0 {
+		return fmt.Errorf("balance mismatch, expected %s", balance)
+	}
+
+	// storage slots
+	for _, slot := range res.StorageProof {
+		if slot.StoreProof == nil {
+			return fmt.Errorf("storage slot %s has no store proof", slot.Key)
+		}
+
+		stateKey := evmtypes.StateKey(res.Address, common.HexToHash(slot.Key).Bytes())
+		if err := VerifyStoreProof(appHash, evmtypes.StoreKey, stateKey, *slot.StoreProof); err != nil {
+			return fmt.Errorf("invalid storage proof of slot %s: %w", slot.Key, err)
+		}
+
+		value := new(big.Int).SetBytes(slot.StoreProof.Value)
+		if slot.Value == nil || slot.Value.ToInt().Cmp(value) != 0 {
+			return fmt.Errorf("storage slot %s value mismatch, expected %s", slot.Key, value)
+		}
+	}
+
+	return nil
+}
+
+// VerifyStoreProof checks that the store proof is the proof of the key of the
+// store against the AppHash, and proves its value, or its absence if the value
+// is empty.
+func VerifyStoreProof(appHash []byte, storeKey string, key []byte, proof rpctypes.StoreProof) error {
+	if proof.StoreKey != storeKey || !bytes.Equal(proof.Key, key) {
+		return fmt.Errorf("proof of key %x in store %s, expected key %x in store %s", []byte(proof.Key), proof.StoreKey, key, storeKey)
+	}
+
+	ops := &crypto.ProofOps{Ops: make([]crypto.ProofOp, len(proof.ProofOps))}
+	for i, op := range proof.ProofOps {
+		ops.Ops[i] = crypto.ProofOp{Type: op.Type, Key: op.Key, Data: op.Data}
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(proof.Value) == 0 {
+		return prt.VerifyAbsence(ops, appHash, keyPath)
+	}
+	return prt.VerifyValue(ops, appHash, keyPath, proof.Value)
+}
+
+// decodeAccount returns the nonce and the code hash of the account stored in
+// the auth store, or of an empty account if the value is empty.
+func decodeAccount(cdc codec.Codec, bz []byte) (uint64, common.Hash, error) {
+	emptyCodeHash := common.BytesToHash(evmtypes.EmptyCodeHash)
+	if len(bz) == 0 {
+		return 0, emptyCodeHash, nil
+	}
+
+	var account authtypes.AccountI
+	if err := cdc.UnmarshalInterface(bz, &account); err != nil {
+		return 0, common.Hash{}, fmt.Errorf("invalid account: %w", err)
+	}
+
+	codeHash := emptyCodeHash
+	if ethAccount, ok := account.(types.EthAccountI); ok {
+		codeHash = ethAccount.GetCodeHash()
+	}
+	return account.GetSequence(), codeHash, nil
+}
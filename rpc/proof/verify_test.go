@@ -0,0 +1,247 @@
+package proof
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	utiltx "github.com/kato114/byte/v15/testutil/tx"
+	"github.com/kato114/byte/v15/types"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+func makeCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+// setupStore commits the evm params and the account state, if not empty, to a
+// multistore and returns the proofs of the account and of the storage slots,
+// with the AppHash.
+func setupStore(t *testing.T, cdc codec.Codec, addr common.Address, slots []common.Hash, empty bool) ([]byte, *rpctypes.AccountResult) {
+	db := dbm.NewMemDB()
+	ms := rootmulti.NewStore(db, log.NewNopLogger())
+	keys := sdk.NewKVStoreKeys(authtypes.StoreKey, banktypes.StoreKey, evmtypes.StoreKey)
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	codeHash := crypto.Keccak256Hash([]byte("code"))
+	account := &types.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(addr.Bytes()), nil, 3, 5),
+		CodeHash:    codeHash.Hex(),
+	}
+	accountBz, err := cdc.MarshalInterface(authtypes.AccountI(account))
+	require.NoError(t, err)
+	accountKey := authtypes.AddressStoreKey(sdk.AccAddress(addr.Bytes()))
+
+	params := evmtypes.DefaultParams()
+	paramsBz, err := cdc.Marshal(&params)
+	require.NoError(t, err)
+	ms.GetKVStore(keys[evmtypes.StoreKey]).Set(evmtypes.KeyPrefixParams, paramsBz)
+
+	balanceBz, err := sdkmath.NewInt(1000).Marshal()
+	require.NoError(t, err)
+	balanceKey := banktypes.CreatePrefixedAccountStoreKey(addr.Bytes(), []byte(params.EvmDenom))
+
+	// the stores have other entries, as the absence can't be proved in an
+	// empty tree
+	other := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	ms.GetKVStore(keys[authtypes.StoreKey]).Set(authtypes.AddressStoreKey(other), accountBz)
+	ms.GetKVStore(keys[banktypes.StoreKey]).Set(banktypes.CreatePrefixedAccountStoreKey(other, []byte(params.EvmDenom)), balanceBz)
+
+	if !empty {
+		ms.GetKVStore(keys[authtypes.StoreKey]).Set(accountKey, accountBz)
+		ms.GetKVStore(keys[banktypes.StoreKey]).Set(balanceKey, balanceBz)
+		// only the first slot is set
+		stateKey := evmtypes.StateKey(addr, slots[0].Bytes())
+		ms.GetKVStore(keys[evmtypes.StoreKey]).Set(stateKey, common.BigToHash(big.NewInt(7)).Bytes())
+	}
+
+	cid := ms.Commit()
+
+	query := func(storeKey string, key []byte) rpctypes.StoreProof {
+		res := ms.Query(abci.RequestQuery{Path: "/" + storeKey + "/key", Data: key, Prove: true})
+		require.Equal(t, uint32(0), res.Code, res.Log)
+
+		proof := rpctypes.StoreProof{StoreKey: storeKey, Key: key, Value: res.Value}
+		for _, op := range res.ProofOps.Ops {
+			proof.ProofOps = append(proof.ProofOps, rpctypes.StoreProofOp{Type: op.Type, Key: op.Key, Data: op.Data})
+		}
+		return proof
+	}
+
+	res := &rpctypes.AccountResult{
+		Address:  addr,
+		Balance:  (*hexutil.Big)(big.NewInt(1000)),
+		CodeHash: codeHash,
+		Nonce:    5,
+		StoreProofs: &rpctypes.AccountStoreProofs{
+			Height:  hexutil.Uint64(cid.Version),
+			Account: query(authtypes.StoreKey, accountKey),
+			Params:  query(evmtypes.StoreKey, evmtypes.KeyPrefixParams),
+			Balance: query(banktypes.StoreKey, balanceKey),
+		},
+	}
+	for _, slot := range slots {
+		storeProof := query(evmtypes.StoreKey, evmtypes.StateKey(addr, slot.Bytes()))
+		res.StorageProof = append(res.StorageProof, rpctypes.StorageResult{
+			Key:        slot.Hex(),
+			Value:      (*hexutil.Big)(new(big.Int).SetBytes(storeProof.Value)),
+			StoreProof: &storeProof,
+		})
+	}
+
+	return cid.Hash, res
+}
+
+func TestVerify(t *testing.T) {
+	cdc := makeCodec()
+	addr := utiltx.GenerateAddress()
+	slots := []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2")}
+
+	testCases := []struct {
+		name     string
+		malleate func(res *rpctypes.AccountResult) []byte
+		expPass  bool
+	}{
+		{
+			"pass",
+			func(*rpctypes.AccountResult) []byte { return nil },
+			true,
+		},
+		{
+			"fail - no store proofs",
+			func(res *rpctypes.AccountResult) []byte {
+				res.StoreProofs = nil
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - other app hash",
+			func(*rpctypes.AccountResult) []byte {
+				return crypto.Keccak256([]byte("other"))
+			},
+			false,
+		},
+		{
+			"fail - other nonce",
+			func(res *rpctypes.AccountResult) []byte {
+				res.Nonce = 6
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - other code hash",
+			func(res *rpctypes.AccountResult) []byte {
+				res.CodeHash = common.BytesToHash(evmtypes.EmptyCodeHash)
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - other balance",
+			func(res *rpctypes.AccountResult) []byte {
+				res.Balance = (*hexutil.Big)(big.NewInt(1001))
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - tampered balance proof value",
+			func(res *rpctypes.AccountResult) []byte {
+				bz, _ := sdkmath.NewInt(1001).Marshal()
+				res.StoreProofs.Balance.Value = bz
+				res.Balance = (*hexutil.Big)(big.NewInt(1001))
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - balance proof of another key",
+			func(res *rpctypes.AccountResult) []byte {
+				res.StoreProofs.Balance = res.StoreProofs.Account
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - other storage value",
+			func(res *rpctypes.AccountResult) []byte {
+				res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(8))
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - value of an absent slot",
+			func(res *rpctypes.AccountResult) []byte {
+				res.StorageProof[1].StoreProof.Value = common.BigToHash(big.NewInt(1)).Bytes()
+				res.StorageProof[1].Value = (*hexutil.Big)(big.NewInt(1))
+				return nil
+			},
+			false,
+		},
+		{
+			"fail - storage slot without store proof",
+			func(res *rpctypes.AccountResult) []byte {
+				res.StorageProof[0].StoreProof = nil
+				return nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appHash, res := setupStore(t, cdc, addr, slots, false)
+			if otherHash := tc.malleate(res); otherHash != nil {
+				appHash = otherHash
+			}
+
+			err := Verify(cdc, appHash, res)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyEmptyAccount(t *testing.T) {
+	cdc := makeCodec()
+	slots := []common.Hash{common.HexToHash("0x1")}
+	appHash, res := setupStore(t, cdc, utiltx.GenerateAddress(), slots, true)
+
+	// the account is proved absent
+	require.Error(t, Verify(cdc, appHash, res))
+
+	res.Nonce = 0
+	res.CodeHash = common.BytesToHash(evmtypes.EmptyCodeHash)
+	res.Balance = (*hexutil.Big)(big.NewInt(0))
+	require.NoError(t, Verify(cdc, appHash, res))
+}
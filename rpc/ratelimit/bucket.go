@@ -0,0 +1,81 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is the interval at which the full buckets are dropped, as
+// they're equivalent to new ones.
+const sweepInterval = time.Minute
+
+// bucket is a token bucket, refilled at the rate of its buckets up to the
+// burst.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// buckets are the token buckets of a set of clients, sharing the same rate
+// and burst.
+type buckets struct {
+	mu        sync.Mutex
+	rate      float64 // tokens refilled per second
+	burst     float64 // max tokens of a bucket
+	entries   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newBuckets(rate, burst float64) *buckets {
+	return &buckets{
+		rate:    rate,
+		burst:   burst,
+		entries: make(map[string]*bucket),
+	}
+}
+
+// take consumes the cost from the bucket of the client, returns false without
+// consuming it if the bucket doesn't have enough tokens.
+func (b *buckets) take(key string, cost float64, now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Sub(b.lastSweep) >= sweepInterval {
+		for k, e := range b.entries {
+			if b.refill(e, now) >= b.burst {
+				delete(b.entries, k)
+			}
+		}
+		b.lastSweep = now
+	}
+
+	e, ok := b.entries[key]
+	if !ok {
+		e = &bucket{tokens: b.burst, last: now}
+		b.entries[key] = e
+	}
+
+	e.tokens = b.refill(e, now)
+	e.last = now
+	if e.tokens < cost {
+		return false
+	}
+	e.tokens -= cost
+	return true
+}
+
+// refill returns the tokens of the bucket at the given time.
+func (b *buckets) refill(e *bucket, now time.Time) float64 {
+	elapsed := now.Sub(e.last).Seconds()
+	if elapsed <= 0 {
+		return e.tokens
+	}
+
+	tokens := e.tokens + elapsed*b.rate
+	if tokens > b.burst {
+		return b.burst
+	}
+	return tokens
+}
@@ -0,0 +1,196 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/kato114/byte/v15/rpc/auth"
+	"github.com/kato114/byte/v15/server/config"
+)
+
+// CodeLimitExceeded is the JSON-RPC error code of the rejected requests, as
+// defined by EIP-1474.
+const CodeLimitExceeded = -32005
+
+// ErrLimitExceeded is returned for the requests exceeding the rate limit of the
+// client.
+var ErrLimitExceeded = errors.New("rate limit exceeded")
+
+var (
+	allowedCounter     = metrics.NewRegisteredCounter("rpc/ratelimit/allowed", nil)
+	rejectedIPCounter  = metrics.NewRegisteredCounter("rpc/ratelimit/rejected/ip", nil)
+	rejectedKeyCounter = metrics.NewRegisteredCounter("rpc/ratelimit/rejected/key", nil)
+	costIPCounter      = metrics.NewRegisteredCounter("rpc/ratelimit/cost/ip", nil)
+)
+
+// Limiter limits the cost of the JSON-RPC requests served per time for each
+// authenticated client, or for each IP sending requests without credentials,
+// with token buckets.
+type Limiter struct {
+	ip    *buckets // nil if the requests without credentials aren't limited
+	key   *buckets // nil if the authenticated requests aren't limited
+	costs Costs
+	now   func() time.Time
+}
+
+// NewLimiter creates the rate limiter from the JSON-RPC config, returns nil if
+// no rate limit is set.
+func NewLimiter(cfg config.JSONRPCConfig) (*Limiter, error) {
+	if cfg.RateLimitIP == 0 && cfg.RateLimitKey == 0 {
+		return nil, nil
+	}
+
+	costs, err := ParseCosts(cfg.RateLimitCosts)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Limiter{
+		costs: costs,
+		now:   time.Now,
+	}
+	if cfg.RateLimitIP > 0 {
+		l.ip = newBuckets(cfg.RateLimitIP, float64(cfg.RateLimitIPBurst))
+	}
+	if cfg.RateLimitKey > 0 {
+		l.key = newBuckets(cfg.RateLimitKey, float64(cfg.RateLimitKeyBurst))
+	}
+	return l, nil
+}
+
+// Allow consumes the cost of the methods from the bucket of the client, or of
+// the IP if the client is nil or not authenticated. Returns ErrLimitExceeded if
+// the bucket doesn't have enough tokens.
+func (l *Limiter) Allow(client *auth.Client, ip string, methods []string) error {
+	cost := l.costs.Cost(methods)
+
+	if client != nil && client.Authenticated {
+		if l.key == nil {
+			return nil
+		}
+		if !l.key.take(client.Name, cost, l.now()) {
+			rejectedKeyCounter.Inc(1)
+			return ErrLimitExceeded
+		}
+		allowedCounter.Inc(1)
+		metrics.GetOrRegisterCounter("rpc/ratelimit/cost/key/"+client.Name, nil).Inc(int64(cost))
+		return nil
+	}
+
+	if l.ip == nil {
+		return nil
+	}
+	if !l.ip.take(ip, cost, l.now()) {
+		rejectedIPCounter.Inc(1)
+		return ErrLimitExceeded
+	}
+	allowedCounter.Inc(1)
+	costIPCounter.Inc(int64(cost))
+	return nil
+}
+
+// Handler returns the HTTP handler rate limiting the JSON-RPC requests before
+// serving them with the next handler. Each call of a batch is charged, and the
+// requests that can't be parsed are rejected. The client is read from the
+// request context if the authentication is enabled.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req, r, err := auth.ReadRequest(r)
+		if err != nil {
+			auth.WriteError(w, http.StatusBadRequest, auth.CodeInvalidRequest, err.Error())
+			return
+		}
+		methods, err := req.Methods()
+		if err != nil {
+			auth.WriteError(w, http.StatusBadRequest, auth.CodeInvalidRequest, err.Error())
+			return
+		}
+
+		client, _ := auth.ClientFromContext(r.Context())
+		if err := l.Allow(client, RemoteIP(r), methods); err != nil {
+			auth.WriteError(w, http.StatusTooManyRequests, CodeLimitExceeded, err.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RemoteIP returns the IP of the client sending the request.
+func RemoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// Costs are the costs of the JSON-RPC namespaces and methods for the rate
+// limits.
+type Costs struct {
+	namespaces map[string]float64
+	methods    map[string]float64
+}
+
+// ParseCosts parses the costs from their entries, which are a namespace (eg.
+// `debug=50`) or a method (eg. `eth_getLogs=20`) with its cost.
+func ParseCosts(entries []string) (Costs, error) {
+	costs := Costs{
+		namespaces: make(map[string]float64),
+		methods:    make(map[string]float64),
+	}
+
+	for _, entry := range entries {
+		name, value, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || name == "" {
+			return Costs{}, fmt.Errorf("invalid rate limit cost '%s', expect: <namespace or method>=<cost>", entry)
+		}
+
+		cost, err := strconv.ParseFloat(value, 64)
+		if err != nil || cost < 0 {
+			return Costs{}, fmt.Errorf("invalid rate limit cost '%s', cost must be a non-negative number", entry)
+		}
+
+		if strings.Contains(name, "_") {
+			costs.methods[name] = cost
+		} else {
+			costs.namespaces[name] = cost
+		}
+	}
+
+	return costs, nil
+}
+
+// Cost returns the total cost of the methods, the methods without a cost of
+// their own or of their namespace cost 1. The requests without methods, as the
+// empty batches, cost 1.
+func (c Costs) Cost(methods []string) float64 {
+	if len(methods) == 0 {
+		return 1
+	}
+
+	var total float64
+	for _, method := range methods {
+		if cost, ok := c.methods[method]; ok {
+			total += cost
+			continue
+		}
+
+		namespace, _, _ := strings.Cut(method, "_")
+		if cost, ok := c.namespaces[namespace]; ok {
+			total += cost
+			continue
+		}
+		total++
+	}
+	return total
+}
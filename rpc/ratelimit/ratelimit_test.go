@@ -0,0 +1,177 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kato114/byte/v15/rpc/auth"
+	"github.com/kato114/byte/v15/server/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCosts(t *testing.T) {
+	costs, err := ParseCosts([]string{"debug=50", "eth_getLogs=20", " eth_chainId=0 ", "ots=2.5"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		methods []string
+		expCost float64
+	}{
+		{"no methods", nil, 1},
+		{"default cost", []string{"eth_blockNumber"}, 1},
+		{"method cost", []string{"eth_getLogs"}, 20},
+		{"free method", []string{"eth_chainId"}, 0},
+		{"namespace cost", []string{"debug_traceBlockByNumber"}, 50},
+		{"fractional cost", []string{"ots_getApiLevel"}, 2.5},
+		{"batch", []string{"eth_getLogs", "eth_blockNumber", "debug_traceTransaction"}, 71},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expCost, costs.Cost(tc.methods))
+		})
+	}
+
+	for _, entry := range []string{"eth_getLogs", "=5", "debug=abc", "debug=-1"} {
+		_, err := ParseCosts([]string{entry})
+		require.Error(t, err, entry)
+	}
+}
+
+func TestBuckets(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	b := newBuckets(2, 10)
+
+	// a new bucket is full
+	require.True(t, b.take("a", 10, now))
+	require.False(t, b.take("a", 1, now))
+	// the buckets are independent
+	require.True(t, b.take("b", 5, now))
+
+	// refilled at the rate
+	now = now.Add(time.Second)
+	require.True(t, b.take("a", 2, now))
+	require.False(t, b.take("a", 1, now))
+
+	// up to the burst
+	now = now.Add(time.Hour)
+	require.False(t, b.take("a", 11, now))
+	require.True(t, b.take("a", 10, now))
+
+	// the full buckets are dropped
+	now = now.Add(time.Hour)
+	require.True(t, b.take("a", 1, now))
+	require.Len(t, b.entries, 1)
+}
+
+func TestLimiter(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	l, err := NewLimiter(*cfg)
+	require.NoError(t, err)
+	require.Nil(t, l)
+
+	cfg.RateLimitIP = 1
+	cfg.RateLimitIPBurst = 20
+	cfg.RateLimitKey = 0
+	cfg.RateLimitCosts = []string{"eth_getLogs=20"}
+	l, err = NewLimiter(*cfg)
+	require.NoError(t, err)
+
+	now := time.Unix(1700000000, 0)
+	l.now = func() time.Time { return now }
+
+	public := &auth.Client{Name: "public"}
+	internal := &auth.Client{Name: "internal", Authenticated: true}
+
+	require.NoError(t, l.Allow(public, "10.0.0.1", []string{"eth_getLogs"}))
+	require.ErrorIs(t, l.Allow(nil, "10.0.0.1", []string{"eth_blockNumber"}), ErrLimitExceeded)
+	require.NoError(t, l.Allow(nil, "10.0.0.2", []string{"eth_blockNumber"}))
+	// the authenticated clients aren't limited without a key rate limit
+	require.NoError(t, l.Allow(internal, "10.0.0.1", []string{"eth_getLogs", "eth_getLogs"}))
+
+	cfg.RateLimitKey = 1
+	cfg.RateLimitKeyBurst = 40
+	l, err = NewLimiter(*cfg)
+	require.NoError(t, err)
+	l.now = func() time.Time { return now }
+
+	require.NoError(t, l.Allow(internal, "10.0.0.1", []string{"eth_getLogs", "eth_getLogs"}))
+	require.ErrorIs(t, l.Allow(internal, "10.0.0.2", []string{"eth_blockNumber"}), ErrLimitExceeded)
+	// the IP bucket is independent
+	require.NoError(t, l.Allow(public, "10.0.0.1", []string{"eth_getLogs"}))
+}
+
+func TestHandler(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimitIP = 1
+	cfg.RateLimitIPBurst = 2
+	l, err := NewLimiter(*cfg)
+	require.NoError(t, err)
+
+	served := 0
+	handler := l.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	}))
+
+	send := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.RemoteAddr = "10.0.0.1:1234"
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, send(`{"method":"eth_blockNumber"}`).Code)
+	// the requests that can't be parsed are rejected without consuming tokens
+	require.Equal(t, http.StatusBadRequest, send(`{"method":`).Code)
+	require.Equal(t, http.StatusOK, send(`{"method":"eth_blockNumber"}`).Code)
+
+	w := send(`{"method":"eth_blockNumber"}`)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, 2, served)
+
+	var res struct {
+		Error struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	require.Equal(t, CodeLimitExceeded, res.Error.Code)
+}
+
+func TestHandlerBatch(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimitIP = 1
+	cfg.RateLimitIPBurst = 2
+	cfg.RateLimitCosts = nil
+	l, err := NewLimiter(*cfg)
+	require.NoError(t, err)
+
+	served := 0
+	handler := l.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+	}))
+
+	send := func(body string) int {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.RemoteAddr = "10.0.0.1:1234"
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// the JSON-RPC server serves the batch despite the bytes after it, so each
+	// of its calls is charged
+	call := `{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber","params":["latest"]}`
+	require.Equal(t, http.StatusTooManyRequests, send("["+strings.Repeat(call+",", 2)+call+"]x"))
+	require.Equal(t, http.StatusBadRequest, send("["+call+",1]"))
+	require.Equal(t, 0, served)
+
+	require.Equal(t, http.StatusOK, send("["+call+","+call+"]x"))
+	require.Equal(t, 1, served)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -22,6 +23,9 @@ type AccountResult struct {
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+	// StoreProofs are the proofs of the account against the AppHash, as the
+	// account state doesn't have an ethereum state root.
+	StoreProofs *AccountStoreProofs `json:"storeProofs,omitempty"`
 }
 
 // StorageResult defines the format for storage proof return
@@ -29,6 +33,59 @@ type StorageResult struct {
 	Key   string       `json:"key"`
 	Value *hexutil.Big `json:"value"`
 	Proof []string     `json:"proof"`
+	// StoreProof is the proof of the slot value in the evm store, or of its
+	// absence if the value is zero.
+	StoreProof *StoreProof `json:"storeProof,omitempty"`
+}
+
+// AccountStoreProofs are the proofs of the store entries making up an account
+// at a height. They're verified against the AppHash of the header of the next
+// block, which commits to the state after the height.
+type AccountStoreProofs struct {
+	Height hexutil.Uint64 `json:"height"`
+	// Account is the proof of the account in the auth store, holding the nonce
+	// and the code hash. Absent if the account doesn't exist.
+	Account StoreProof `json:"account"`
+	// Params is the proof of the evm params in the evm store, holding the denom
+	// of the balance.
+	Params StoreProof `json:"params"`
+	// Balance is the proof of the balance of the evm denom in the bank store.
+	// Absent if the balance is zero.
+	Balance StoreProof `json:"balance"`
+}
+
+// StoreProof is the proof of an entry of a store of the multistore, or of its
+// absence if the value is empty. The proof ops are the ICS-23 commitment proofs
+// of the key in the store and of the store in the multistore.
+type StoreProof struct {
+	StoreKey string         `json:"storeKey"`
+	Key      hexutil.Bytes  `json:"key"`
+	Value    hexutil.Bytes  `json:"value"`
+	ProofOps []StoreProofOp `json:"proofOps"`
+}
+
+// StoreProofOp is a proof op of a store proof, as returned by the ABCI queries.
+type StoreProofOp struct {
+	Type string        `json:"type"`
+	Key  hexutil.Bytes `json:"key"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// StorageRangeResult is the result of a debug_storageRangeAt API call. The
+// entries are keyed by the keccak256 hash of the storage key, as on geth.
+type StorageRangeResult struct {
+	Storage StorageMap `json:"storage"`
+	// NextKey is nil if Storage includes the last key of the contract.
+	NextKey *common.Hash `json:"nextKey"`
+}
+
+// StorageMap maps the hashed storage keys to the storage entries.
+type StorageMap map[common.Hash]StorageEntry
+
+// StorageEntry is a storage entry of a debug_storageRangeAt API call.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
 }
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
@@ -55,20 +112,29 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// AddressHistoryArgs defines the paging arguments of
+// `eth_getTransactionsByAddress`. The page starts after FromBlock in ascending
+// order, or before it in descending order if Reverse is set, in which case a
+// FromBlock of 0 starts from the latest block. Roles filters the roles of the
+// address in the txs: sender, recipient, contract or log.
+type AddressHistoryArgs struct {
+	FromBlock hexutil.Uint64  `json:"fromBlock"`
+	Reverse   bool            `json:"reverse"`
+	Limit     *hexutil.Uint64 `json:"limit"`
+	Roles     []string        `json:"roles"`
+}
+
+// AddressHistory is a page of the txs of an address. NextBlock is the
+// FromBlock of the next page, nil if this is the last page.
+type AddressHistory struct {
+	Transactions []*RPCTransaction `json:"transactions"`
+	NextBlock    *hexutil.Uint64   `json:"nextBlock"`
 }
 
 type FeeHistoryResult struct {
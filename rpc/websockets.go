@@ -7,12 +7,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"math/big"
-	"net"
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -20,22 +19,32 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/cometbft/cometbft/libs/log"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 
+	"github.com/kato114/byte/v15/rpc/auth"
 	"github.com/kato114/byte/v15/rpc/ethereum/pubsub"
 	rpcfilters "github.com/kato114/byte/v15/rpc/namespaces/ethereum/eth/filters"
+	"github.com/kato114/byte/v15/rpc/ratelimit"
 	"github.com/kato114/byte/v15/rpc/types"
 	"github.com/kato114/byte/v15/server/config"
+	evmostypes "github.com/kato114/byte/v15/types"
 	evmtypes "github.com/kato114/byte/v15/x/evm/types"
 )
 
+// syncingPollInterval is the interval at which the CometBFT node status is
+// polled for the `syncing` subscription.
+const syncingPollInterval = 2 * time.Second
+
 type WebsocketsServer interface {
 	Start()
 }
@@ -69,25 +78,46 @@ type ErrorMessageJSON struct {
 }
 
 type websocketsServer struct {
-	rpcAddr  string // listen address of rest-server
-	wsAddr   string // listen address of ws server
-	certFile string
-	keyFile  string
-	api      *pubSubAPI
-	logger   log.Logger
+	rpcServer             http.Handler // JSON-RPC server serving the non-subscription requests
+	wsAddr                string       // listen address of ws server
+	certFile              string
+	keyFile               string
+	maxConcurrentRequests int                 // max requests served at once per connection
+	maxMessageSize        int64               // max size of a message read from a connection
+	authenticator         *auth.Authenticator // nil if the authentication is disabled
+	limiter               *ratelimit.Limiter  // nil if the requests aren't rate limited
+	api                   *pubSubAPI
+	logger                log.Logger
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+// NewWebsocketsServer creates the websocket server. The subscriptions are
+// served by the server itself, and the other requests are dispatched in-process
+// to the given JSON-RPC server handler. The connections are authenticated at the
+// upgrade, and the methods of each message checked against the allowlist and
+// the rate limit of the client, unless the authenticator and the limiter are
+// nil.
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	rpcServer http.Handler,
+	authenticator *auth.Authenticator,
+	limiter *ratelimit.Limiter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
-	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
 	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+		rpcServer:             rpcServer,
+		wsAddr:                cfg.JSONRPC.WsAddress,
+		certFile:              cfg.TLS.CertificatePath,
+		keyFile:               cfg.TLS.KeyPath,
+		maxConcurrentRequests: cfg.JSONRPC.WSMaxConcurrentRequests,
+		maxMessageSize:        cfg.JSONRPC.WSMaxMessageSize,
+		authenticator:         authenticator,
+		limiter:               limiter,
+		api:                   newPubSubAPI(clientCtx, logger, tmWSClient),
+		logger:                logger,
 	}
 }
 
@@ -116,6 +146,16 @@ func (s *websocketsServer) Start() {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var client *auth.Client
+	if s.authenticator != nil {
+		var err error
+		client, err = s.authenticator.Authenticate(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: func(r *http.Request) bool {
 			return true
@@ -128,17 +168,32 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if s.maxMessageSize > 0 {
+		conn.SetReadLimit(s.maxMessageSize)
+	}
+
+	// the requests being served are canceled once the connection is closed
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		ctx:    ctx,
+		mux:    new(sync.Mutex),
+		conn:   conn,
+		client: client,
+		ip:     ratelimit.RemoteIP(r),
 	})
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
+	s.sendErrResponseWithCode(wsConn, -32600, msg)
+}
+
+func (s *websocketsServer) sendErrResponseWithCode(wsConn *wsConn, code int64, msg string) {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
 		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
+			Code:    big.NewInt(code),
 			Message: msg,
 		},
 		ID: nil,
@@ -148,8 +203,11 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 }
 
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	ctx    context.Context // canceled when the connection is closed
+	conn   *websocket.Conn
+	mux    *sync.Mutex
+	client *auth.Client // authenticated client, nil if the authentication is disabled
+	ip     string
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -159,6 +217,13 @@ func (w *wsConn) WriteJSON(v interface{}) error {
 	return w.conn.WriteJSON(v)
 }
 
+func (w *wsConn) WriteMessage(data []byte) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	return w.conn.WriteMessage(websocket.TextMessage, data)
+}
+
 func (w *wsConn) Close() error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
@@ -175,6 +240,8 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 func (s *websocketsServer) readLoop(wsConn *wsConn) {
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
+	// requests being served for the current connection
+	requests := make(chan struct{}, s.maxConcurrentRequests)
 	defer func() {
 		// cancel all subscriptions when connection closed
 		// #nosec G705
@@ -191,10 +258,14 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		if !s.checkRequest(wsConn, mb) {
+			continue
+		}
+
+		// the batches are dispatched as a whole, the JSON-RPC server handler
+		// enforcing the batch limits
 		if isBatch(mb) {
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
+			s.dispatch(wsConn, mb, requests)
 			continue
 		}
 
@@ -208,10 +279,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		method, ok := msg["method"].(string)
 		if !ok {
 			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
-
+			s.dispatch(wsConn, mb, requests)
 			continue
 		}
 
@@ -286,11 +354,41 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			}
 		default:
 			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
+			s.dispatch(wsConn, mb, requests)
+		}
+	}
+}
+
+// checkRequest checks the methods of the message against the allowlist and the
+// rate limit of the client, and sends the error response if they're rejected.
+// The messages that can't be parsed are rejected, as their methods can't be
+// checked nor charged.
+func (s *websocketsServer) checkRequest(wsConn *wsConn, mb []byte) bool {
+	if wsConn.client == nil && s.limiter == nil {
+		return true
+	}
+
+	methods, err := auth.RequestMethods(mb)
+	if err != nil {
+		s.sendErrResponseWithCode(wsConn, auth.CodeInvalidRequest, err.Error())
+		return false
+	}
+
+	if wsConn.client != nil {
+		if method, ok := wsConn.client.Allowlist.AllowedAll(methods); !ok {
+			s.sendErrResponseWithCode(wsConn, auth.CodeMethodNotFound, auth.MethodNotAllowedError(method).Error())
+			return false
+		}
+	}
+
+	if s.limiter != nil {
+		if err := s.limiter.Allow(wsConn.client, wsConn.ip, methods); err != nil {
+			s.sendErrResponseWithCode(wsConn, ratelimit.CodeLimitExceeded, err.Error())
+			return false
 		}
 	}
+
+	return true
 }
 
 // tcpGetAndSendResponse sends error response to client if params is invalid
@@ -309,40 +407,76 @@ func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, ws
 	return params, true
 }
 
-// tcpGetAndSendResponse connects to the rest-server over tcp, posts a JSON-RPC request, and sends the response
-// to the client over websockets
-func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) error {
-	req, err := http.NewRequestWithContext(context.Background(), "POST", "http://"+s.rpcAddr, bytes.NewBuffer(mb))
-	if err != nil {
-		return errors.Wrap(err, "Could not build request")
-	}
+// dispatch serves the JSON-RPC request or batch in the background, and sends
+// the response to the client over websockets. It blocks while the connection
+// already has the max number of requests being served.
+func (s *websocketsServer) dispatch(wsConn *wsConn, mb []byte, requests chan struct{}) {
+	requests <- struct{}{}
+	go func() {
+		defer func() { <-requests }()
 
-	req.Header.Set("Content-Type", "application/json")
-	client := &http.Client{}
-	resp, err := client.Do(req)
+		if err := s.serveRequest(wsConn, mb); err != nil {
+			s.sendErrResponse(wsConn, err.Error())
+		}
+	}()
+}
+
+// serveRequest serves the JSON-RPC request or batch with the in-process
+// JSON-RPC server, and sends the response to the client over websockets. The
+// request is canceled if the connection is closed meanwhile.
+func (s *websocketsServer) serveRequest(wsConn *wsConn, mb []byte) error {
+	req, err := http.NewRequestWithContext(wsConn.ctx, http.MethodPost, "/", bytes.NewReader(mb))
 	if err != nil {
-		return errors.Wrap(err, "Could not perform request")
+		return errors.Wrap(err, "could not build request")
 	}
+	req.Header.Set("Content-Type", "application/json")
 
-	defer resp.Body.Close()
+	res := newRPCResponseWriter()
+	s.rpcServer.ServeHTTP(res, req)
+	if res.status != http.StatusOK {
+		return fmt.Errorf("request failed with status %d: %s", res.status, bytes.TrimSpace(res.body.Bytes()))
+	}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return errors.Wrap(err, "could not read body from response")
+	// notifications have no response
+	body := bytes.TrimSpace(res.body.Bytes())
+	if len(body) == 0 {
+		return nil
 	}
 
-	var wsSend interface{}
-	err = json.Unmarshal(body, &wsSend)
-	if err != nil {
-		return errors.Wrap(err, "failed to unmarshal rest-server response")
+	return wsConn.WriteMessage(body)
+}
+
+// rpcResponseWriter is an in-memory http.ResponseWriter that buffers the
+// response of the JSON-RPC server.
+type rpcResponseWriter struct {
+	header http.Header
+	body   bytes.Buffer
+	status int
+}
+
+func newRPCResponseWriter() *rpcResponseWriter {
+	return &rpcResponseWriter{
+		header: make(http.Header),
+		status: http.StatusOK,
 	}
+}
 
-	return wsConn.WriteJSON(wsSend)
+func (w *rpcResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *rpcResponseWriter) Write(bz []byte) (int, error) {
+	return w.body.Write(bz)
+}
+
+func (w *rpcResponseWriter) WriteHeader(status int) {
+	w.status = status
 }
 
 // pubSubAPI is the eth_ prefixed set of APIs in the Web3 JSON-RPC spec
 type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
+	syncing   *syncingFeed
 	logger    log.Logger
 	clientCtx client.Context
 }
@@ -350,11 +484,13 @@ type pubSubAPI struct {
 // newPubSubAPI creates an instance of the ethereum PubSub API.
 func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
-	return &pubSubAPI{
+	api := &pubSubAPI{
 		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
 		logger:    logger,
 		clientCtx: clientCtx,
 	}
+	api.syncing = newSyncingFeed(api, syncingPollInterval)
+	return api
 }
 
 func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
@@ -373,7 +509,14 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		var fullTx bool
+		if len(params) > 1 {
+			fullTx, ok = params[1].(bool)
+			if !ok {
+				return nil, errors.New("invalid parameters: fullTx must be a boolean")
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -621,7 +764,18 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+// subscribePendingTransactions streams the hashes of the eth txs added to the
+// mempool, or the full txs if fullTx is set.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (pubsub.UnsubscribeFunc, error) {
+	var chainID *big.Int
+	if fullTx {
+		var err error
+		chainID, err = evmostypes.ParseChainID(api.clientCtx.ChainID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse chain id")
+		}
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -646,13 +800,19 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					result, err := pendingTxResult(ethTx, fullTx, chainID)
+					if err != nil {
+						api.logger.Debug("failed to build rpc transaction", "hash", ethTx.Hash, "error", err.Error())
+						continue
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -679,8 +839,249 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// pendingTxResult returns the notification of a pending tx: its hash, or the
+// full tx if fullTx is set.
+func pendingTxResult(ethTx *evmtypes.MsgEthereumTx, fullTx bool, chainID *big.Int) (interface{}, error) {
+	if !fullTx {
+		return ethTx.Hash, nil
+	}
+
+	// use zero block values since it's not included in a block yet
+	return types.NewTransactionFromMsg(ethTx, common.Hash{}, uint64(0), uint64(0), nil, chainID)
+}
+
+// subscribeSyncing notifies the changes of the catch-up status of the
+// CometBFT node, following geth: the sync progress when the node starts
+// catching up, and false when it's done. The status is polled once for all the
+// syncing subscriptions, see syncingFeed.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a CometBFT client")
+	}
+
+	results := api.syncing.subscribe(subID)
+	done := make(chan struct{})
+	var once sync.Once
+	unsubFn := func() {
+		once.Do(func() {
+			api.syncing.unsubscribe(subID)
+			close(done)
+		})
+	}
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case result := <-results:
+				// write to ws conn
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close() // #nosec G703
+						}
+					}, api.logger, "closing websocket peer sub")
+					return
+				}
+			}
+		}
+	}()
+
+	return unsubFn, nil
+}
+
+// syncingFeed polls the CometBFT node status while there are syncing
+// subscriptions, and sends the changes of its catch-up status to all of them.
+// The polling, and the requests to the node in flight, are canceled with the
+// last subscription.
+type syncingFeed struct {
+	api      *pubSubAPI
+	interval time.Duration
+
+	mu   sync.Mutex
+	subs map[rpc.ID]chan interface{}
+	// result is the last status sent, nil until the first poll
+	result interface{}
+	// cancel stops the polling, nil if it's not running
+	cancel context.CancelFunc
+}
+
+func newSyncingFeed(api *pubSubAPI, interval time.Duration) *syncingFeed {
+	return &syncingFeed{
+		api:      api,
+		interval: interval,
+		subs:     make(map[rpc.ID]chan interface{}),
+	}
+}
+
+// subscribe returns the channel of the statuses sent to the subscription,
+// starting with the last one if any. The polling starts with the first
+// subscription.
+func (f *syncingFeed) subscribe(subID rpc.ID) <-chan interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch := make(chan interface{}, 1)
+	if f.result != nil {
+		ch <- f.result
+	}
+	f.subs[subID] = ch
+
+	if f.cancel == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		f.cancel = cancel
+		go f.poll(ctx)
+	}
+
+	return ch
+}
+
+// unsubscribe removes the subscription, and stops the polling if it was the
+// last one.
+func (f *syncingFeed) unsubscribe(subID rpc.ID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.subs, subID)
+	if len(f.subs) == 0 && f.cancel != nil {
+		f.cancel()
+		f.cancel = nil
+		f.result = nil
+	}
+}
+
+// poll polls the node status until the context is canceled, and sends the
+// first status and its changes to the subscriptions.
+func (f *syncingFeed) poll(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	var catchingUp *bool
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		status, err := f.api.clientCtx.Client.Status(ctx)
+		if err != nil {
+			f.api.logger.Debug("failed to fetch node status", "error", err.Error())
+			continue
+		}
+
+		if catchingUp != nil && *catchingUp == status.SyncInfo.CatchingUp {
+			continue
+		}
+		catchingUp = &status.SyncInfo.CatchingUp
+
+		var highestBlock int64
+		if status.SyncInfo.CatchingUp {
+			highestBlock = f.api.highestBlock(ctx, status.SyncInfo.LatestBlockHeight)
+		}
+		f.send(ctx, syncingResult(status.SyncInfo, highestBlock))
+	}
+}
+
+// send sends the status to the subscriptions, unless the polling is canceled.
+func (f *syncingFeed) send(ctx context.Context, result interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	f.result = result
+	for _, ch := range f.subs {
+		// a subscription that is behind only gets the last status
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- result
+	}
+}
+
+// highestBlock returns the highest block known to the node: the latest block
+// of the node, or the last block committed by the peers if higher. The peers
+// heights are the consensus ones of their round states, which is the height
+// after their last committed block.
+func (api *pubSubAPI) highestBlock(ctx context.Context, latest int64) int64 {
+	nc, ok := api.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return latest
+	}
+
+	res, err := nc.DumpConsensusState(ctx)
+	if err != nil {
+		api.logger.Debug("failed to fetch consensus state", "error", err.Error())
+		return latest
+	}
+
+	highest := latest
+	for _, peer := range res.Peers {
+		// peers without consensus state yet are skipped
+		if len(peer.PeerState) == 0 {
+			continue
+		}
+
+		height, err := peerHeight(peer.PeerState)
+		if err != nil {
+			api.logger.Debug("failed to parse peer state", "peer", peer.NodeAddress, "error", err.Error())
+			continue
+		}
+
+		if height-1 > highest {
+			highest = height - 1
+		}
+	}
+
+	return highest
+}
+
+// peerHeight returns the consensus height of the peer state of a CometBFT
+// consensus state dump.
+func peerHeight(peerState json.RawMessage) (int64, error) {
+	var state struct {
+		RoundState struct {
+			Height int64 `json:"height,string"`
+		} `json:"round_state"`
+	}
+	if err := json.Unmarshal(peerState, &state); err != nil {
+		return 0, err
+	}
+	return state.RoundState.Height, nil
+}
+
+// syncingResult returns the syncing notification of the node sync info,
+// following geth: the sync progress while the node catches up, and false
+// when it's done.
+func syncingResult(syncInfo coretypes.SyncInfo, highestBlock int64) interface{} {
+	if !syncInfo.CatchingUp {
+		return false
+	}
+
+	return map[string]interface{}{
+		"syncing": true,
+		"status": map[string]interface{}{
+			"startingBlock": hexutil.Uint64(syncInfo.EarliestBlockHeight),
+			"currentBlock":  hexutil.Uint64(syncInfo.LatestBlockHeight),
+			"highestBlock":  hexutil.Uint64(highestBlock),
+		},
+	}
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -0,0 +1,357 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kato114/byte/v15/rpc/auth"
+	"github.com/kato114/byte/v15/rpc/backend/mocks"
+	"github.com/kato114/byte/v15/rpc/ratelimit"
+	rpctypes "github.com/kato114/byte/v15/rpc/types"
+	"github.com/kato114/byte/v15/server/config"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// testService is a JSON-RPC service recording the methods called.
+type testService struct {
+	namespace string
+	mu        *sync.Mutex
+	calls     *[]string
+}
+
+func (s *testService) record(method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.calls = append(*s.calls, s.namespace+"_"+method)
+}
+
+func (s *testService) BlockNumber() string {
+	s.record("blockNumber")
+	return "0x1"
+}
+
+func (s *testService) TraceBlockByNumber(_ *string) string {
+	s.record("traceBlockByNumber")
+	return strings.Repeat("a", 100)
+}
+
+func (s *testService) Sign(_ *string) string {
+	s.record("sign")
+	return "0x"
+}
+
+// newTestRPCServer returns a JSON-RPC server serving the test service in the
+// eth, debug and personal namespaces, and the calls it records.
+func newTestRPCServer(t *testing.T) (*rpc.Server, func() []string) {
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+
+	srv := rpc.NewServer()
+	for _, namespace := range []string{"eth", "debug", "personal"} {
+		require.NoError(t, srv.RegisterName(namespace, &testService{namespace: namespace, mu: &mu, calls: &calls}))
+	}
+	t.Cleanup(srv.Stop)
+
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), calls...)
+	}
+}
+
+// newTestAuthenticator returns an authenticator allowing the eth namespace to
+// the public client.
+func newTestAuthenticator(t *testing.T) *auth.Authenticator {
+	keysFile := filepath.Join(t.TempDir(), "keys.json")
+	require.NoError(t, os.WriteFile(keysFile, []byte(`[{"name":"internal","key":"internal-key","allow":["*"]}]`), 0o600))
+
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.AuthKeysFile = keysFile
+	cfg.AuthPublicAllow = []string{"eth"}
+
+	a, err := auth.NewAuthenticator(*cfg)
+	require.NoError(t, err)
+	return a
+}
+
+// dialTestWebsocketsServer starts the websocket server and returns a
+// connection to it.
+func dialTestWebsocketsServer(t *testing.T, s *websocketsServer) *websocket.Conn {
+	s.maxConcurrentRequests = 1
+	s.logger = log.NewNopLogger()
+
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// wsCall sends the message over the connection and returns the response.
+func wsCall(t *testing.T, conn *websocket.Conn, msg string) []byte {
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+	_, res, err := conn.ReadMessage()
+	require.NoError(t, err)
+	return res
+}
+
+// errorCode returns the code of the JSON-RPC error response, 0 if it isn't an
+// error.
+func errorCode(t *testing.T, res []byte) int {
+	var msg struct {
+		Error *struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(res, &msg))
+	if msg.Error == nil {
+		return 0
+	}
+	return msg.Error.Code
+}
+
+func TestWebsocketsAllowlist(t *testing.T) {
+	trailingBytes := `[{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber","params":["latest"]}]x`
+	invalidCall := `[{"jsonrpc":"2.0","id":1,"method":"personal_sign","params":[]},1]`
+
+	t.Run("without authentication", func(t *testing.T) {
+		// the JSON-RPC server ignores the bytes after the batch and the
+		// invalid calls of a batch
+		rpcServer, calls := newTestRPCServer(t)
+		conn := dialTestWebsocketsServer(t, &websocketsServer{rpcServer: rpcServer})
+
+		wsCall(t, conn, trailingBytes)
+		wsCall(t, conn, invalidCall)
+		require.Equal(t, []string{"debug_traceBlockByNumber", "personal_sign"}, calls())
+	})
+
+	t.Run("public client", func(t *testing.T) {
+		rpcServer, calls := newTestRPCServer(t)
+		conn := dialTestWebsocketsServer(t, &websocketsServer{rpcServer: rpcServer, authenticator: newTestAuthenticator(t)})
+
+		require.Zero(t, errorCode(t, wsCall(t, conn, `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)))
+		require.Equal(t, auth.CodeMethodNotFound, errorCode(t, wsCall(t, conn, trailingBytes)))
+		require.Equal(t, auth.CodeInvalidRequest, errorCode(t, wsCall(t, conn, invalidCall)))
+		require.Equal(t, auth.CodeInvalidRequest, errorCode(t, wsCall(t, conn, `{"method":`)))
+		require.Equal(t, []string{"eth_blockNumber"}, calls())
+	})
+}
+
+func TestWebsocketsRateLimit(t *testing.T) {
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.RateLimitIP = 1
+	cfg.RateLimitIPBurst = 2
+	cfg.RateLimitCosts = nil
+	limiter, err := ratelimit.NewLimiter(*cfg)
+	require.NoError(t, err)
+
+	rpcServer, calls := newTestRPCServer(t)
+	conn := dialTestWebsocketsServer(t, &websocketsServer{rpcServer: rpcServer, limiter: limiter})
+
+	call := `{"jsonrpc":"2.0","id":1,"method":"debug_traceBlockByNumber","params":["latest"]}`
+	require.Equal(t, ratelimit.CodeLimitExceeded, errorCode(t, wsCall(t, conn, "["+call+","+call+","+call+"]x")))
+	require.Equal(t, auth.CodeInvalidRequest, errorCode(t, wsCall(t, conn, `{"method":`)))
+	require.Empty(t, calls())
+}
+
+// blockingService is a JSON-RPC service whose calls block until they're
+// canceled.
+type blockingService struct {
+	started  chan struct{}
+	canceled chan struct{}
+}
+
+func (s *blockingService) Wait(ctx context.Context) error {
+	close(s.started)
+	<-ctx.Done()
+	close(s.canceled)
+	return ctx.Err()
+}
+
+func TestWebsocketsRequestCanceled(t *testing.T) {
+	service := &blockingService{started: make(chan struct{}), canceled: make(chan struct{})}
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("debug", service))
+	t.Cleanup(rpcServer.Stop)
+
+	conn := dialTestWebsocketsServer(t, &websocketsServer{rpcServer: rpcServer})
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"debug_wait","params":[]}`)))
+	<-service.started
+
+	// the request is canceled once the client disconnects
+	require.NoError(t, conn.Close())
+	select {
+	case <-service.canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request not canceled after the connection is closed")
+	}
+}
+
+func TestPendingTxResult(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	chainID := big.NewInt(9000)
+	to := common.HexToAddress("0x1")
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(10),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(100),
+	})
+	require.NoError(t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+
+	result, err := pendingTxResult(msg, false, chainID)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash().Hex(), result)
+
+	result, err = pendingTxResult(msg, true, chainID)
+	require.NoError(t, err)
+	rpcTx, ok := result.(*rpctypes.RPCTransaction)
+	require.True(t, ok)
+	require.Equal(t, tx.Hash(), rpcTx.Hash)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), rpcTx.From)
+	require.Equal(t, &to, rpcTx.To)
+	require.Equal(t, hexutil.Uint64(1), rpcTx.Nonce)
+	require.Nil(t, rpcTx.BlockHash)
+	require.Nil(t, rpcTx.BlockNumber)
+}
+
+func TestSyncingResult(t *testing.T) {
+	require.Equal(t, false, syncingResult(coretypes.SyncInfo{LatestBlockHeight: 100}, 0))
+
+	require.Equal(t, map[string]interface{}{
+		"syncing": true,
+		"status": map[string]interface{}{
+			"startingBlock": hexutil.Uint64(1),
+			"currentBlock":  hexutil.Uint64(50),
+			"highestBlock":  hexutil.Uint64(100),
+		},
+	}, syncingResult(coretypes.SyncInfo{CatchingUp: true, EarliestBlockHeight: 1, LatestBlockHeight: 50}, 100))
+}
+
+func TestHighestBlock(t *testing.T) {
+	peerState := func(height string) json.RawMessage {
+		return json.RawMessage(`{"round_state":{"height":"` + height + `","round":0,"step":1},"stats":{"votes":"0","block_parts":"0"}}`)
+	}
+
+	testCases := []struct {
+		name       string
+		res        *coretypes.ResultDumpConsensusState
+		err        error
+		expHighest int64
+	}{
+		{
+			"peers ahead",
+			&coretypes.ResultDumpConsensusState{Peers: []coretypes.PeerStateInfo{
+				{NodeAddress: "a", PeerState: peerState("80")},
+				{NodeAddress: "b", PeerState: peerState("101")},
+				{},
+			}},
+			nil,
+			100,
+		},
+		{
+			"peers behind",
+			&coretypes.ResultDumpConsensusState{Peers: []coretypes.PeerStateInfo{
+				{NodeAddress: "a", PeerState: peerState("40")},
+			}},
+			nil,
+			50,
+		},
+		{
+			"invalid peer state",
+			&coretypes.ResultDumpConsensusState{Peers: []coretypes.PeerStateInfo{
+				{NodeAddress: "a", PeerState: json.RawMessage(`{"round_state":{"height":101}}`)},
+				{NodeAddress: "b", PeerState: peerState("71")},
+			}},
+			nil,
+			70,
+		},
+		{
+			"consensus state not available",
+			nil,
+			errors.New("unavailable"),
+			50,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tmClient := mocks.NewClient(t)
+			tmClient.On("DumpConsensusState", mock.Anything).Return(tc.res, tc.err)
+
+			api := &pubSubAPI{
+				logger:    log.NewNopLogger(),
+				clientCtx: client.Context{}.WithClient(tmClient),
+			}
+			require.Equal(t, tc.expHighest, api.highestBlock(context.Background(), 50))
+		})
+	}
+}
+
+func TestSyncingFeed(t *testing.T) {
+	var calls sync.WaitGroup
+	calls.Add(2)
+
+	tmClient := mocks.NewClient(t)
+	tmClient.On("Status", mock.Anything).Return(&coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{LatestBlockHeight: 100}}, nil).Run(func(mock.Arguments) {
+		calls.Done()
+	}).Twice()
+	tmClient.On("Status", mock.Anything).Return(nil, errors.New("canceled")).Maybe()
+
+	api := &pubSubAPI{
+		logger:    log.NewNopLogger(),
+		clientCtx: client.Context{}.WithClient(tmClient),
+	}
+	feed := newSyncingFeed(api, 10*time.Millisecond)
+
+	// the subscriptions share the polling, and the last status is sent to the
+	// new ones
+	first := feed.subscribe(rpc.ID("a"))
+	require.Equal(t, false, <-first)
+	second := feed.subscribe(rpc.ID("b"))
+	require.Equal(t, false, <-second)
+
+	// the unchanged status is not sent again
+	calls.Wait()
+	require.Empty(t, first)
+	require.Empty(t, second)
+
+	// the polling is stopped with the last subscription
+	feed.unsubscribe(rpc.ID("a"))
+	feed.unsubscribe(rpc.ID("b"))
+	require.Nil(t, feed.cancel)
+	require.Nil(t, feed.result)
+}
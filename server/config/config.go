@@ -5,6 +5,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"path"
 	"time"
 
@@ -17,6 +18,8 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/crypto-org-chain/cronos/memiavl"
 	memiavlcfg "github.com/crypto-org-chain/cronos/store/config"
+
+	"github.com/kato114/byte/v15/types"
 )
 
 const (
@@ -92,6 +95,45 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultWSMaxConcurrentRequests is the default number of requests served at once per websocket connection
+	DefaultWSMaxConcurrentRequests = 16
+
+	// DefaultWSMaxMessageSize is the default max size in bytes of a message read from a websocket connection
+	DefaultWSMaxMessageSize = 15 * 1024 * 1024
+
+	// DefaultGPOBlocks is the default number of blocks sampled by the gas price oracle
+	DefaultGPOBlocks = 20
+
+	// DefaultGPOPercentile is the default percentile of the sampled tips suggested by the gas price oracle
+	DefaultGPOPercentile = 60
+
+	// DefaultGPOIgnorePrice is the default tip in wei below which the gas price oracle ignores the txs
+	DefaultGPOIgnorePrice = 2
+
+	// DefaultGPOMaxPrice is the default max tip in wei suggested by the gas price oracle
+	DefaultGPOMaxPrice = 500 * 1000 * 1000 * 1000
+
+	// DefaultBatchRequestLimit is the default max number of calls of a JSON-RPC batch
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultBatchResponseMaxSize is the default max size in bytes of the responses of a JSON-RPC batch
+	DefaultBatchResponseMaxSize = 25 * 1000 * 1000
+
+	// DefaultRateLimitIPBurst is the default max cost of the requests served at once for each IP
+	DefaultRateLimitIPBurst = 100
+
+	// DefaultRateLimitKeyBurst is the default max cost of the requests served at once for each authenticated client
+	DefaultRateLimitKeyBurst = 1000
+
+	// DefaultBundlerInterval is the default interval between the bundles submitted by the bundler
+	DefaultBundlerInterval = 5 * time.Second
+
+	// DefaultBundlerMaxBundleSize is the default max number of user operations of a bundle
+	DefaultBundlerMaxBundleSize = 10
+
+	// DefaultBundlerMempoolSize is the default max number of user operations in the bundler mempool
+	DefaultBundlerMempoolSize = 4096
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
@@ -174,8 +216,82 @@ type JSONRPCConfig struct {
 	// MaxOpenConnections sets the maximum number of simultaneous connections
 	// for the server listener.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
+	// WSMaxConcurrentRequests sets the maximum number of requests served at
+	// once for each websocket connection.
+	WSMaxConcurrentRequests int `mapstructure:"ws-max-concurrent-requests"`
+	// WSMaxMessageSize sets the maximum size in bytes of a message read from a
+	// websocket connection (unlimited = 0).
+	WSMaxMessageSize int64 `mapstructure:"ws-max-message-size"`
+	// AuthJWTSecret defines the file of the hex encoded secret verifying the
+	// HS256 JWTs of the clients.
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
+	// AuthJWTAllow defines the namespaces and methods allowed to the clients
+	// authenticated with a JWT.
+	AuthJWTAllow []string `mapstructure:"auth-jwt-allow"`
+	// AuthKeysFile defines the JSON file of the API keys of the clients, with
+	// the namespaces and methods allowed to each of them.
+	AuthKeysFile string `mapstructure:"auth-keys-file"`
+	// AuthPublicAllow defines the namespaces and methods allowed to the clients
+	// without credentials when the authentication is enabled.
+	AuthPublicAllow []string `mapstructure:"auth-public-allow"`
+	// GPOBlocks defines the number of blocks sampled by the gas price oracle.
+	GPOBlocks int `mapstructure:"gpo-blocks"`
+	// GPOPercentile defines the percentile of the sampled tips suggested by the
+	// gas price oracle.
+	GPOPercentile int `mapstructure:"gpo-percentile"`
+	// GPOIgnorePrice defines the tip in wei below which the gas price oracle
+	// ignores the txs.
+	GPOIgnorePrice int64 `mapstructure:"gpo-ignore-price"`
+	// GPOMaxPrice defines the max tip in wei suggested by the gas price oracle
+	// (unlimited = 0).
+	GPOMaxPrice int64 `mapstructure:"gpo-max-price"`
+	// BatchRequestLimit sets the maximum number of calls of a batch
+	// (unlimited = 0).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// BatchResponseMaxSize sets the maximum size in bytes of the responses of a
+	// batch (unlimited = 0).
+	BatchResponseMaxSize int `mapstructure:"batch-response-max-size"`
+	// RateLimitIP defines the cost of the requests refilled per second for each
+	// IP sending requests without credentials (disabled = 0).
+	RateLimitIP float64 `mapstructure:"rate-limit-ip"`
+	// RateLimitIPBurst defines the max cost of the requests served at once for
+	// each IP.
+	RateLimitIPBurst int `mapstructure:"rate-limit-ip-burst"`
+	// RateLimitKey defines the cost of the requests refilled per second for
+	// each authenticated client (disabled = 0).
+	RateLimitKey float64 `mapstructure:"rate-limit-key"`
+	// RateLimitKeyBurst defines the max cost of the requests served at once for
+	// each authenticated client.
+	RateLimitKeyBurst int `mapstructure:"rate-limit-key-burst"`
+	// RateLimitCosts defines the costs of the namespaces and methods for the
+	// rate limits, eg. `eth_getLogs=20`. The others cost 1.
+	RateLimitCosts []string `mapstructure:"rate-limit-costs"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// AddressIndex defines the roles of the addresses by which the custom
+	// indexer indexes the txs: sender, recipient, contract and log.
+	AddressIndex []string `mapstructure:"address-index"`
+	// EnableLogIndex defines if the custom indexer indexes the logs by address
+	// and topics, to serve `eth_getLogs` without scanning the blocks.
+	EnableLogIndex bool `mapstructure:"enable-log-index"`
+	// BundlerEntryPoints defines the addresses of the ERC-4337 EntryPoints
+	// served by the bundler namespace. The bundler prefixed methods managing
+	// the mempool are only served to the local or authenticated clients, as
+	// the admin namespace.
+	BundlerEntryPoints []string `mapstructure:"bundler-entry-points"`
+	// BundlerKey defines the name of the keyring key signing the bundles.
+	BundlerKey string `mapstructure:"bundler-key"`
+	// BundlerBeneficiary defines the address receiving the fees of the
+	// bundles, the address of the bundler key if empty.
+	BundlerBeneficiary string `mapstructure:"bundler-beneficiary"`
+	// BundlerInterval defines the interval between the bundles.
+	BundlerInterval time.Duration `mapstructure:"bundler-interval"`
+	// BundlerMaxBundleSize defines the max number of user operations of a
+	// bundle.
+	BundlerMaxBundleSize int `mapstructure:"bundler-max-bundle-size"`
+	// BundlerMempoolSize defines the max number of user operations in the
+	// bundler mempool.
+	BundlerMempoolSize int `mapstructure:"bundler-mempool-size"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -263,9 +379,21 @@ func GetDefaultAPINamespaces() []string {
 	return []string{"eth", "net", "web3"}
 }
 
+// GetDefaultRateLimitCosts returns the default costs of the JSON-RPC namespaces
+// and methods for the rate limits, the others cost 1.
+func GetDefaultRateLimitCosts() []string {
+	return []string{"eth_getLogs=20", "eth_call=5", "eth_estimateGas=5", "debug=50", "trace=50"}
+}
+
+// GetDefaultBundlerEntryPoints returns the canonical addresses of the
+// ERC-4337 EntryPoint v0.6 and v0.7.
+func GetDefaultBundlerEntryPoints() []string {
+	return []string{"0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789", "0x0000000071727De22E5E9d8BAf0edAc6f37da032"}
+}
+
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots", "admin", "bundler"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -286,7 +414,32 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
+		WSMaxConcurrentRequests:  DefaultWSMaxConcurrentRequests,
+		WSMaxMessageSize:         DefaultWSMaxMessageSize,
+		AuthJWTSecret:            "",
+		AuthJWTAllow:             []string{"*"},
+		AuthKeysFile:             "",
+		AuthPublicAllow:          []string{},
+		GPOBlocks:                DefaultGPOBlocks,
+		GPOPercentile:            DefaultGPOPercentile,
+		GPOIgnorePrice:           DefaultGPOIgnorePrice,
+		GPOMaxPrice:              DefaultGPOMaxPrice,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
+		RateLimitIP:              0,
+		RateLimitIPBurst:         DefaultRateLimitIPBurst,
+		RateLimitKey:             0,
+		RateLimitKeyBurst:        DefaultRateLimitKeyBurst,
+		RateLimitCosts:           GetDefaultRateLimitCosts(),
 		EnableIndexer:            false,
+		AddressIndex:             []string{},
+		EnableLogIndex:           false,
+		BundlerEntryPoints:       GetDefaultBundlerEntryPoints(),
+		BundlerKey:               "",
+		BundlerBeneficiary:       "",
+		BundlerInterval:          DefaultBundlerInterval,
+		BundlerMaxBundleSize:     DefaultBundlerMaxBundleSize,
+		BundlerMempoolSize:       DefaultBundlerMempoolSize,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -330,6 +483,46 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.WSMaxConcurrentRequests <= 0 {
+		return errors.New("JSON-RPC websocket max concurrent requests must be positive")
+	}
+
+	if c.WSMaxMessageSize < 0 {
+		return errors.New("JSON-RPC websocket max message size cannot be negative")
+	}
+
+	if c.GPOBlocks <= 0 {
+		return errors.New("JSON-RPC gas price oracle blocks must be positive")
+	}
+
+	if c.GPOPercentile < 0 || c.GPOPercentile > 100 {
+		return errors.New("JSON-RPC gas price oracle percentile must be between 0 and 100")
+	}
+
+	if c.GPOIgnorePrice < 0 || c.GPOMaxPrice < 0 {
+		return errors.New("JSON-RPC gas price oracle prices cannot be negative")
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.BatchResponseMaxSize < 0 {
+		return errors.New("JSON-RPC batch response max size cannot be negative")
+	}
+
+	if c.RateLimitIP < 0 || c.RateLimitKey < 0 {
+		return errors.New("JSON-RPC rate limits cannot be negative")
+	}
+
+	if (c.RateLimitIP > 0 && c.RateLimitIPBurst <= 0) || (c.RateLimitKey > 0 && c.RateLimitKeyBurst <= 0) {
+		return errors.New("JSON-RPC rate limit bursts must be positive")
+	}
+
+	if _, err := types.ParseAddressRoles(c.AddressIndex); err != nil {
+		return fmt.Errorf("invalid address index: %w", err)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -340,9 +533,78 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	// the admin namespace manages the peers of the node, so it's only served
+	// to the local clients unless they're authenticated
+	if seenAPIs["admin"] && !c.IsLocalOrAuthenticated() {
+		return errors.New("JSON-RPC admin namespace can only be enabled on localhost addresses if the authentication isn't configured")
+	}
+
+	if seenAPIs["bundler"] {
+		if err := c.validateBundler(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// validateBundler returns an error if the bundler configuration fields are
+// invalid.
+func (c JSONRPCConfig) validateBundler() error {
+	if c.BundlerKey == "" {
+		return errors.New("JSON-RPC bundler namespace requires a bundler key")
+	}
+
+	if len(c.BundlerEntryPoints) == 0 {
+		return errors.New("JSON-RPC bundler namespace requires at least one entry point")
+	}
+
+	for _, entryPoint := range c.BundlerEntryPoints {
+		if err := types.ValidateNonZeroAddress(entryPoint); err != nil {
+			return fmt.Errorf("invalid bundler entry point: %w", err)
+		}
+	}
+
+	if c.BundlerBeneficiary != "" {
+		if err := types.ValidateNonZeroAddress(c.BundlerBeneficiary); err != nil {
+			return fmt.Errorf("invalid bundler beneficiary: %w", err)
+		}
+	}
+
+	if c.BundlerInterval <= 0 {
+		return errors.New("JSON-RPC bundler interval must be positive")
+	}
+
+	if c.BundlerMaxBundleSize <= 0 || c.BundlerMempoolSize <= 0 {
+		return errors.New("JSON-RPC bundler max bundle size and mempool size must be positive")
+	}
+
+	return nil
+}
+
+// IsLocalOrAuthenticated returns true if the JSON-RPC servers only listen on
+// localhost addresses or the authentication is configured, in which case the
+// methods managing the node can be served.
+func (c JSONRPCConfig) IsLocalOrAuthenticated() bool {
+	if c.AuthJWTSecret != "" || c.AuthKeysFile != "" {
+		return true
+	}
+	return isLocalAddress(c.Address) && isLocalAddress(c.WsAddress)
+}
+
+// isLocalAddress returns true if the host of the address is a loopback.
+func isLocalAddress(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -68,3 +68,151 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAdminNamespace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *JSONRPCConfig)
+		expPass  bool
+	}{
+		{
+			"pass - localhost addresses",
+			func(cfg *JSONRPCConfig) {
+				cfg.Address = "localhost:8545"
+			},
+			true,
+		},
+		{
+			"fail - public http address",
+			func(cfg *JSONRPCConfig) {
+				cfg.Address = "0.0.0.0:8545"
+			},
+			false,
+		},
+		{
+			"fail - public websocket address",
+			func(cfg *JSONRPCConfig) {
+				cfg.WsAddress = "10.0.0.1:8546"
+			},
+			false,
+		},
+		{
+			"pass - public addresses with authentication",
+			func(cfg *JSONRPCConfig) {
+				cfg.Address = "0.0.0.0:8545"
+				cfg.WsAddress = "0.0.0.0:8546"
+				cfg.AuthKeysFile = "keys.json"
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.API = append(cfg.API, "admin")
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestIsLocalOrAuthenticated(t *testing.T) {
+	cfg := DefaultJSONRPCConfig()
+	require.True(t, cfg.IsLocalOrAuthenticated())
+
+	cfg.WsAddress = "0.0.0.0:8546"
+	require.False(t, cfg.IsLocalOrAuthenticated())
+
+	cfg.AuthJWTSecret = "secret"
+	require.True(t, cfg.IsLocalOrAuthenticated())
+}
+
+func TestValidateBundlerNamespace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *JSONRPCConfig)
+		expPass  bool
+	}{
+		{
+			"pass - default entry points",
+			func(cfg *JSONRPCConfig) {},
+			true,
+		},
+		{
+			"pass - beneficiary",
+			func(cfg *JSONRPCConfig) {
+				cfg.BundlerBeneficiary = "0x000000000000000000000000000000000000dEaD"
+			},
+			true,
+		},
+		{
+			"fail - no key",
+			func(cfg *JSONRPCConfig) {
+				cfg.BundlerKey = ""
+			},
+			false,
+		},
+		{
+			"fail - no entry point",
+			func(cfg *JSONRPCConfig) {
+				cfg.BundlerEntryPoints = []string{}
+			},
+			false,
+		},
+		{
+			"fail - invalid entry point",
+			func(cfg *JSONRPCConfig) {
+				cfg.BundlerEntryPoints = []string{"0x1"}
+			},
+			false,
+		},
+		{
+			"fail - invalid beneficiary",
+			func(cfg *JSONRPCConfig) {
+				cfg.BundlerBeneficiary = "beneficiary"
+			},
+			false,
+		},
+		{
+			"fail - zero interval",
+			func(cfg *JSONRPCConfig) {
+				cfg.BundlerInterval = 0
+			},
+			false,
+		},
+		{
+			"fail - zero bundle size",
+			func(cfg *JSONRPCConfig) {
+				cfg.BundlerMaxBundleSize = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.API = append(cfg.API, "bundler")
+			cfg.BundlerKey = "bundler"
+			tc.malleate(cfg)
+
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	// the bundler settings are ignored when the namespace is disabled
+	cfg := DefaultJSONRPCConfig()
+	require.NoError(t, cfg.Validate())
+}
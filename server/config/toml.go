@@ -39,6 +39,7 @@ ws-address = "{{ .JSONRPC.WsAddress }}"
 
 # API defines a list of JSON-RPC namespaces that should be enabled
 # Example: "eth,txpool,personal,net,debug,web3"
+# The admin namespace requires localhost addresses unless the authentication is configured.
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
@@ -76,9 +77,107 @@ allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 # for the server listener.
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
+# WSMaxConcurrentRequests sets the maximum number of requests served at once for each websocket connection.
+ws-max-concurrent-requests = {{ .JSONRPC.WSMaxConcurrentRequests }}
+
+# WSMaxMessageSize sets the maximum size in bytes of a message read from a websocket connection (unlimited = 0).
+ws-max-message-size = {{ .JSONRPC.WSMaxMessageSize }}
+
+# GPOBlocks defines the number of latest blocks sampled by the gas price oracle suggesting the tips of
+# 'eth_maxPriorityFeePerGas' and 'eth_gasPrice'.
+gpo-blocks = {{ .JSONRPC.GPOBlocks }}
+
+# GPOPercentile defines the percentile of the sampled tips suggested by the gas price oracle.
+gpo-percentile = {{ .JSONRPC.GPOPercentile }}
+
+# GPOIgnorePrice defines the tip in wei below which the gas price oracle ignores the txs.
+gpo-ignore-price = {{ .JSONRPC.GPOIgnorePrice }}
+
+# GPOMaxPrice defines the max tip in wei suggested by the gas price oracle (unlimited = 0).
+gpo-max-price = {{ .JSONRPC.GPOMaxPrice }}
+
+# BatchRequestLimit sets the maximum number of calls of a batch (unlimited = 0).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# BatchResponseMaxSize sets the maximum size in bytes of the responses of a batch (unlimited = 0).
+# The calls left once the size is exceeded get the 'response too large' error.
+batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
+
+# AuthJWTSecret defines the file of the hex encoded 32 bytes secret verifying the HS256 JWTs sent
+# by the clients in the 'Authorization: Bearer' header. The tokens must have an 'iat' claim within 60s
+# of the node time. The authentication is enabled if either auth-jwt-secret or auth-keys-file is set.
+auth-jwt-secret = "{{ .JSONRPC.AuthJWTSecret }}"
+
+# AuthJWTAllow defines the namespaces (eg. "eth") and methods (eg. "debug_traceTransaction") allowed
+# to the clients authenticated with a JWT ("*" allows everything).
+auth-jwt-allow = "{{range $index, $elmt := .JSONRPC.AuthJWTAllow}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# AuthKeysFile defines the JSON file of the API keys sent by the clients in the 'X-API-Key' header,
+# with the namespaces and methods allowed to each key.
+# Example: [{"name": "internal", "key": "<secret>", "allow": ["eth", "net", "web3", "debug", "personal"]}]
+auth-keys-file = "{{ .JSONRPC.AuthKeysFile }}"
+
+# AuthPublicAllow defines the namespaces and methods allowed to the clients without credentials when
+# the authentication is enabled. Example: "eth,net,web3"
+auth-public-allow = "{{range $index, $elmt := .JSONRPC.AuthPublicAllow}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# RateLimitIP defines the cost of the requests refilled per second in the token bucket of each IP
+# sending requests without credentials (disabled = 0). The rejected requests get the JSON-RPC
+# error -32005 (limit exceeded).
+rate-limit-ip = {{ .JSONRPC.RateLimitIP }}
+
+# RateLimitIPBurst defines the max cost of the requests served at once for each IP.
+rate-limit-ip-burst = {{ .JSONRPC.RateLimitIPBurst }}
+
+# RateLimitKey defines the cost of the requests refilled per second in the token bucket of each client
+# authenticated with an API key or a JWT (disabled = 0).
+rate-limit-key = {{ .JSONRPC.RateLimitKey }}
+
+# RateLimitKeyBurst defines the max cost of the requests served at once for each authenticated client.
+rate-limit-key-burst = {{ .JSONRPC.RateLimitKeyBurst }}
+
+# RateLimitCosts defines the costs of the namespaces (eg. "debug=50") and methods (eg. "eth_getLogs=20")
+# for the rate limits, the methods override their namespace. The others cost 1.
+rate-limit-costs = "{{range $index, $elmt := .JSONRPC.RateLimitCosts}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# AddressIndex defines the roles of the addresses by which the custom indexer also indexes the
+# transactions, to query the history of an address. Requires the custom indexer to be enabled.
+# Run 'reindex-eth-tx' to index the blocks indexed before enabling it.
+# Example: "sender,recipient,contract,log"
+address-index = "{{range $index, $elmt := .JSONRPC.AddressIndex}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# EnableLogIndex enables the index of the logs by address and topics in the custom indexer, used by
+# 'eth_getLogs' instead of scanning the blocks. The block-range-cap doesn't apply to the block ranges
+# covered by the log index. Requires the custom indexer to be enabled.
+# Run 'reindex-eth-tx' to index the blocks indexed before enabling it.
+enable-log-index = {{ .JSONRPC.EnableLogIndex }}
+
+# BundlerEntryPoints defines the addresses of the ERC-4337 EntryPoints served by the 'bundler' API
+# namespace, which validates and bundles the user operations sent with 'eth_sendUserOperation'.
+# The 'bundler_' methods managing the mempool are only served on localhost addresses if the
+# authentication isn't configured.
+bundler-entry-points = "{{range $index, $elmt := .JSONRPC.BundlerEntryPoints}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
+# BundlerKey defines the name of the keyring key signing the 'handleOps' transactions of the bundles.
+# Required by the 'bundler' API namespace.
+bundler-key = "{{ .JSONRPC.BundlerKey }}"
+
+# BundlerBeneficiary defines the address receiving the fees of the bundles, the address of the
+# bundler key if empty.
+bundler-beneficiary = "{{ .JSONRPC.BundlerBeneficiary }}"
+
+# BundlerInterval defines the interval between the bundles submitted by the bundler.
+bundler-interval = "{{ .JSONRPC.BundlerInterval }}"
+
+# BundlerMaxBundleSize defines the max number of user operations of a bundle.
+bundler-max-bundle-size = {{ .JSONRPC.BundlerMaxBundleSize }}
+
+# BundlerMempoolSize defines the max number of user operations in the bundler mempool.
+bundler-mempool-size = {{ .JSONRPC.BundlerMempoolSize }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -51,7 +51,32 @@ const (
 	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
+	JSONRPCWSMaxConcurrentReqs = "json-rpc.ws-max-concurrent-requests"
+	JSONRPCWSMaxMessageSize    = "json-rpc.ws-max-message-size"
+	JSONRPCGPOBlocks           = "json-rpc.gpo-blocks"
+	JSONRPCGPOPercentile       = "json-rpc.gpo-percentile"
+	JSONRPCGPOIgnorePrice      = "json-rpc.gpo-ignore-price"
+	JSONRPCGPOMaxPrice         = "json-rpc.gpo-max-price"
+	JSONRPCBatchRequestLimit   = "json-rpc.batch-request-limit"
+	JSONRPCBatchRespMaxSize    = "json-rpc.batch-response-max-size"
+	JSONRPCAuthJWTSecret       = "json-rpc.auth-jwt-secret"
+	JSONRPCAuthJWTAllow        = "json-rpc.auth-jwt-allow"
+	JSONRPCAuthKeysFile        = "json-rpc.auth-keys-file"
+	JSONRPCAuthPublicAllow     = "json-rpc.auth-public-allow"
+	JSONRPCRateLimitIP         = "json-rpc.rate-limit-ip"
+	JSONRPCRateLimitIPBurst    = "json-rpc.rate-limit-ip-burst"
+	JSONRPCRateLimitKey        = "json-rpc.rate-limit-key"
+	JSONRPCRateLimitKeyBurst   = "json-rpc.rate-limit-key-burst"
+	JSONRPCRateLimitCosts      = "json-rpc.rate-limit-costs"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCAddressIndex        = "json-rpc.address-index"
+	JSONRPCEnableLogIndex      = "json-rpc.enable-log-index"
+	JSONRPCBundlerEntryPoints  = "json-rpc.bundler-entry-points"
+	JSONRPCBundlerKey          = "json-rpc.bundler-key"
+	JSONRPCBundlerBeneficiary  = "json-rpc.bundler-beneficiary"
+	JSONRPCBundlerInterval     = "json-rpc.bundler-interval"
+	JSONRPCBundlerMaxBundle    = "json-rpc.bundler-max-bundle-size"
+	JSONRPCBundlerMempoolSize  = "json-rpc.bundler-mempool-size"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -13,6 +13,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/kato114/byte/v15/indexer"
+	"github.com/kato114/byte/v15/server/config"
+	evmostypes "github.com/kato114/byte/v15/types"
+)
+
+const (
+	flagAddressRoles = "roles"
+	flagLogIndex     = "log-index"
 )
 
 func NewIndexTxCmd() *cobra.Command {
@@ -39,6 +46,16 @@ func NewIndexTxCmd() *cobra.Command {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
+			// build the optional indexes enabled in the app config too
+			appConf, err := config.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			addressRoles, err := evmostypes.ParseAddressRoles(appConf.JSONRPC.AddressIndex)
+			if err != nil {
+				return err
+			}
+
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
@@ -47,7 +64,8 @@ func NewIndexTxCmd() *cobra.Command {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx, addressRoles...)
+			idxer.SetLogIndex(appConf.JSONRPC.EnableLogIndex)
 
 			// open local tendermint db, because the local rpc won't be available.
 			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
@@ -118,3 +136,111 @@ func NewIndexTxCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+func NewReindexTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reindex-eth-tx",
+		Short: "Index again the already indexed eth txs with the optional indexes",
+		Long: `Index again the blocks already indexed by the custom tx indexer with the optional address and log indexes, to migrate an indexer db built before they were enabled.
+		The blocks from the latest to the first indexed block are indexed with the address roles (json-rpc.address-index) and the log index (json-rpc.enable-log-index) of the app config, or the ones given by the flags.
+		It should be run while the node is stopped, with the same indexes enabled in the app config so that the following blocks are indexed too.
+		`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			appConf, err := config.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			roleNames, err := cmd.Flags().GetStringSlice(flagAddressRoles)
+			if err != nil {
+				return err
+			}
+			if len(roleNames) == 0 {
+				roleNames = appConf.JSONRPC.AddressIndex
+			}
+			addressRoles, err := evmostypes.ParseAddressRoles(roleNames)
+			if err != nil {
+				return err
+			}
+
+			logIndex, err := cmd.Flags().GetBool(flagLogIndex)
+			if err != nil {
+				return err
+			}
+			logIndex = logIndex || appConf.JSONRPC.EnableLogIndex
+
+			if len(addressRoles) == 0 && !logIndex {
+				return fmt.Errorf("no optional index enabled, set json-rpc.address-index or json-rpc.enable-log-index in the app config, or use --%s or --%s", flagAddressRoles, flagLogIndex)
+			}
+
+			cfg := serverCtx.Config
+			logger := serverCtx.Logger
+			idxDB, err := OpenIndexerDB(cfg.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx, addressRoles...)
+			idxer.SetLogIndex(logIndex)
+
+			// open local tendermint db, because the local rpc won't be available.
+			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := tmstore.NewBlockStore(tmdb)
+
+			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+			})
+
+			first, err := idxer.FirstIndexedBlock()
+			if err != nil {
+				return err
+			}
+			last, err := idxer.LastIndexedBlock()
+			if err != nil {
+				return err
+			}
+			if first == -1 {
+				// nothing to migrate if indexer db is empty
+				return nil
+			}
+
+			// indexing a block again is idempotent, the tx entries are
+			// overwritten with the same values. The blocks are indexed
+			// backward so that the range covered by the log index stays
+			// contiguous if interrupted.
+			for i := last; i >= first; i-- {
+				blk := blockStore.LoadBlock(i)
+				if blk == nil {
+					return fmt.Errorf("block not found %d", i)
+				}
+				resBlk, err := stateStore.LoadABCIResponses(i)
+				if err != nil {
+					return err
+				}
+				if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
+					return err
+				}
+				fmt.Println(i)
+			}
+
+			return nil
+		},
+	}
+	cmd.Flags().StringSlice(flagAddressRoles, []string{}, "Roles of the addresses to index (sender, recipient, contract, log), defaults to the app config")
+	cmd.Flags().Bool(flagLogIndex, false, "Index the logs by address and topics, defaults to the app config")
+	return cmd
+}
@@ -15,6 +15,8 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/kato114/byte/v15/rpc"
+	"github.com/kato114/byte/v15/rpc/auth"
+	"github.com/kato114/byte/v15/rpc/ratelimit"
 
 	"github.com/kato114/byte/v15/server/config"
 	evmostypes "github.com/kato114/byte/v15/types"
@@ -61,8 +63,31 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	authenticator, err := auth.NewAuthenticator(config.JSONRPC)
+	if err != nil {
+		ctx.Logger.Error("failed to load JSON-RPC authentication", "error", err.Error())
+		return nil, nil, err
+	}
+
+	limiter, err := ratelimit.NewLimiter(config.JSONRPC)
+	if err != nil {
+		ctx.Logger.Error("failed to load JSON-RPC rate limits", "error", err.Error())
+		return nil, nil, err
+	}
+
+	batchHandler := rpc.NewBatchLimitHandler(rpcServer, config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+
+	// the authentication runs first to rate limit the requests per client
+	handler := batchHandler
+	if limiter != nil {
+		handler = limiter.Handler(handler)
+	}
+	if authenticator != nil {
+		handler = authenticator.Handler(handler)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", handler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -109,7 +134,7 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, batchHandler, authenticator, limiter)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
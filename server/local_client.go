@@ -0,0 +1,37 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package server
+
+import (
+	"context"
+
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/rpc/client/local"
+)
+
+// localClient is the local client of the node, able to disconnect from peers
+// for the admin JSON-RPC namespace, which CometBFT doesn't expose over RPC.
+type localClient struct {
+	*local.Local
+	sw *p2p.Switch
+}
+
+func newLocalClient(tmNode *node.Node) *localClient {
+	return &localClient{
+		Local: local.New(tmNode),
+		sw:    tmNode.Switch(),
+	}
+}
+
+// RemovePeer disconnects from the peer, returns false if the peer isn't
+// connected.
+func (c *localClient) RemovePeer(_ context.Context, id p2p.ID) (bool, error) {
+	peer := c.sw.Peers().Get(id)
+	if peer == nil {
+		return false, nil
+	}
+
+	c.sw.StopPeerGracefully(peer)
+	return true, nil
+}
@@ -31,7 +31,6 @@ import (
 	pvm "github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
-	"github.com/cometbft/cometbft/rpc/client/local"
 
 	"cosmossdk.io/tools/rosetta"
 	crgserver "cosmossdk.io/tools/rosetta/lib/server"
@@ -197,7 +196,32 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxConcurrentReqs, config.DefaultWSMaxConcurrentRequests, "Sets the maximum number of requests served at once for each websocket connection")
+	cmd.Flags().Int64(srvflags.JSONRPCWSMaxMessageSize, config.DefaultWSMaxMessageSize, "Sets the maximum size in bytes of a message read from a websocket connection (unlimited = 0)")
+	cmd.Flags().Int(srvflags.JSONRPCGPOBlocks, config.DefaultGPOBlocks, "Sets the number of blocks sampled by the gas price oracle")
+	cmd.Flags().Int(srvflags.JSONRPCGPOPercentile, config.DefaultGPOPercentile, "Sets the percentile of the sampled tips suggested by the gas price oracle")
+	cmd.Flags().Int64(srvflags.JSONRPCGPOIgnorePrice, config.DefaultGPOIgnorePrice, "Sets the tip in wei below which the gas price oracle ignores the txs")
+	cmd.Flags().Int64(srvflags.JSONRPCGPOMaxPrice, config.DefaultGPOMaxPrice, "Sets the max tip in wei suggested by the gas price oracle (unlimited = 0)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the maximum number of calls of a json-rpc batch (unlimited = 0)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRespMaxSize, config.DefaultBatchResponseMaxSize, "Sets the maximum size in bytes of the responses of a json-rpc batch (unlimited = 0)")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, "", "Sets the file of the hex encoded secret verifying the HS256 JWTs of the json-rpc clients")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthJWTAllow, []string{"*"}, "Defines the namespaces and methods allowed to the json-rpc clients authenticated with a JWT")
+	cmd.Flags().String(srvflags.JSONRPCAuthKeysFile, "", "Sets the JSON file of the API keys of the json-rpc clients and their allowed namespaces and methods")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthPublicAllow, []string{}, "Defines the namespaces and methods allowed to the json-rpc clients without credentials when the authentication is enabled") //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitIP, 0, "Sets the cost of the requests refilled per second for each IP sending json-rpc requests without credentials (disabled = 0)")                 //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitIPBurst, config.DefaultRateLimitIPBurst, "Sets the max cost of the json-rpc requests served at once for each IP")                                        //nolint:lll
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitKey, 0, "Sets the cost of the requests refilled per second for each authenticated json-rpc client (disabled = 0)")                                   //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitKeyBurst, config.DefaultRateLimitKeyBurst, "Sets the max cost of the json-rpc requests served at once for each authenticated client")                    //nolint:lll
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitCosts, config.GetDefaultRateLimitCosts(), "Defines the costs of the json-rpc namespaces and methods for the rate limits (eg. eth_getLogs=20)")   //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAddressIndex, []string{}, "Defines the roles of the addresses indexed by the custom tx indexer (sender, recipient, contract, log)")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndex, false, "Enable the index of the logs by address and topics in the custom tx indexer")
+	cmd.Flags().StringSlice(srvflags.JSONRPCBundlerEntryPoints, config.GetDefaultBundlerEntryPoints(), "Defines the ERC-4337 EntryPoints served by the bundler json-rpc namespace") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCBundlerKey, "", "Sets the name of the keyring key signing the bundles of the bundler")                                                       //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCBundlerBeneficiary, "", "Sets the address receiving the fees of the bundles (default the bundler key address)")                              //nolint:lll
+	cmd.Flags().Duration(srvflags.JSONRPCBundlerInterval, config.DefaultBundlerInterval, "Sets the interval between the bundles submitted by the bundler")                          //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCBundlerMaxBundle, config.DefaultBundlerMaxBundleSize, "Sets the max number of user operations of a bundle")                                     //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCBundlerMempoolSize, config.DefaultBundlerMempoolSize, "Sets the max number of user operations in the bundler mempool")                          //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -391,7 +415,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	// service if API or gRPC or JSONRPC is enabled, and avoid doing so in the general
 	// case, because it spawns a new local tendermint RPC client.
 	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer) && tmNode != nil {
-		clientCtx = clientCtx.WithClient(local.New(tmNode))
+		clientCtx = clientCtx.WithClient(newLocalClient(tmNode))
 
 		app.RegisterTxService(clientCtx)
 		app.RegisterTendermintService(clientCtx)
@@ -417,8 +441,15 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 			return err
 		}
 
+		addressRoles, err := evmostypes.ParseAddressRoles(config.JSONRPC.AddressIndex)
+		if err != nil {
+			return err
+		}
+
 		idxLogger := ctx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		kvIdxer := indexer.NewKVIndexer(idxDB, idxLogger, clientCtx, addressRoles...)
+		kvIdxer.SetLogIndex(config.JSONRPC.EnableLogIndex)
+		idxer = kvIdxer
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(idxLogger)
 
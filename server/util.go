@@ -57,6 +57,7 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+		NewReindexTxCmd(),
 	)
 }
 
@@ -326,6 +326,8 @@ func New(l Logger, baseDir string, cfg Config) (*Network, error) {
 			}
 			appCfg.JSONRPC.Enable = true
 			appCfg.JSONRPC.API = config.GetAPINamespaces()
+			// the validator key signs the bundles
+			appCfg.JSONRPC.BundlerKey = fmt.Sprintf("node%d", i)
 		}
 
 		logger := log.NewNopLogger()
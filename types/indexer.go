@@ -3,11 +3,77 @@
 package types
 
 import (
+	"fmt"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
+// AddressRole defines the role of an address in an eth tx, used by the
+// address index of the EVM indexer.
+type AddressRole byte
+
+const (
+	// AddressRoleSender is the sender of the tx.
+	AddressRoleSender AddressRole = iota + 1
+	// AddressRoleRecipient is the recipient of the tx.
+	AddressRoleRecipient
+	// AddressRoleContract is the contract created by the tx.
+	AddressRoleContract
+	// AddressRoleLog is a contract that emitted a log in the tx.
+	AddressRoleLog
+)
+
+var addressRoleNames = map[AddressRole]string{
+	AddressRoleSender:    "sender",
+	AddressRoleRecipient: "recipient",
+	AddressRoleContract:  "contract",
+	AddressRoleLog:       "log",
+}
+
+// AllAddressRoles returns all the address roles that can be indexed.
+func AllAddressRoles() []AddressRole {
+	return []AddressRole{AddressRoleSender, AddressRoleRecipient, AddressRoleContract, AddressRoleLog}
+}
+
+// String returns the name of the address role.
+func (r AddressRole) String() string {
+	return addressRoleNames[r]
+}
+
+// ParseAddressRole returns the address role with the given name.
+func ParseAddressRole(name string) (AddressRole, error) {
+	for role, roleName := range addressRoleNames {
+		if roleName == name {
+			return role, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown address role '%s', expected one of: sender, recipient, contract, log", name)
+}
+
+// ParseAddressRoles returns the address roles with the given names.
+func ParseAddressRoles(names []string) ([]AddressRole, error) {
+	roles := make([]AddressRole, 0, len(names))
+	for _, name := range names {
+		role, err := ParseAddressRole(name)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// LogPosition is the position of a log in the chain, used by the log index of
+// the EVM indexer.
+type LogPosition struct {
+	// Height is the block number of the log
+	Height int64
+	// Index is the index of the log in the block
+	Index uint64
+}
+
 // EVMTxIndexer defines the interface of custom eth tx indexer.
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
@@ -18,4 +84,19 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// AddressRoles returns the address roles indexed, empty if the address
+	// index is disabled.
+	AddressRoles() []AddressRole
+	// GetByAddress returns the hashes of the txs of an address with any of
+	// the given roles, starting from a block number in either direction, and
+	// whether there are more.
+	GetByAddress(common.Address, []AddressRole, int64, bool, int) ([]common.Hash, bool, error)
+
+	// LogIndexRange returns the first and last blocks covered by the log
+	// index, -1 if the log index is empty or disabled.
+	LogIndexRange() (int64, int64, error)
+	// GetLogPositions returns the positions of the logs in a block range that
+	// match the addresses and topics criteria, up to the limit plus one.
+	GetLogPositions(int64, int64, []common.Address, [][]common.Hash, int) ([]LogPosition, error)
 }
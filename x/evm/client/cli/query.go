@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetAccessControlCmd(),
+		GetPrecompileInstancesCmd(),
 	)
 	return cmd
 }
@@ -134,3 +136,69 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAccessControlCmd queries the access control of the contract deployments and calls
+func GetAccessControlCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "access-control",
+		Short: "Get the evm access control",
+		Long:  "Get the permission policy of the contract deployments and calls.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccessControl(cmd.Context(), &types.QueryAccessControlRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPrecompileInstancesCmd queries the instances of the precompile registry
+func GetPrecompileInstancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "precompile-instances",
+		Short: "Get the registered precompile instances",
+		Long:  "Get the parameterized precompiles registered by governance, both enabled and disabled.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPrecompileInstancesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PrecompileInstances(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "precompile instances")
+	return cmd
+}
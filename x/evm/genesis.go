@@ -70,6 +70,17 @@ func InitGenesis(
 		}
 	}
 
+	// the registered precompiles are loaded at begin block, once the state they
+	// depend on (e.g. the ERC20 token pairs) is initialized
+	for _, instance := range data.PrecompileInstances {
+		k.SetPrecompileInstance(ctx, instance)
+	}
+
+	// new chains store the parent block hashes from the first block
+	if err := k.DeployHistoryStorage(ctx); err != nil {
+		panic(fmt.Errorf("error deploying the history storage contract %s", err))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -98,7 +109,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:            ethGenAccounts,
+		Params:              k.GetParams(ctx),
+		PrecompileInstances: k.GetPrecompileInstances(ctx),
 	}
 }
@@ -10,9 +10,18 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper, loads the
+// precompile registry and stores the hash of the parent block on the history
+// storage contract. It panics if the hash can't be stored, as the contract
+// would serve a different history on each node.
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+
+	k.LoadPrecompileRegistry(ctx)
+
+	if err := k.SetParentBlockHash(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // EndBlock also retrieves the bloom filter value from the transient store and commits it to the
@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/kato114/byte/v15/x/evm/statedb"
+	"github.com/kato114/byte/v15/x/evm/types"
+)
+
+// createGuard denies the contract creations, including the ones of the
+// internal calls, to the accounts that are not permitted to create contracts.
+//
+// The EVM has no hook to deny a CREATE or CREATE2, so the creation is detected
+// when the EVM transfers the value from the creating contract to the new
+// account, right before running the init code. A denied creation aborts the
+// execution of the message, which is then reverted.
+type createGuard struct {
+	stateDB  *statedb.StateDB
+	policy   types.AccessPolicy
+	transfer vm.TransferFunc
+
+	// checked holds the accounts whose creation has been checked, so that the
+	// transfers to a contract under construction are not taken for its creation
+	checked map[common.Address]struct{}
+}
+
+// createNotPermitted is the panic value aborting the execution of a message
+// on a denied creation.
+type createNotPermitted struct {
+	creator common.Address
+}
+
+// newCreateGuard returns a createGuard for the given create policy, or nil if
+// the policy allows anyone to create contracts.
+func newCreateGuard(stateDB *statedb.StateDB, policy types.AccessPolicy) *createGuard {
+	if policy.IsPermissionless() {
+		return nil
+	}
+
+	return &createGuard{
+		stateDB: stateDB,
+		policy:  policy,
+		checked: make(map[common.Address]struct{}),
+	}
+}
+
+// install replaces the transfer function of the EVM by the guarded one.
+func (g *createGuard) install(evm *vm.EVM) {
+	g.transfer = evm.Context.Transfer
+	evm.Context.Transfer = g.Transfer
+}
+
+// Transfer implements vm.TransferFunc. The EVM creates the account of a new
+// contract with nonce 1 and transfers the value to it before running its init
+// code, so the first transfer to such an account is the one of its creation.
+func (g *createGuard) Transfer(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+	if _, ok := g.checked[recipient]; !ok &&
+		g.stateDB.IsCreated(recipient) && db.GetNonce(recipient) == 1 && db.GetCodeSize(recipient) == 0 {
+		g.checked[recipient] = struct{}{}
+
+		if !g.policy.IsAllowed(sender) {
+			panic(createNotPermitted{creator: sender})
+		}
+	}
+
+	g.transfer(db, sender, recipient, amount)
+}
+
+// run executes the message and reverts it if it was aborted by a denied
+// creation.
+func (g *createGuard) run(execute func()) (err error) {
+	if g == nil {
+		execute()
+		return nil
+	}
+
+	snapshot := g.stateDB.Snapshot()
+	defer func() {
+		if r := recover(); r != nil {
+			denied, ok := r.(createNotPermitted)
+			if !ok {
+				panic(r)
+			}
+
+			g.stateDB.RevertToSnapshot(snapshot)
+			err = errorsmod.Wrapf(types.ErrCreateNotPermitted, "%s is not permitted to create contracts", denied.creator)
+		}
+	}()
+
+	execute()
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/kato114/byte/v15/x/evm/statedb"
 	"github.com/kato114/byte/v15/x/evm/types"
+	"golang.org/x/exp/slices"
 )
 
 // EVMConfig creates the EVMConfig based on current state
@@ -57,10 +58,17 @@ func (k Keeper) VMConfig(ctx sdk.Context, _ core.Message, cfg *statedb.EVMConfig
 		debug = true
 	}
 
+	extraEIPs := cfg.Params.EIPs()
+	for _, eip := range types.ForkEIPs(cfg.ChainConfig, ctx.BlockHeight()) {
+		if !slices.Contains(extraEIPs, eip) {
+			extraEIPs = append(extraEIPs, eip)
+		}
+	}
+
 	return vm.Config{
 		Debug:     debug,
 		Tracer:    tracer,
 		NoBaseFee: noBaseFee,
-		ExtraEips: cfg.Params.EIPs(),
+		ExtraEips: extraEIPs,
 	}
 }
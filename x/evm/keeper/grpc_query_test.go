@@ -1,15 +1,19 @@
 package keeper_test
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -18,6 +22,7 @@ import (
 	"github.com/kato114/byte/v15/server/config"
 	utiltx "github.com/kato114/byte/v15/testutil/tx"
 	"github.com/kato114/byte/v15/x/evm/statedb"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
 	"github.com/kato114/byte/v15/x/evm/types"
 )
 
@@ -25,10 +30,10 @@ import (
 const invalidAddress = "0x0000"
 
 // expGasConsumed is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee)
-const expGasConsumed = 7196
+const expGasConsumed = 7070
 
 // expGasConsumedWithFeeMkt is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) with enabled feemarket
-const expGasConsumedWithFeeMkt = 7190
+const expGasConsumedWithFeeMkt = 7064
 
 func (suite *KeeperTestSuite) TestQueryAccount() {
 	var (
@@ -415,6 +420,55 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryAccessControl() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.AccessControl(ctx, &types.QueryAccessControlRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultAccessControl(), res.AccessControl)
+
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.AccessControl.Create = types.AccessControlType{
+		AccessType:        types.AccessTypePermissioned,
+		AccessControlList: []string{suite.address.Hex()},
+	}
+	err = suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.AccessControl(ctx, &types.QueryAccessControlRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.AccessControl, res.AccessControl)
+}
+
+func (suite *KeeperTestSuite) TestQueryPrecompileInstances() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// the Stride outpost is registered in the default genesis
+	res, err := suite.queryClient.PrecompileInstances(ctx, &types.QueryPrecompileInstancesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultPrecompileInstances(), res.PrecompileInstances)
+
+	instances := []types.PrecompileInstance{
+		types.DefaultPrecompileInstances()[0],
+		types.NewPrecompileInstance(common.HexToAddress("0x0000000000000000000000000000000000000a00"), types.PrecompileTypeERC20, true),
+		types.NewPrecompileInstance(common.HexToAddress("0x0000000000000000000000000000000000000a01"), types.PrecompileTypeERC20, false),
+	}
+	for _, instance := range instances[1:] {
+		suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, instance)
+	}
+
+	res, err = suite.queryClient.PrecompileInstances(ctx, &types.QueryPrecompileInstancesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(instances, res.PrecompileInstances)
+
+	res, err = suite.queryClient.PrecompileInstances(ctx, &types.QueryPrecompileInstancesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(instances[:1], res.PrecompileInstances)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	var (
 		req        *types.QueryValidatorAccountRequest
@@ -499,8 +553,9 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 	hexBigInt := hexutil.Big(*big.NewInt(1))
 
 	var (
-		args   interface{}
-		gasCap uint64
+		args           interface{}
+		gasCap         uint64
+		stateOverrides []byte
 	)
 	testCases := []struct {
 		msg             string
@@ -539,6 +594,30 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 			0,
 			false,
 		},
+		// should success, the balance of the default From address is overridden
+		{
+			"not enough balance - balance state override",
+			func() {
+				balance := (*hexutil.Big)(big.NewInt(100))
+				args = types.TransactionArgs{To: &common.Address{}, Value: (*hexutil.Big)(big.NewInt(100))}
+				stateOverrides, _ = json.Marshal(types.StateOverride{
+					common.Address{}: {Balance: &balance},
+				})
+			},
+			true,
+			ethparams.TxGas,
+			false,
+		},
+		{
+			"invalid state overrides",
+			func() {
+				args = types.TransactionArgs{To: &common.Address{}}
+				stateOverrides = []byte("invalid overrides")
+			},
+			false,
+			0,
+			false,
+		},
 		// should success, enough balance now
 		{
 			"enough balance",
@@ -740,6 +819,7 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 			suite.enableFeemarket = tc.enableFeemarket
 			suite.SetupTest()
 			gasCap = 25_000_000
+			stateOverrides = nil
 			tc.malleate()
 
 			args, err := json.Marshal(&args)
@@ -748,6 +828,7 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 				Args:            args,
 				GasCap:          gasCap,
 				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+				StateOverrides:  stateOverrides,
 			}
 
 			rsp, err := suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &req)
@@ -937,7 +1018,7 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			},
 			expPass:       true,
 			traceResponse: "{\"gas\":34828,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
-			expFinalGas:   23660, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
+			expFinalGas:   22754, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
 		},
 		{
 			msg: "invalid chain id",
@@ -1000,6 +1081,50 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceTxVMTrace() {
+	suite.SetupTest()
+	// Deploy contract
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	// Generate token transfer transaction
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+		Msg:         txMsg,
+		TraceConfig: &types.TraceConfig{Tracer: evmtracers.VMTraceTracer},
+	})
+	suite.Require().NoError(err)
+
+	var trace evmtracers.VMTrace
+	suite.Require().NoError(json.Unmarshal(res.Data, &trace))
+	suite.Require().Equal(hexutil.Bytes(suite.StateDB().GetCode(contractAddr)), trace.Code)
+	suite.Require().NotEmpty(trace.Ops)
+
+	// the contract starts with PUSH1 0x80 PUSH1 0x40 MSTORE
+	suite.Require().Equal(uint64(0), trace.Ops[0].PC)
+	suite.Require().Equal([]*hexutil.Big{(*hexutil.Big)(big.NewInt(0x80))}, trace.Ops[0].Ex.Push)
+	suite.Require().Equal(uint64(2), trace.Ops[1].PC)
+	suite.Require().Equal([]*hexutil.Big{(*hexutil.Big)(big.NewInt(0x40))}, trace.Ops[1].Ex.Push)
+	suite.Require().Equal(uint64(4), trace.Ops[2].PC)
+	suite.Require().Empty(trace.Ops[2].Ex.Push)
+	suite.Require().Equal(&evmtracers.VMTraceMemory{
+		Off:  0x40,
+		Data: common.LeftPadBytes([]byte{0x80}, 32),
+	}, trace.Ops[2].Ex.Mem)
+
+	// the gas left after each operation is the gas left before the next one
+	suite.Require().Equal(trace.Ops[0].Ex.Used-trace.Ops[1].Cost, trace.Ops[1].Ex.Used)
+
+	var store *evmtracers.VMTraceStorage
+	for _, op := range trace.Ops {
+		if op.Ex.Store != nil {
+			store = op.Ex.Store
+		}
+	}
+	suite.Require().NotNil(store, "the token transfer should update the balances")
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
 		txs         []*types.MsgEthereumTx
@@ -1184,6 +1309,636 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var (
+		args           types.TransactionArgs
+		stateOverrides types.StateOverride
+		blockOverrides *types.BlockOverrides
+		expTo          common.Address
+	)
+
+	from := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	slot := common.BigToHash(big.NewInt(0))
+	value := common.BigToHash(big.NewInt(42))
+
+	// returns the value of the given opcode as a 32 bytes word:
+	// <OPCODE> PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	returnOpCode := func(op vm.OpCode) hexutil.Bytes {
+		return hexutil.Bytes{byte(op), 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	}
+	// returns the value of the storage slot 0: PUSH1 0 SLOAD ...
+	sloadCode := append(hexutil.Bytes{0x60, 0x00}, returnOpCode(vm.SLOAD)...)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		expOutput string
+		expError  string
+	}{
+		{
+			"fail - state and stateDiff overrides on the same account",
+			func() {
+				state := map[common.Hash]common.Hash{slot: value}
+				stateOverrides = types.StateOverride{
+					contract: {State: &state, StateDiff: &state},
+				}
+			},
+			false,
+			"",
+			"",
+		},
+		{
+			"fail - negative block number override",
+			func() {
+				blockOverrides = &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(-1))}
+			},
+			false,
+			"",
+			"",
+		},
+		{
+			"pass - code and balance overrides",
+			func() {
+				code := returnOpCode(vm.SELFBALANCE)
+				balance := (*hexutil.Big)(big.NewInt(2e18))
+				stateOverrides = types.StateOverride{
+					contract: {Code: &code, Balance: &balance},
+				}
+			},
+			true,
+			common.BigToHash(big.NewInt(2e18)).Hex(),
+			"",
+		},
+		{
+			"pass - nonce override",
+			func() {
+				// the sender nonce is used for the contract creation address
+				nonce := hexutil.Uint64(5)
+				stateOverrides = types.StateOverride{
+					from: {Nonce: &nonce},
+				}
+				args.To = nil
+				args.Data = &hexutil.Bytes{0x00}
+				expTo = crypto.CreateAddress(from, 5)
+			},
+			true,
+			"0x",
+			"",
+		},
+		{
+			"pass - code and state overrides",
+			func() {
+				state := map[common.Hash]common.Hash{slot: value}
+				stateOverrides = types.StateOverride{
+					contract: {Code: &sloadCode, State: &state},
+				}
+			},
+			true,
+			value.Hex(),
+			"",
+		},
+		{
+			"pass - code and stateDiff overrides",
+			func() {
+				stateDiff := map[common.Hash]common.Hash{slot: value}
+				stateOverrides = types.StateOverride{
+					contract: {Code: &sloadCode, StateDiff: &stateDiff},
+				}
+			},
+			true,
+			value.Hex(),
+			"",
+		},
+		{
+			"pass - block number override",
+			func() {
+				code := returnOpCode(vm.NUMBER)
+				stateOverrides = types.StateOverride{contract: {Code: &code}}
+				blockOverrides = &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1000))}
+			},
+			true,
+			common.BigToHash(big.NewInt(1000)).Hex(),
+			"",
+		},
+		{
+			"pass - block time override",
+			func() {
+				code := returnOpCode(vm.TIMESTAMP)
+				blockTime := hexutil.Uint64(1700000000)
+				stateOverrides = types.StateOverride{contract: {Code: &code}}
+				blockOverrides = &types.BlockOverrides{Time: &blockTime}
+			},
+			true,
+			common.BigToHash(big.NewInt(1700000000)).Hex(),
+			"",
+		},
+		{
+			"pass - coinbase override",
+			func() {
+				code := returnOpCode(vm.COINBASE)
+				stateOverrides = types.StateOverride{contract: {Code: &code}}
+				blockOverrides = &types.BlockOverrides{Coinbase: &from}
+			},
+			true,
+			common.BytesToHash(from.Bytes()).Hex(),
+			"",
+		},
+		{
+			"pass - base fee override",
+			func() {
+				code := returnOpCode(vm.BASEFEE)
+				stateOverrides = types.StateOverride{contract: {Code: &code}}
+				blockOverrides = &types.BlockOverrides{BaseFee: (*hexutil.Big)(big.NewInt(7))}
+			},
+			true,
+			common.BigToHash(big.NewInt(7)).Hex(),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			args = types.TransactionArgs{From: &from, To: &contract}
+			stateOverrides = nil
+			blockOverrides = nil
+			expTo = contract
+
+			tc.malleate()
+
+			argsBz, err := json.Marshal(&args)
+			suite.Require().NoError(err)
+
+			req := &types.QueryTraceCallRequest{
+				Args:        argsBz,
+				GasCap:      config.DefaultGasCap,
+				TraceConfig: &types.TraceConfig{Tracer: "callTracer"},
+				BlockNumber: suite.ctx.BlockHeight(),
+				BlockTime:   suite.ctx.BlockTime(),
+				ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+			}
+			if stateOverrides != nil {
+				req.StateOverrides, err = json.Marshal(stateOverrides)
+				suite.Require().NoError(err)
+			}
+			if blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var frame struct {
+				To     common.Address `json:"to"`
+				Output string         `json:"output"`
+				Error  string         `json:"error"`
+			}
+			suite.Require().NoError(json.Unmarshal(res.Data, &frame))
+			suite.Require().Equal(expTo, frame.To)
+			suite.Require().Equal(tc.expError, frame.Error)
+			if tc.expError == "" {
+				suite.Require().Equal(tc.expOutput, frame.Output)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1() {
+	var (
+		opts   types.SimulateOpts
+		gasCap uint64
+	)
+
+	from := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	receiver := utiltx.GenerateAddress()
+
+	// increments the storage slot 0 and returns its new value:
+	// PUSH1 0 SLOAD PUSH1 1 ADD DUP1 PUSH1 0 SSTORE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	counterCode := hexutil.Bytes{
+		0x60, 0x00, 0x54, 0x60, 0x01, 0x01, 0x80, 0x60, 0x00, 0x55,
+		0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3,
+	}
+	// PUSH1 0 PUSH1 0 REVERT
+	revertCode := hexutil.Bytes{0x60, 0x00, 0x60, 0x00, 0xfd}
+
+	counter := func(n int64) hexutil.Bytes {
+		return common.BigToHash(big.NewInt(n)).Bytes()
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		postCheck func(results []types.SimBlockResult)
+	}{
+		{
+			"fail - empty input",
+			func() {
+				opts = types.SimulateOpts{}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - too many blocks",
+			func() {
+				opts = types.SimulateOpts{
+					BlockStateCalls: make([]types.SimBlock, types.MaxSimulateBlocks+1),
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - too many calls",
+			func() {
+				calls := make([]types.TransactionArgs, types.MaxSimulateCalls/2+1)
+				opts = types.SimulateOpts{
+					BlockStateCalls: []types.SimBlock{{Calls: calls}, {Calls: calls}},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - gas cap shared by the blocks exceeded",
+			func() {
+				gas := hexutil.Uint64(30000)
+				gasCap = 50000
+				opts = types.SimulateOpts{
+					BlockStateCalls: []types.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &from, To: &receiver, Gas: &gas}}},
+						{Calls: []types.TransactionArgs{{From: &from, To: &receiver, Gas: &gas}}},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - block numbers out of order",
+			func() {
+				number := (*hexutil.Big)(big.NewInt(suite.ctx.BlockHeight() + 10))
+				opts = types.SimulateOpts{
+					BlockStateCalls: []types.SimBlock{
+						{BlockOverrides: &types.BlockOverrides{Number: number}},
+						{BlockOverrides: &types.BlockOverrides{Number: number}},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - invalid nonce with validation",
+			func() {
+				nonce := hexutil.Uint64(1)
+				opts = types.SimulateOpts{
+					Validation: true,
+					BlockStateCalls: []types.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &from, To: &receiver, Nonce: &nonce}}},
+					},
+				}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - state is carried between calls and blocks",
+			func() {
+				opts = types.SimulateOpts{
+					BlockStateCalls: []types.SimBlock{
+						{
+							StateOverrides: &types.StateOverride{contract: {Code: &counterCode}},
+							Calls: []types.TransactionArgs{
+								{From: &from, To: &contract},
+								{From: &from, To: &contract},
+							},
+						},
+						{
+							Calls: []types.TransactionArgs{{From: &from, To: &contract}},
+						},
+					},
+				}
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				suite.Require().Len(results, 2)
+				suite.Require().Len(results[0].Calls, 2)
+				suite.Require().Len(results[1].Calls, 1)
+				suite.Require().Equal(counter(1), results[0].Calls[0].ReturnData)
+				suite.Require().Equal(counter(2), results[0].Calls[1].ReturnData)
+				suite.Require().Equal(counter(3), results[1].Calls[0].ReturnData)
+
+				// the blocks are chained
+				height := uint64(suite.ctx.BlockHeight())
+				suite.Require().Equal(hexutil.Uint64(height+1), results[0].Number)
+				suite.Require().Equal(hexutil.Uint64(height+2), results[1].Number)
+				suite.Require().Equal(results[0].Hash, results[1].ParentHash)
+				suite.Require().Equal(
+					results[0].Timestamp+types.SimulateTimestampIncrement,
+					results[1].Timestamp,
+				)
+				suite.Require().Equal(
+					results[0].Calls[0].GasUsed+results[0].Calls[1].GasUsed,
+					results[0].GasUsed,
+				)
+			},
+		},
+		{
+			"pass - calls without gas limit share the gas cap",
+			func() {
+				gasCap = 50000
+				opts = types.SimulateOpts{
+					BlockStateCalls: []types.SimBlock{
+						{Calls: []types.TransactionArgs{{From: &from, To: &receiver}}},
+						{Calls: []types.TransactionArgs{{From: &from, To: &receiver}}},
+					},
+				}
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				// the second call gets the gas left by the first one
+				suite.Require().Len(results, 2)
+				suite.Require().LessOrEqual(uint64(results[0].GasUsed+results[1].GasUsed), uint64(50000))
+			},
+		},
+		{
+			"pass - reverted call",
+			func() {
+				opts = types.SimulateOpts{
+					BlockStateCalls: []types.SimBlock{
+						{
+							StateOverrides: &types.StateOverride{contract: {Code: &revertCode}},
+							Calls:          []types.TransactionArgs{{From: &from, To: &contract}},
+						},
+					},
+				}
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				call := results[0].Calls[0]
+				suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusFailed), call.Status)
+				suite.Require().NotNil(call.Error)
+				suite.Require().Equal(types.SimErrCodeReverted, call.Error.Code)
+				suite.Require().Equal("execution reverted", call.Error.Message)
+			},
+		},
+		{
+			"pass - trace transfers",
+			func() {
+				balance := (*hexutil.Big)(big.NewInt(1e18))
+				opts = types.SimulateOpts{
+					TraceTransfers: true,
+					BlockStateCalls: []types.SimBlock{
+						{
+							StateOverrides: &types.StateOverride{from: {Balance: &balance}},
+							Calls: []types.TransactionArgs{
+								{From: &from, To: &receiver, Value: (*hexutil.Big)(big.NewInt(100))},
+							},
+						},
+					},
+				}
+			},
+			true,
+			func(results []types.SimBlockResult) {
+				call := results[0].Calls[0]
+				suite.Require().Equal(hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), call.Status)
+				suite.Require().Len(call.Logs, 1)
+
+				log := call.Logs[0]
+				suite.Require().Equal(evmtracers.TransferLogAddress, log.Address)
+				suite.Require().Equal([]common.Hash{
+					evmtracers.TransferTopic,
+					common.BytesToHash(from.Bytes()),
+					common.BytesToHash(receiver.Bytes()),
+				}, log.Topics)
+				suite.Require().Equal(common.BigToHash(big.NewInt(100)).Bytes(), log.Data)
+				suite.Require().Equal(results[0].Hash, log.BlockHash)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			gasCap = config.DefaultGasCap
+			tc.malleate()
+
+			optsBz, err := json.Marshal(&opts)
+			suite.Require().NoError(err)
+
+			req := &types.QuerySimulateV1Request{
+				Opts:        optsBz,
+				GasCap:      gasCap,
+				BlockNumber: suite.ctx.BlockHeight(),
+				BlockTime:   suite.ctx.BlockTime(),
+				ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+			}
+
+			res, err := suite.queryClient.SimulateV1(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var results []types.SimBlockResult
+			suite.Require().NoError(json.Unmarshal(res.Data, &results))
+			tc.postCheck(results)
+
+			// the simulated state changes are discarded
+			suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(counterCode)))
+			suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, from))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1Canceled() {
+	from := utiltx.GenerateAddress()
+	receiver := utiltx.GenerateAddress()
+
+	optsBz, err := json.Marshal(&types.SimulateOpts{
+		BlockStateCalls: []types.SimBlock{
+			{Calls: []types.TransactionArgs{{From: &from, To: &receiver}}},
+		},
+	})
+	suite.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = suite.app.EvmKeeper.SimulateV1(sdk.WrapSDKContext(suite.ctx.WithContext(ctx)), &types.QuerySimulateV1Request{
+		Opts:        optsBz,
+		GasCap:      config.DefaultGasCap,
+		BlockNumber: suite.ctx.BlockHeight(),
+		BlockTime:   suite.ctx.BlockTime(),
+		ChainId:     suite.app.EvmKeeper.ChainID().Int64(),
+	})
+	suite.Require().ErrorContains(err, context.Canceled.Error())
+}
+
+func (suite *KeeperTestSuite) TestStorageRangeAt() {
+	var (
+		req          *types.QueryStorageRangeAtRequest
+		contractAddr common.Address
+	)
+
+	// the transfer is replayed on top of the committed one, so the
+	// recipient balance is transferred twice
+	amount := sdkmath.NewIntWithDecimal(1, 18).BigInt()
+	expValue := common.BigToHash(new(big.Int).Mul(amount, big.NewInt(2))).Hex()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		expLen   int
+		expNext  bool
+	}{
+		{
+			"pass - all entries",
+			func() {},
+			true,
+			3,
+			false,
+		},
+		{
+			"pass - max results",
+			func() {
+				req.MaxResults = 1
+			},
+			true,
+			1,
+			true,
+		},
+		{
+			"pass - no storage",
+			func() {
+				req.Address = utiltx.GenerateAddress().Hex()
+			},
+			true,
+			0,
+			false,
+		},
+		{
+			"fail - invalid address",
+			func() {
+				req.Address = invalidAddress
+			},
+			false,
+			0,
+			false,
+		},
+		{
+			"fail - invalid chain id",
+			func() {
+				req.ChainId = 1
+			},
+			false,
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			contractAddr = suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+			txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), amount)
+			suite.Commit()
+
+			req = &types.QueryStorageRangeAtRequest{
+				Msg:        txMsg,
+				Address:    contractAddr.Hex(),
+				MaxResults: 10,
+			}
+			tc.malleate()
+
+			// the replayed transactions are committed to the query context
+			ctx, _ := suite.ctx.CacheContext()
+			res, err := suite.queryClient.StorageRangeAt(sdk.WrapSDKContext(ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Storage, tc.expLen)
+			suite.Require().Equal(tc.expNext, res.NextKey != "")
+
+			if tc.expLen > 1 {
+				var values []string
+				for _, state := range res.Storage {
+					values = append(values, state.Value)
+				}
+				suite.Require().Contains(values, expValue)
+			}
+
+			if tc.expNext {
+				req.StartKey = common.HexToHash(res.NextKey).Bytes()
+				nextRes, err := suite.queryClient.StorageRangeAt(sdk.WrapSDKContext(ctx), req)
+				suite.Require().NoError(err)
+				suite.Require().Len(nextRes.Storage, 1)
+				suite.Require().Equal(res.NextKey, nextRes.Storage[0].Key)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccountRange() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	res, err := suite.queryClient.AccountRange(ctx, &types.QueryAccountRangeRequest{})
+	suite.Require().NoError(err)
+
+	accounts := make(map[string]types.AccountDump)
+	for _, acct := range res.Accounts {
+		accounts[acct.Address] = acct
+	}
+	suite.Require().Contains(accounts, suite.address.Hex())
+	suite.Require().Contains(accounts, contractAddr.Hex())
+
+	contract := accounts[contractAddr.Hex()]
+	suite.Require().Equal(suite.app.EvmKeeper.GetCode(suite.ctx, common.HexToHash(contract.CodeHash)), contract.Code)
+	suite.Require().NotEmpty(contract.Code)
+	suite.Require().NotEmpty(contract.Storage)
+
+	sender := accounts[suite.address.Hex()]
+	suite.Require().Equal(suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address).String(), sender.Balance)
+	suite.Require().Equal(suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address), sender.Nonce)
+	suite.Require().Empty(sender.Code)
+
+	// without code and storage
+	res, err = suite.queryClient.AccountRange(ctx, &types.QueryAccountRangeRequest{NoCode: true, NoStorage: true})
+	suite.Require().NoError(err)
+	for _, acct := range res.Accounts {
+		suite.Require().Empty(acct.Code)
+		suite.Require().Empty(acct.Storage)
+	}
+
+	// paginated
+	res, err = suite.queryClient.AccountRange(ctx, &types.QueryAccountRangeRequest{
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().LessOrEqual(len(res.Accounts), 1)
+	suite.Require().NotEmpty(res.Pagination.NextKey)
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	address := utiltx.GenerateAddress()
 	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
@@ -1368,6 +2123,178 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallStateOverrides() {
+	var (
+		to        common.Address
+		overrides []byte
+	)
+
+	from := utiltx.GenerateAddress()
+	contract := utiltx.GenerateAddress()
+	slot := common.BigToHash(big.NewInt(0))
+	value := common.BigToHash(big.NewInt(42))
+
+	// addresses of the sha256 and ripemd160 precompiled contracts
+	sha256Addr := common.BytesToAddress([]byte{0x02})
+	ripemdAddr := common.BytesToAddress([]byte{0x03})
+	emptyHash := sha256.Sum256(nil)
+
+	// returns the value of the given opcode as a 32 bytes word:
+	// <OPCODE> PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	returnOpCode := func(op vm.OpCode) hexutil.Bytes {
+		return hexutil.Bytes{byte(op), 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	}
+
+	marshal := func(overrides types.StateOverride) []byte {
+		bz, err := json.Marshal(overrides)
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expRet   []byte
+	}{
+		{
+			"fail - invalid overrides",
+			func() {
+				overrides = []byte("invalid overrides")
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - state and stateDiff overrides on the same account",
+			func() {
+				state := map[common.Hash]common.Hash{slot: value}
+				overrides = marshal(types.StateOverride{
+					contract: {State: &state, StateDiff: &state},
+				})
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - move an account that is not a precompile",
+			func() {
+				overrides = marshal(types.StateOverride{
+					contract: {MovePrecompileTo: &from},
+				})
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - move a precompile to another precompile",
+			func() {
+				overrides = marshal(types.StateOverride{
+					sha256Addr: {MovePrecompileTo: &ripemdAddr},
+				})
+			},
+			false,
+			nil,
+		},
+		{
+			"fail - move a precompile to an overridden account",
+			func() {
+				code := returnOpCode(vm.NUMBER)
+				overrides = marshal(types.StateOverride{
+					sha256Addr: {MovePrecompileTo: &contract},
+					contract:   {Code: &code},
+				})
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - no overrides",
+			func() {},
+			true,
+			nil,
+		},
+		{
+			"pass - code and balance overrides",
+			func() {
+				code := returnOpCode(vm.SELFBALANCE)
+				balance := (*hexutil.Big)(big.NewInt(2e18))
+				overrides = marshal(types.StateOverride{
+					contract: {Code: &code, Balance: &balance},
+				})
+			},
+			true,
+			common.BigToHash(big.NewInt(2e18)).Bytes(),
+		},
+		{
+			"pass - code and stateDiff overrides",
+			func() {
+				code := append(hexutil.Bytes{0x60, 0x00}, returnOpCode(vm.SLOAD)...)
+				stateDiff := map[common.Hash]common.Hash{slot: value}
+				overrides = marshal(types.StateOverride{
+					contract: {Code: &code, StateDiff: &stateDiff},
+				})
+			},
+			true,
+			value.Bytes(),
+		},
+		{
+			"pass - move precompile and call it on the new address",
+			func() {
+				overrides = marshal(types.StateOverride{
+					sha256Addr: {MovePrecompileTo: &contract},
+				})
+			},
+			true,
+			emptyHash[:],
+		},
+		{
+			"pass - move precompile and override the original address",
+			func() {
+				code := returnOpCode(vm.NUMBER)
+				overrides = marshal(types.StateOverride{
+					sha256Addr: {MovePrecompileTo: &contract, Code: &code},
+				})
+				to = sha256Addr
+			},
+			true,
+			common.BigToHash(big.NewInt(suite.ctx.BlockHeight())).Bytes(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			to = contract
+			overrides = nil
+
+			tc.malleate()
+
+			args, err := json.Marshal(&types.TransactionArgs{From: &from, To: &to})
+			suite.Require().NoError(err)
+
+			req := &types.EthCallRequest{
+				Args:           args,
+				GasCap:         config.DefaultGasCap,
+				StateOverrides: overrides,
+			}
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expRet, res.Ret)
+
+			// the overrides are not persisted
+			suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contract))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1435,6 +2362,30 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(suite.ctx, nil)
+			},
+		},
+		{
+			"StorageRangeAt method",
+			func() (interface{}, error) {
+				return k.StorageRangeAt(suite.ctx, nil)
+			},
+		},
+		{
+			"AccountRange method",
+			func() (interface{}, error) {
+				return k.AccountRange(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
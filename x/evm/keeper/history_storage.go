@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kato114/byte/v15/x/evm/types"
+)
+
+// SetParentBlockHash stores the hash of the parent block of the current one
+// on the history storage contract. The contract storage is a ring buffer of
+// the last HistoryServeWindow block hashes. Nothing is stored until the
+// contract is deployed at genesis or by an upgrade, so that all the nodes
+// start storing the hashes at the same height.
+func (k *Keeper) SetParentBlockHash(ctx sdk.Context) error {
+	height := ctx.BlockHeight() - 1
+	parentHash := ctx.BlockHeader().LastBlockId.Hash
+	if height < 1 || len(parentHash) == 0 {
+		return nil
+	}
+
+	if !k.GetAccountOrEmpty(ctx, types.HistoryStorageAddress).IsContract() {
+		return nil
+	}
+
+	k.SetState(ctx, types.HistoryStorageAddress, types.HistoryStorageSlot(height), parentHash)
+	return nil
+}
+
+// GetHistoricalBlockHash returns the hash of the given block from the history
+// storage contract. It returns an empty hash if the block is not one of the
+// last HistoryServeWindow ones or if it was not stored.
+func (k *Keeper) GetHistoricalBlockHash(ctx sdk.Context, height int64) common.Hash {
+	if height < 1 || height >= ctx.BlockHeight() || ctx.BlockHeight()-height > types.HistoryServeWindow {
+		return common.Hash{}
+	}
+
+	return k.GetState(ctx, types.HistoryStorageAddress, types.HistoryStorageSlot(height))
+}
+
+// DeployHistoryStorage sets the code of the history storage contract, if not
+// set yet.
+func (k *Keeper) DeployHistoryStorage(ctx sdk.Context) error {
+	acct := k.GetAccountOrEmpty(ctx, types.HistoryStorageAddress)
+	if acct.IsContract() {
+		return nil
+	}
+
+	codeHash := crypto.Keccak256Hash(types.HistoryStorageCode)
+	k.SetCode(ctx, codeHash.Bytes(), types.HistoryStorageCode)
+
+	acct.CodeHash = codeHash.Bytes()
+	if acct.Nonce == 0 {
+		// EIP-161: contracts are created with a nonce of 1
+		acct.Nonce = 1
+	}
+
+	return k.SetAccount(ctx, types.HistoryStorageAddress, acct)
+}
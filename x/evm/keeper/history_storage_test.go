@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// beginBlock runs the EVM BeginBlock at the given height, with the given hash
+// as the one of the parent block.
+func (suite *KeeperTestSuite) beginBlock(height int64, parentHash common.Hash) {
+	header := suite.ctx.BlockHeader()
+	header.Height = height
+	header.LastBlockId = tmproto.BlockID{Hash: parentHash.Bytes()}
+	suite.ctx = suite.ctx.WithBlockHeader(header)
+
+	suite.app.EvmKeeper.BeginBlock(suite.ctx, types.RequestBeginBlock{Header: header})
+}
+
+func (suite *KeeperTestSuite) TestSetParentBlockHash() {
+	suite.SetupTest()
+
+	parentHash := common.BytesToHash(crypto.Keccak256([]byte("parent")))
+
+	// the contract is deployed at genesis
+	acct := suite.app.EvmKeeper.GetAccount(suite.ctx, evmtypes.HistoryStorageAddress)
+	suite.Require().NotNil(acct)
+	suite.Require().True(acct.IsContract())
+	suite.Require().Equal(uint64(1), acct.Nonce)
+	suite.Require().Equal(evmtypes.HistoryStorageCode, suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acct.CodeHash)))
+
+	suite.beginBlock(100, parentHash)
+	suite.Require().Equal(parentHash, suite.app.EvmKeeper.GetState(suite.ctx, evmtypes.HistoryStorageAddress, evmtypes.HistoryStorageSlot(99)))
+	suite.Require().Equal(parentHash, suite.app.EvmKeeper.GetHistoricalBlockHash(suite.ctx, 99))
+
+	// BLOCKHASH doesn't depend on the historical info of the staking module
+	suite.Require().Equal(parentHash, suite.app.EvmKeeper.GetHashFn(suite.ctx)(99))
+
+	// the hashes out of the window are not served, even if their slot is set
+	suite.ctx = suite.ctx.WithBlockHeight(99 + evmtypes.HistoryServeWindow + 1)
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetHistoricalBlockHash(suite.ctx, 99))
+	suite.ctx = suite.ctx.WithBlockHeight(99 + evmtypes.HistoryServeWindow)
+	suite.Require().Equal(parentHash, suite.app.EvmKeeper.GetHistoricalBlockHash(suite.ctx, 99))
+
+	// the ring buffer overwrites the hash of the block one window before
+	newHash := common.BytesToHash(crypto.Keccak256([]byte("new")))
+	suite.beginBlock(100+evmtypes.HistoryServeWindow, newHash)
+	suite.Require().Equal(newHash, suite.app.EvmKeeper.GetHistoricalBlockHash(suite.ctx, 99+evmtypes.HistoryServeWindow))
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetHistoricalBlockHash(suite.ctx, 99))
+}
+
+func (suite *KeeperTestSuite) TestSetParentBlockHashNotDeployed() {
+	suite.SetupTest()
+
+	parentHash := common.BytesToHash(crypto.Keccak256([]byte("parent")))
+	suite.Require().NoError(suite.app.EvmKeeper.DeleteAccount(suite.ctx, evmtypes.HistoryStorageAddress))
+
+	// nothing is stored nor deployed before the upgrade
+	suite.beginBlock(100, parentHash)
+	suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, evmtypes.HistoryStorageAddress))
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, evmtypes.HistoryStorageAddress, evmtypes.HistoryStorageSlot(99)))
+
+	// the hashes are stored from the block after the deployment on
+	suite.Require().NoError(suite.app.EvmKeeper.DeployHistoryStorage(suite.ctx))
+	suite.beginBlock(101, parentHash)
+	suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetHistoricalBlockHash(suite.ctx, 99))
+	suite.Require().Equal(parentHash, suite.app.EvmKeeper.GetHistoricalBlockHash(suite.ctx, 100))
+}
+
+func (suite *KeeperTestSuite) TestHistoryStorageContract() {
+	height := int64(10_000)
+	parentHash := common.BytesToHash(crypto.Keccak256([]byte("parent")))
+
+	testCases := []struct {
+		name    string
+		input   []byte
+		expHash common.Hash
+		expErr  bool
+	}{
+		{"parent block", common.BigToHash(big.NewInt(height - 1)).Bytes(), parentHash, false},
+		{"block not stored", common.BigToHash(big.NewInt(height - 2)).Bytes(), common.Hash{}, false},
+		{"current block", common.BigToHash(big.NewInt(height)).Bytes(), common.Hash{}, true},
+		{"oldest block of the window", common.BigToHash(big.NewInt(height - evmtypes.HistoryServeWindow)).Bytes(), common.Hash{}, false},
+		{"block out of the window", common.BigToHash(big.NewInt(height - evmtypes.HistoryServeWindow - 1)).Bytes(), common.Hash{}, true},
+		{"invalid input", []byte{99}, common.Hash{}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.beginBlock(height, parentHash)
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, &evmtypes.HistoryStorageAddress, nonce, big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), tc.input, nil, true)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, false, config, txConfig)
+			suite.Require().NoError(err)
+
+			if tc.expErr {
+				suite.Require().True(res.Failed())
+				return
+			}
+
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(tc.expHash, common.BytesToHash(res.Ret))
+		})
+	}
+}
@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"math/big"
+	"sync/atomic"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/libs/log"
@@ -63,6 +64,13 @@ type Keeper struct {
 	// Some these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+
+	// precompileFactory instantiates the parameterized precompiles of the
+	// governance-managed precompile registry.
+	precompileFactory PrecompileFactory
+	// registry holds the precompile registry instances loaded at begin block.
+	// It is shared between the copies of the keeper.
+	registry *atomic.Pointer[precompileRegistry]
 }
 
 // NewKeeper generates new evm module keeper
@@ -99,6 +107,7 @@ func NewKeeper(
 		transientKey:    transientKey,
 		tracer:          tracer,
 		ss:              ss,
+		registry:        new(atomic.Pointer[precompileRegistry]),
 	}
 }
 
@@ -131,6 +131,10 @@ func (suite *KeeperTestSuite) TestGetAccountStorage() {
 				}
 
 				addr := ethAccount.EthAddress()
+				if addr == evmtypes.HistoryStorageAddress {
+					// ignore the history storage contract deployed at genesis
+					return false
+				}
 				storage := suite.app.EvmKeeper.GetAccountStorage(suite.ctx, addr)
 
 				suite.Require().Equal(tc.expRes[i], len(storage))
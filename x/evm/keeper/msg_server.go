@@ -17,6 +17,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/kato114/byte/v15/x/evm/types"
 )
@@ -146,3 +147,47 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterPrecompile implements the gRPC MsgServer interface. When a
+// RegisterPrecompile proposal passes, it adds the precompile instance to the
+// registry or replaces the instance registered at the same address. The
+// instance is available to the EVM from the next block on. The registration
+// can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k *Keeper) RegisterPrecompile(goCtx context.Context, req *types.MsgRegisterPrecompile) (*types.MsgRegisterPrecompileResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// instantiate the precompile to check that it can be loaded at begin block
+	if _, err := k.NewRegistryPrecompile(ctx, req.Instance); err != nil {
+		return nil, err
+	}
+
+	k.SetPrecompileInstance(ctx, req.Instance)
+
+	return &types.MsgRegisterPrecompileResponse{}, nil
+}
+
+// RemovePrecompile implements the gRPC MsgServer interface. When a
+// RemovePrecompile proposal passes, it deletes the precompile instance from
+// the registry. The removal can only be performed if the requested authority
+// is the Cosmos SDK governance module account.
+func (k *Keeper) RemovePrecompile(goCtx context.Context, req *types.MsgRemovePrecompile) (*types.MsgRemovePrecompileResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address := common.HexToAddress(req.Address)
+
+	if _, found := k.GetPrecompileInstance(ctx, address); !found {
+		return nil, errorsmod.Wrapf(types.ErrPrecompileNotFound, "address %s", req.Address)
+	}
+
+	k.DeletePrecompileInstance(ctx, address)
+
+	return &types.MsgRemovePrecompileResponse{}, nil
+}
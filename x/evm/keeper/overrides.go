@@ -0,0 +1,168 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kato114/byte/v15/x/evm/statedb"
+	"github.com/kato114/byte/v15/x/evm/types"
+)
+
+// ApplyStateOverrides writes the given account overrides to the state of the
+// provided context and records the moved precompiles on the EVM config. It must
+// only be called with a branched context (e.g. the one used by gRPC queries)
+// since the changes are written to the store.
+func (k *Keeper) ApplyStateOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+
+	moved := overrides.MovedPrecompiles()
+	if len(moved) > 0 {
+		active := make(map[common.Address]bool)
+		for _, addr := range vm.PrecompiledAddressesBerlin {
+			active[addr] = true
+		}
+		for _, addr := range cfg.Params.GetActivePrecompilesAddrs() {
+			active[addr] = true
+		}
+
+		for src, dst := range moved {
+			if !active[src] {
+				return fmt.Errorf("account %s is not an active precompile", src.Hex())
+			}
+			if active[dst] {
+				return fmt.Errorf("account %s is already a precompile", dst.Hex())
+			}
+		}
+
+		cfg.MovedPrecompiles = moved
+	}
+
+	for addr, override := range overrides {
+		account := k.GetAccountOrEmpty(ctx, addr)
+
+		if override.Nonce != nil {
+			account.Nonce = uint64(*override.Nonce)
+		}
+
+		if override.Code != nil {
+			code := []byte(*override.Code)
+			codeHash := crypto.Keccak256Hash(code)
+			k.SetCode(ctx, codeHash.Bytes(), code)
+			account.CodeHash = codeHash.Bytes()
+		}
+
+		if override.Balance != nil && *override.Balance != nil {
+			account.Balance = (*override.Balance).ToInt()
+		}
+
+		if err := k.SetAccount(ctx, addr, account); err != nil {
+			return errorsmod.Wrapf(err, "failed to override account %s", addr.Hex())
+		}
+
+		// replace the entire storage of the account
+		if override.State != nil {
+			var keys []common.Hash
+			k.ForEachStorage(ctx, addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.SetState(ctx, addr, key, nil)
+			}
+			for key, value := range *override.State {
+				k.SetState(ctx, addr, key, value.Bytes())
+			}
+		}
+
+		// apply the storage slots on top of the existing storage
+		if override.StateDiff != nil {
+			for key, value := range *override.StateDiff {
+				k.SetState(ctx, addr, key, value.Bytes())
+			}
+		}
+	}
+
+	return nil
+}
+
+// contextWithStateOverrides returns a branch of the given context with the JSON
+// encoded state overrides applied to it, so that they are discarded once the
+// call completes. The context is returned unchanged if there are no overrides.
+func (k *Keeper) contextWithStateOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, bz []byte) (sdk.Context, error) {
+	if len(bz) == 0 {
+		return ctx, nil
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return ctx, errorsmod.Wrap(err, "invalid state overrides")
+	}
+
+	ctx, _ = ctx.CacheContext()
+	if err := k.ApplyStateOverrides(ctx, cfg, overrides); err != nil {
+		return ctx, err
+	}
+
+	return ctx, nil
+}
+
+// movePrecompiles moves the precompiled contracts of the given map to the
+// addresses defined on moved and returns the updated list of active
+// precompile addresses.
+func movePrecompiles(
+	precompiles map[common.Address]vm.PrecompiledContract,
+	active []common.Address,
+	moved map[common.Address]common.Address,
+) []common.Address {
+	if len(moved) == 0 {
+		return active
+	}
+
+	updated := make([]common.Address, 0, len(active))
+	for _, addr := range active {
+		dst, ok := moved[addr]
+		if !ok {
+			updated = append(updated, addr)
+			continue
+		}
+
+		precompiles[dst] = precompiles[addr]
+		delete(precompiles, addr)
+		updated = append(updated, dst)
+	}
+
+	return updated
+}
+
+// applyBlockOverrides returns the context and EVM config updated with the
+// given block header overrides.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides types.BlockOverrides) (sdk.Context, error) {
+	if err := overrides.Validate(); err != nil {
+		return ctx, err
+	}
+
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC()) // #nosec G701 -- checked for int overflow on Validate
+	}
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+
+	return ctx, nil
+}
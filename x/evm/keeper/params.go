@@ -5,9 +5,11 @@ package keeper
 import (
 	"sort"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/kato114/byte/v15/x/evm/types"
+	"golang.org/x/exp/slices"
 )
 
 // GetParams returns the total set of evm parameters.
@@ -27,6 +29,20 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	// the precompiles of the registry are enabled and disabled by their instance
+	var err error
+	activePrecompiles := params.GetActivePrecompilesAddrs()
+	k.IteratePrecompileInstances(ctx, func(instance types.PrecompileInstance) bool {
+		if slices.Contains(activePrecompiles, instance.GetAddressHex()) {
+			err = errorsmod.Wrapf(types.ErrInvalidPrecompileInstance, "precompile %s is managed by the precompile registry", instance.Address)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
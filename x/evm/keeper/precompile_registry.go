@@ -0,0 +1,210 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"golang.org/x/exp/slices"
+
+	"github.com/kato114/byte/v15/x/evm/types"
+)
+
+// PrecompileFactory instantiates the precompiled contract of a precompile
+// registry instance.
+type PrecompileFactory func(ctx sdk.Context, instance types.PrecompileInstance) (vm.PrecompiledContract, error)
+
+// precompileRegistry is the snapshot of the precompile registry that is used
+// by the EVM until the next begin block.
+type precompileRegistry struct {
+	// height is the block height the registry was loaded at
+	height int64
+	// instances are the registered instances, both enabled and disabled
+	instances map[common.Address]types.PrecompileInstance
+	// contracts are the precompiled contracts of the enabled instances
+	contracts map[common.Address]vm.PrecompiledContract
+	// enabled are the addresses of the enabled instances
+	enabled []common.Address
+	// disabled are the addresses of the disabled instances
+	disabled []common.Address
+}
+
+// WithPrecompileFactory sets the factory of the parameterized precompiles of
+// the precompile registry.
+func (k *Keeper) WithPrecompileFactory(factory PrecompileFactory) *Keeper {
+	if k.precompileFactory != nil {
+		panic("precompile factory already set")
+	}
+
+	k.precompileFactory = factory
+	return k
+}
+
+// GetPrecompileInstance returns the precompile registry instance at the given
+// address.
+func (k Keeper) GetPrecompileInstance(ctx sdk.Context, address common.Address) (types.PrecompileInstance, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrecompileInstance)
+	bz := store.Get(address.Bytes())
+	if len(bz) == 0 {
+		return types.PrecompileInstance{}, false
+	}
+
+	var instance types.PrecompileInstance
+	k.cdc.MustUnmarshal(bz, &instance)
+	return instance, true
+}
+
+// SetPrecompileInstance stores the given precompile registry instance,
+// replacing the instance registered at the same address (if any).
+func (k Keeper) SetPrecompileInstance(ctx sdk.Context, instance types.PrecompileInstance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrecompileInstance)
+	bz := k.cdc.MustMarshal(&instance)
+	store.Set(instance.GetAddressHex().Bytes(), bz)
+}
+
+// DeletePrecompileInstance removes the precompile registry instance at the
+// given address.
+func (k Keeper) DeletePrecompileInstance(ctx sdk.Context, address common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPrecompileInstance)
+	store.Delete(address.Bytes())
+}
+
+// IteratePrecompileInstances iterates over the precompile registry instances,
+// callback returns true to break early.
+func (k Keeper) IteratePrecompileInstances(ctx sdk.Context, cb func(instance types.PrecompileInstance) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixPrecompileInstance)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var instance types.PrecompileInstance
+		k.cdc.MustUnmarshal(iterator.Value(), &instance)
+
+		if cb(instance) {
+			break
+		}
+	}
+}
+
+// GetPrecompileInstances returns all the precompile registry instances.
+func (k Keeper) GetPrecompileInstances(ctx sdk.Context) []types.PrecompileInstance {
+	var instances []types.PrecompileInstance
+	k.IteratePrecompileInstances(ctx, func(instance types.PrecompileInstance) bool {
+		instances = append(instances, instance)
+		return false
+	})
+	return instances
+}
+
+// NewRegistryPrecompile instantiates the precompiled contract of the given
+// precompile registry instance.
+func (k Keeper) NewRegistryPrecompile(ctx sdk.Context, instance types.PrecompileInstance) (vm.PrecompiledContract, error) {
+	if k.precompileFactory == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPrecompileInstance, "precompile factory not set")
+	}
+
+	if err := instance.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPrecompileInstance, err.Error())
+	}
+
+	if _, ok := k.precompiles[instance.GetAddressHex()]; ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInstance, "address %s is reserved by a static precompile", instance.Address)
+	}
+
+	if slices.Contains(k.GetParams(ctx).GetActivePrecompilesAddrs(), instance.GetAddressHex()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInstance, "address %s is an active precompile of the params", instance.Address)
+	}
+
+	// the precompile would shadow the contract deployed at its address
+	if account := k.GetAccount(ctx, instance.GetAddressHex()); account != nil && account.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPrecompileInstance, "address %s has contract code", instance.Address)
+	}
+
+	precompile, err := k.precompileFactory(ctx, instance)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPrecompileInstance, err.Error())
+	}
+
+	if precompile.Address() != instance.GetAddressHex() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidPrecompileInstance,
+			"precompile address mismatch, expected %s, got %s", instance.Address, precompile.Address(),
+		)
+	}
+
+	return precompile, nil
+}
+
+// LoadPrecompileRegistry instantiates the enabled precompile registry
+// instances and makes them available to the EVM for the block.
+func (k Keeper) LoadPrecompileRegistry(ctx sdk.Context) {
+	k.registry.Store(k.buildPrecompileRegistry(ctx, k.registry.Load()))
+}
+
+// precompileRegistry returns the precompile registry of the block height of
+// the context: the one loaded at begin block, or for the contexts at other
+// heights (e.g. queries), the registry built from the state at their height
+// only. The registry loaded at begin block is shared by all the keeper copies,
+// so it's never used at a height other than its own.
+func (k Keeper) precompileRegistry(ctx sdk.Context) *precompileRegistry {
+	registry := k.registry.Load()
+	if registry != nil && registry.height == ctx.BlockHeight() {
+		return registry
+	}
+	return k.buildPrecompileRegistry(ctx, nil)
+}
+
+// buildPrecompileRegistry instantiates the enabled precompile registry
+// instances of the state. The contracts of the instances that didn't change
+// since the previous registry are reused, except the ERC20 ones, which depend
+// on the token pair state besides the instance. Instances that fail to
+// instantiate are logged and treated as disabled, so that the chain isn't
+// halted if the state they depend on (e.g. an ERC20 token pair) is removed.
+func (k Keeper) buildPrecompileRegistry(ctx sdk.Context, previous *precompileRegistry) *precompileRegistry {
+	registry := &precompileRegistry{
+		height:    ctx.BlockHeight(),
+		instances: make(map[common.Address]types.PrecompileInstance),
+		contracts: make(map[common.Address]vm.PrecompiledContract),
+	}
+
+	k.IteratePrecompileInstances(ctx, func(instance types.PrecompileInstance) bool {
+		address := instance.GetAddressHex()
+		registry.instances[address] = instance
+
+		if !instance.Enabled {
+			registry.disabled = append(registry.disabled, address)
+			return false
+		}
+
+		if previous != nil && previous.instances[address] == instance && instance.Type != types.PrecompileTypeERC20 {
+			if precompile, ok := previous.contracts[address]; ok {
+				registry.contracts[address] = precompile
+				registry.enabled = append(registry.enabled, address)
+				return false
+			}
+		}
+
+		precompile, err := k.NewRegistryPrecompile(ctx, instance)
+		if err != nil {
+			k.Logger(ctx).Error("failed to load registered precompile", "address", instance.Address, "error", err.Error())
+			registry.disabled = append(registry.disabled, address)
+			return false
+		}
+
+		registry.contracts[address] = precompile
+		registry.enabled = append(registry.enabled, address)
+		return false
+	})
+
+	return registry
+}
+
+// RegisteredPrecompiles returns the addresses of the enabled and the disabled
+// precompile registry instances of the block height of the context. Enabled
+// instances that failed to load are returned as disabled.
+func (k Keeper) RegisteredPrecompiles(ctx sdk.Context) (enabled, disabled []common.Address) {
+	registry := k.precompileRegistry(ctx)
+	return registry.enabled, registry.disabled
+}
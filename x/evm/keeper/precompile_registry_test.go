@@ -0,0 +1,267 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/cometbft/cometbft/abci/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	erc20types "github.com/kato114/byte/v15/x/erc20/types"
+	"github.com/kato114/byte/v15/x/evm/statedb"
+	evmtypes "github.com/kato114/byte/v15/x/evm/types"
+)
+
+// strideOutpostInstance returns an instance of the Stride outpost at the given
+// address.
+func strideOutpostInstance(address common.Address, enabled bool) evmtypes.PrecompileInstance {
+	instance := evmtypes.NewPrecompileInstance(address, evmtypes.PrecompileTypeStrideOutpost, enabled)
+	instance.PortID = "transfer"
+	instance.ChannelID = "channel-7"
+	return instance
+}
+
+func (suite *KeeperTestSuite) TestRegisterPrecompile() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	address := common.HexToAddress("0x0000000000000000000000000000000000000a00")
+
+	testCases := []struct {
+		name      string
+		request   *evmtypes.MsgRegisterPrecompile
+		withCode  bool
+		expectErr bool
+	}{
+		{
+			"fail - invalid authority",
+			&evmtypes.MsgRegisterPrecompile{Authority: "foobar", Instance: strideOutpostInstance(address, true)},
+			false,
+			true,
+		},
+		{
+			"fail - invalid instance",
+			&evmtypes.MsgRegisterPrecompile{Authority: authority, Instance: evmtypes.NewPrecompileInstance(address, evmtypes.PrecompileTypeStrideOutpost, true)},
+			false,
+			true,
+		},
+		{
+			"fail - ERC20 precompile without token pair",
+			&evmtypes.MsgRegisterPrecompile{Authority: authority, Instance: evmtypes.NewPrecompileInstance(address, evmtypes.PrecompileTypeERC20, true)},
+			false,
+			true,
+		},
+		{
+			"fail - address with contract code",
+			&evmtypes.MsgRegisterPrecompile{Authority: authority, Instance: strideOutpostInstance(address, true)},
+			true,
+			true,
+		},
+		{
+			"pass - enabled stride outpost",
+			&evmtypes.MsgRegisterPrecompile{Authority: authority, Instance: strideOutpostInstance(address, true)},
+			false,
+			false,
+		},
+		{
+			"pass - disabled stride outpost",
+			&evmtypes.MsgRegisterPrecompile{Authority: authority, Instance: strideOutpostInstance(address, false)},
+			false,
+			false,
+		},
+		{
+			"pass - stride outpost of the default genesis updated",
+			&evmtypes.MsgRegisterPrecompile{Authority: authority, Instance: strideOutpostInstance(common.HexToAddress(evmtypes.StrideOutpostAddress), true)},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if tc.withCode {
+				code := []byte{0x60, 0x00}
+				codeHash := crypto.Keccak256(code)
+				suite.app.EvmKeeper.SetCode(suite.ctx, codeHash, code)
+				suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, address, statedb.Account{
+					Balance:  big.NewInt(0),
+					CodeHash: codeHash,
+				}))
+			}
+
+			_, err := suite.app.EvmKeeper.RegisterPrecompile(suite.ctx, tc.request)
+			instance, found := suite.app.EvmKeeper.GetPrecompileInstance(suite.ctx, tc.request.Instance.GetAddressHex())
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.request.Instance, instance)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemovePrecompile() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	address := common.HexToAddress("0x0000000000000000000000000000000000000a00")
+
+	_, err := suite.app.EvmKeeper.RemovePrecompile(suite.ctx, &evmtypes.MsgRemovePrecompile{Authority: authority, Address: address.Hex()})
+	suite.Require().ErrorIs(err, evmtypes.ErrPrecompileNotFound)
+
+	suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, strideOutpostInstance(address, true))
+
+	_, err = suite.app.EvmKeeper.RemovePrecompile(suite.ctx, &evmtypes.MsgRemovePrecompile{Authority: "foobar", Address: address.Hex()})
+	suite.Require().Error(err)
+
+	_, err = suite.app.EvmKeeper.RemovePrecompile(suite.ctx, &evmtypes.MsgRemovePrecompile{Authority: authority, Address: address.Hex()})
+	suite.Require().NoError(err)
+
+	_, found := suite.app.EvmKeeper.GetPrecompileInstance(suite.ctx, address)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestLoadPrecompileRegistry() {
+	enabled := common.HexToAddress("0x0000000000000000000000000000000000000a00")
+	disabled := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	broken := common.HexToAddress("0x0000000000000000000000000000000000000a02")
+
+	suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, strideOutpostInstance(enabled, true))
+	suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, strideOutpostInstance(disabled, false))
+	// the token pair of the ERC20 precompile doesn't exist
+	suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, evmtypes.NewPrecompileInstance(broken, evmtypes.PrecompileTypeERC20, true))
+
+	suite.app.EvmKeeper.BeginBlock(suite.ctx, types.RequestBeginBlock{})
+
+	// the Stride outpost is registered in the default genesis
+	strideOutpost := common.HexToAddress(evmtypes.StrideOutpostAddress)
+	enabledAddrs, disabledAddrs := suite.app.EvmKeeper.RegisteredPrecompiles(suite.ctx)
+	suite.Require().Equal([]common.Address{strideOutpost, enabled}, enabledAddrs)
+	suite.Require().Equal([]common.Address{disabled, broken}, disabledAddrs)
+
+	precompiles := suite.app.EvmKeeper.Precompiles(enabled)
+	suite.Require().Equal(enabled, precompiles[enabled].Address())
+	// the addresses of the instances not loaded are skipped
+	suite.Require().Empty(suite.app.EvmKeeper.Precompiles(disabled, broken))
+
+	// the contracts of the unchanged instances are reused
+	suite.app.EvmKeeper.LoadPrecompileRegistry(suite.ctx)
+	suite.Require().Same(precompiles[enabled], suite.app.EvmKeeper.Precompiles(enabled)[enabled])
+
+	// the registry is kept until the next begin block, while the queries at
+	// other heights use the registry of their state
+	suite.app.EvmKeeper.DeletePrecompileInstance(suite.ctx, enabled)
+	enabledAddrs, _ = suite.app.EvmKeeper.RegisteredPrecompiles(suite.ctx)
+	suite.Require().Equal([]common.Address{strideOutpost, enabled}, enabledAddrs)
+	enabledAddrs, _ = suite.app.EvmKeeper.RegisteredPrecompiles(suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() - 1))
+	suite.Require().Equal([]common.Address{strideOutpost}, enabledAddrs)
+
+	// the removed instances are unloaded
+	suite.app.EvmKeeper.LoadPrecompileRegistry(suite.ctx)
+	enabledAddrs, _ = suite.app.EvmKeeper.RegisteredPrecompiles(suite.ctx)
+	suite.Require().Equal([]common.Address{strideOutpost}, enabledAddrs)
+}
+
+func (suite *KeeperTestSuite) TestLoadPrecompileRegistryERC20() {
+	address := common.HexToAddress("0x0000000000000000000000000000000000000a00")
+
+	tokenPair := erc20types.NewTokenPair(address, "xmpl", erc20types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, tokenPair)
+	suite.app.Erc20Keeper.SetERC20Map(suite.ctx, address, tokenPair.GetID())
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, tokenPair.Denom, tokenPair.GetID())
+	suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, evmtypes.NewPrecompileInstance(address, evmtypes.PrecompileTypeERC20, true))
+
+	suite.app.EvmKeeper.LoadPrecompileRegistry(suite.ctx)
+	precompile := suite.app.EvmKeeper.Precompiles(address)[address]
+	suite.Require().NotNil(precompile)
+
+	// the ERC20 precompiles are rebuilt, as their token pair may have changed
+	suite.app.EvmKeeper.LoadPrecompileRegistry(suite.ctx)
+	suite.Require().NotSame(precompile, suite.app.EvmKeeper.Precompiles(address)[address])
+}
+
+func (suite *KeeperTestSuite) TestPrecompileRegistryActivePrecompiles() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	address := common.HexToAddress("0x0000000000000000000000000000000000000a00")
+
+	// the addresses of the registry can't be set as active precompiles
+	suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, strideOutpostInstance(address, false))
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.ActivePrecompiles = append(params.ActivePrecompiles, address.Hex())
+	suite.Require().ErrorIs(suite.app.EvmKeeper.SetParams(suite.ctx, params), evmtypes.ErrInvalidPrecompileInstance)
+
+	// and the active precompiles can't be registered
+	suite.app.EvmKeeper.DeletePrecompileInstance(suite.ctx, address)
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	_, err := suite.app.EvmKeeper.RegisterPrecompile(suite.ctx, &evmtypes.MsgRegisterPrecompile{Authority: authority, Instance: strideOutpostInstance(address, true)})
+	suite.Require().ErrorIs(err, evmtypes.ErrInvalidPrecompileInstance)
+
+	// an active precompile without a loaded contract is skipped by the EVM
+	suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, strideOutpostInstance(address, false))
+	suite.app.EvmKeeper.LoadPrecompileRegistry(suite.ctx)
+
+	proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+	config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+	suite.Require().NoError(err)
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000b00")
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := ethtypes.NewMessage(suite.address, &to, nonce, big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+	txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+	res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageWithConfigRegisteredPrecompile() {
+	enabled := common.HexToAddress("0x0000000000000000000000000000000000000a00")
+	disabled := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	// unknown method of the outpost
+	data := []byte{0x00, 0x00, 0x00, 0x00}
+
+	testCases := []struct {
+		name     string
+		to       common.Address
+		register bool
+		expErr   error
+		expFail  bool
+	}{
+		{"unregistered address is an empty account", enabled, false, nil, false},
+		{"enabled instance runs the precompile", enabled, true, nil, true},
+		{"disabled instance", disabled, true, evmtypes.ErrInactivePrecompile, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if tc.register {
+				suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, strideOutpostInstance(enabled, true))
+				suite.app.EvmKeeper.SetPrecompileInstance(suite.ctx, strideOutpostInstance(disabled, false))
+				suite.app.EvmKeeper.LoadPrecompileRegistry(suite.ctx)
+			}
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, &tc.to, nonce, big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFail, res.Failed(), res.VmError)
+		})
+	}
+}
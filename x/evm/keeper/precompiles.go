@@ -11,18 +11,22 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
-	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
 	distprecompile "github.com/kato114/byte/v15/precompiles/distribution"
+	erc20precompile "github.com/kato114/byte/v15/precompiles/erc20"
 	ics20precompile "github.com/kato114/byte/v15/precompiles/ics20"
+	osmosisoutpost "github.com/kato114/byte/v15/precompiles/outposts/osmosis"
 	strideoutpost "github.com/kato114/byte/v15/precompiles/outposts/stride"
 	"github.com/kato114/byte/v15/precompiles/p256"
 	stakingprecompile "github.com/kato114/byte/v15/precompiles/staking"
 	vestingprecompile "github.com/kato114/byte/v15/precompiles/vesting"
 	erc20Keeper "github.com/kato114/byte/v15/x/erc20/keeper"
+	"github.com/kato114/byte/v15/x/evm/types"
 	transferkeeper "github.com/kato114/byte/v15/x/ibc/transfer/keeper"
 	vestingkeeper "github.com/kato114/byte/v15/x/vesting/keeper"
 )
@@ -32,7 +36,6 @@ import (
 func AvailablePrecompiles(
 	stakingKeeper stakingkeeper.Keeper,
 	distributionKeeper distributionkeeper.Keeper,
-	erc20Keeper erc20Keeper.Keeper,
 	vestingKeeper vestingkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
@@ -64,20 +67,59 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to load vesting precompile: %w", err))
 	}
 
-	strideOutpost, err := strideoutpost.NewPrecompile(transfertypes.PortID, "channel-25", transferKeeper, erc20Keeper, authzKeeper, stakingKeeper)
-	if err != nil {
-		panic(fmt.Errorf("failed to load stride outpost: %w", err))
-	}
-
 	precompiles[p256Precompile.Address()] = p256Precompile
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
 	precompiles[distributionPrecompile.Address()] = distributionPrecompile
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
-	precompiles[strideOutpost.Address()] = strideOutpost
 	return precompiles
 }
 
+// NewPrecompileFactory returns the factory of the parameterized precompiles of
+// the precompile registry.
+func NewPrecompileFactory(
+	stakingKeeper stakingkeeper.Keeper,
+	erc20Keeper erc20Keeper.Keeper,
+	authzKeeper authzkeeper.Keeper,
+	transferKeeper transferkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+) PrecompileFactory {
+	return func(ctx sdk.Context, instance types.PrecompileInstance) (vm.PrecompiledContract, error) {
+		address := instance.GetAddressHex()
+
+		switch instance.Type {
+		case types.PrecompileTypeStrideOutpost:
+			precompile, err := strideoutpost.NewPrecompile(instance.PortID, instance.ChannelID, transferKeeper, erc20Keeper, authzKeeper, stakingKeeper)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load stride outpost: %w", err)
+			}
+			return precompile.WithAddress(address), nil
+		case types.PrecompileTypeOsmosisOutpost:
+			precompile, err := osmosisoutpost.NewPrecompile(
+				instance.PortID, instance.ChannelID, instance.OsmosisXCSContract,
+				bankKeeper, transferKeeper, stakingKeeper, erc20Keeper, authzKeeper,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load osmosis outpost: %w", err)
+			}
+			return precompile.WithAddress(address), nil
+		case types.PrecompileTypeERC20:
+			tokenPair, found := erc20Keeper.GetTokenPair(ctx, erc20Keeper.GetTokenPairID(ctx, address.String()))
+			if !found {
+				return nil, fmt.Errorf("token pair not found for ERC20 precompile %s", address)
+			}
+
+			precompile, err := erc20precompile.NewPrecompile(tokenPair, bankKeeper, authzKeeper, transferKeeper)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load ERC20 precompile: %w", err)
+			}
+			return precompile, nil
+		default:
+			return nil, fmt.Errorf("invalid precompile type %d", instance.Type)
+		}
+	}
+}
+
 // WithPrecompiles sets the available precompiled contracts.
 func (k *Keeper) WithPrecompiles(precompiles map[common.Address]vm.PrecompiledContract) *Keeper {
 	if k.precompiles != nil {
@@ -93,16 +135,32 @@ func (k *Keeper) WithPrecompiles(precompiles map[common.Address]vm.PrecompiledCo
 }
 
 // Precompiles returns the subset of the available precompiled contracts that
-// are active given the current parameters.
+// are active given the current parameters. The enabled instances of the
+// precompile registry loaded at begin block are available besides the static
+// precompiles.
 func (k Keeper) Precompiles(
 	activePrecompiles ...common.Address,
+) map[common.Address]vm.PrecompiledContract {
+	return k.precompilesOf(k.registry.Load(), activePrecompiles...)
+}
+
+// precompilesOf returns the subset of the available precompiled contracts
+// that are active, with the enabled instances of the given precompile
+// registry. The active addresses without a precompiled contract (e.g. of a
+// registry instance that is disabled or failed to load) are skipped.
+func (k Keeper) precompilesOf(
+	registry *precompileRegistry,
+	activePrecompiles ...common.Address,
 ) map[common.Address]vm.PrecompiledContract {
 	activePrecompileMap := make(map[common.Address]vm.PrecompiledContract)
 
 	for _, address := range activePrecompiles {
 		precompile, ok := k.precompiles[address]
+		if !ok && registry != nil {
+			precompile, ok = registry.contracts[address]
+		}
 		if !ok {
-			panic(fmt.Sprintf("precompiled contract not initialized: %s", address))
+			continue
 		}
 
 		activePrecompileMap[address] = precompile
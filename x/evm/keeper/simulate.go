@@ -0,0 +1,295 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package keeper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmostypes "github.com/kato114/byte/v15/types"
+	"github.com/kato114/byte/v15/x/evm/statedb"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	"github.com/kato114/byte/v15/x/evm/types"
+)
+
+// simulate executes the simulated blocks on top of the state of the given
+// context. All the calls are executed on a single branch of the context, so
+// that the state changes are carried between the calls and blocks and
+// discarded once the simulation completes. The gas cap bounds the gas used by
+// all the calls, as go-ethereum does, and not only by each call.
+func (k *Keeper) simulate(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	opts types.SimulateOpts,
+	gasCap uint64,
+) ([]*types.SimBlockResult, error) {
+	ctx, _ = ctx.CacheContext()
+
+	gasLimit := evmostypes.BlockGasLimit(ctx)
+	if gasLimit == 0 {
+		gasLimit = gasCap
+	}
+	if gasLimit == 0 {
+		gasLimit = math.MaxUint64 / 2
+	}
+
+	budget := gasCap
+	if budget == 0 {
+		budget = math.MaxUint64
+	}
+
+	var (
+		parentHash = common.BytesToHash(ctx.HeaderHash())
+		number     = uint64(ctx.BlockHeight()) // #nosec G701 -- block height is always positive
+		timestamp  = uint64(ctx.BlockTime().Unix())
+		results    = make([]*types.SimBlockResult, 0, len(opts.BlockStateCalls))
+	)
+
+	for i, block := range opts.BlockStateCalls {
+		// copy the config so that the overrides only apply to the current block
+		blockCfg := *cfg
+		blockCfg.MovedPrecompiles = nil
+
+		// the calls are executed without base fee unless it is validated
+		if !opts.Validation && blockCfg.BaseFee != nil {
+			blockCfg.BaseFee = new(big.Int)
+		}
+
+		var blockOverrides types.BlockOverrides
+		if block.BlockOverrides != nil {
+			blockOverrides = *block.BlockOverrides
+		}
+		if blockOverrides.Number == nil {
+			blockOverrides.Number = (*hexutil.Big)(new(big.Int).SetUint64(number + 1))
+		}
+		if blockOverrides.Time == nil {
+			blockTime := hexutil.Uint64(timestamp + types.SimulateTimestampIncrement)
+			blockOverrides.Time = &blockTime
+		}
+
+		if n := blockOverrides.Number.ToInt(); !n.IsUint64() || n.Uint64() <= number {
+			return nil, fmt.Errorf("block %d: block numbers must be in order: %s <= %d", i, n, number)
+		}
+		if t := uint64(*blockOverrides.Time); t <= timestamp {
+			return nil, fmt.Errorf("block %d: block timestamps must be in order: %d <= %d", i, t, timestamp)
+		}
+
+		blockCtx, err := applyBlockOverrides(ctx, &blockCfg, blockOverrides)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+		number = blockOverrides.Number.ToInt().Uint64()
+		timestamp = uint64(*blockOverrides.Time)
+
+		if block.StateOverrides != nil {
+			if err := k.ApplyStateOverrides(blockCtx, &blockCfg, *block.StateOverrides); err != nil {
+				return nil, fmt.Errorf("block %d: %w", i, err)
+			}
+		}
+
+		result, err := k.simulateBlock(blockCtx, &blockCfg, block.Calls, opts, gasCap, gasLimit, &budget)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", i, err)
+		}
+
+		header := &ethtypes.Header{
+			ParentHash: parentHash,
+			Coinbase:   blockCfg.CoinBase,
+			Number:     new(big.Int).SetUint64(number),
+			GasLimit:   gasLimit,
+			GasUsed:    uint64(result.GasUsed),
+			Time:       timestamp,
+			BaseFee:    blockCfg.BaseFee,
+		}
+
+		result.Number = hexutil.Uint64(number)
+		result.Hash = header.Hash()
+		result.ParentHash = parentHash
+		result.Timestamp = hexutil.Uint64(timestamp)
+		result.GasLimit = hexutil.Uint64(gasLimit)
+		result.Miner = blockCfg.CoinBase
+		result.BaseFeePerGas = (*hexutil.Big)(blockCfg.BaseFee)
+
+		for _, call := range result.Calls {
+			for _, log := range call.Logs {
+				log.BlockHash = result.Hash
+			}
+		}
+
+		results = append(results, result)
+		parentHash = result.Hash
+	}
+
+	return results, nil
+}
+
+// simulateBlock executes the calls of a simulated block in order and returns
+// their results. The gas used by the calls is deducted from the gas budget of
+// the simulation. The block header fields are set by the caller.
+func (k *Keeper) simulateBlock(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	calls []types.TransactionArgs,
+	opts types.SimulateOpts,
+	gasCap, gasLimit uint64,
+	budget *uint64,
+) (*types.SimBlockResult, error) {
+	var (
+		gasUsed  uint64
+		logIndex uint
+		results  = make([]types.SimCallResult, 0, len(calls))
+	)
+
+	for i, args := range calls {
+		// stop once the request is canceled or timed out
+		if err := ctx.Context().Err(); err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		if args.Nonce == nil {
+			nonce := k.GetNonce(ctx, args.GetFrom())
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		}
+
+		// calls without gas limit use the gas left on the block
+		if args.Gas == nil {
+			remaining := gasLimit - gasUsed
+			if remaining > *budget {
+				remaining = *budget
+			}
+			args.Gas = (*hexutil.Uint64)(&remaining)
+		}
+
+		msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		if msg.Gas() > gasLimit-gasUsed {
+			return nil, fmt.Errorf("call %d: %w", i, core.ErrGasLimitReached)
+		}
+		if msg.Gas() > *budget {
+			return nil, fmt.Errorf("call %d: gas cap of the simulation exceeded: %d > %d", i, msg.Gas(), *budget)
+		}
+
+		if opts.Validation {
+			if err := k.validateSimulatedMsg(ctx, cfg, msg); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+		}
+
+		txConfig := statedb.NewEmptyTxConfig(common.Hash{})
+		txConfig.TxHash = args.ToTransaction().AsTransaction().Hash()
+		txConfig.TxIndex = uint(i)
+		txConfig.LogIndex = logIndex
+
+		var (
+			tracer    vm.EVMLogger
+			transfers *evmtracers.TransferTracer
+		)
+		if opts.TraceTransfers {
+			transfers = evmtracers.NewTransferTracer()
+			tracer = transfers
+		}
+
+		// commit the state changes so that they are visible to the next calls
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, true, cfg, txConfig)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		// the nonce is increased by the ante handler on regular transactions
+		account := k.GetAccountOrEmpty(ctx, msg.From())
+		account.Nonce = msg.Nonce() + 1
+		if err := k.SetAccount(ctx, msg.From(), account); err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		logs := types.LogsToEthereum(res.Logs)
+		if transfers != nil {
+			logs = transfers.Logs(logs)
+		}
+		if logs == nil {
+			logs = []*ethtypes.Log{}
+		}
+
+		for j, log := range logs {
+			log.BlockNumber = uint64(ctx.BlockHeight()) // #nosec G701 -- block height is always positive
+			log.TxHash = txConfig.TxHash
+			log.TxIndex = txConfig.TxIndex
+			log.Index = logIndex + uint(j)
+		}
+		logIndex += uint(len(logs))
+		gasUsed += res.GasUsed
+		*budget -= res.GasUsed
+
+		results = append(results, newSimCallResult(res, logs))
+	}
+
+	return &types.SimBlockResult{
+		GasUsed: hexutil.Uint64(gasUsed),
+		Calls:   results,
+	}, nil
+}
+
+// validateSimulatedMsg performs the nonce, fee and balance checks that the
+// ante handler performs on regular transactions.
+func (k *Keeper) validateSimulatedMsg(ctx sdk.Context, cfg *statedb.EVMConfig, msg core.Message) error {
+	nonce := k.GetNonce(ctx, msg.From())
+	switch {
+	case msg.Nonce() < nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooLow, msg.From().Hex(), msg.Nonce(), nonce)
+	case msg.Nonce() > nonce:
+		return fmt.Errorf("%w: address %s, tx: %d state: %d", core.ErrNonceTooHigh, msg.From().Hex(), msg.Nonce(), nonce)
+	}
+
+	if cfg.BaseFee != nil && msg.GasFeeCap().Cmp(cfg.BaseFee) < 0 {
+		return fmt.Errorf("%w: address %s, maxFeePerGas: %s baseFee: %s", core.ErrFeeCapTooLow, msg.From().Hex(), msg.GasFeeCap(), cfg.BaseFee)
+	}
+
+	cost := new(big.Int).Mul(msg.GasFeeCap(), new(big.Int).SetUint64(msg.Gas()))
+	cost.Add(cost, msg.Value())
+	if balance := k.GetBalance(ctx, msg.From()); balance.Cmp(cost) < 0 {
+		return fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, msg.From().Hex(), balance, cost)
+	}
+
+	return nil
+}
+
+// newSimCallResult returns the simulated call result of the given response.
+func newSimCallResult(res *types.MsgEthereumTxResponse, logs []*ethtypes.Log) types.SimCallResult {
+	result := types.SimCallResult{
+		ReturnData: res.Ret,
+		Logs:       logs,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+
+	if !res.Failed() {
+		return result
+	}
+
+	result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		revertErr := types.NewExecErrorWithReason(res.Ret)
+		result.Error = &types.SimCallError{
+			Message: revertErr.Error(),
+			Code:    types.SimErrCodeReverted,
+			Data:    hexutil.Encode(res.Ret),
+		}
+	} else {
+		result.Error = &types.SimCallError{
+			Message: res.VmError,
+			Code:    types.SimErrCodeVMError,
+		}
+	}
+
+	return result
+}
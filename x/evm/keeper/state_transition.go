@@ -63,7 +63,8 @@ func (k *Keeper) NewEVM(
 
 // GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
 //  1. The requested height matches the current height from context (and thus same epoch number)
-//  2. The requested height is from an previous height from the same chain epoch
+//  2. The requested height is from an previous height, served by the history storage contract or, if it's not
+//     stored there, by the historical info of the staking module
 //  3. The requested height is from a height greater than the latest one
 func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	return func(height uint64) common.Hash {
@@ -97,6 +98,12 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 		case ctx.BlockHeight() > h:
 			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
 			// current chain epoch. This only applies if the current height is greater than the requested height.
+			// The history storage contract keeps the last HistoryServeWindow hashes regardless of the staking
+			// params, the historical info being used for the blocks before it was deployed.
+			if hash := k.GetHistoricalBlockHash(ctx, h); hash != (common.Hash{}) {
+				return hash
+			}
+
 			histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
 			if !found {
 				k.Logger(ctx).Debug("historical info not found", "height", h)
@@ -289,11 +296,18 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLo
 // 4. the purchased gas is enough to cover intrinsic usage
 // 5. there is no overflow when calculating intrinsic gas
 // 6. caller has enough balance to cover asset transfer for **topmost** call
+// 7. caller is permitted to create or call contracts by the access control
 //
 // The preprocessing steps performed by the AnteHandler are:
 //
 // 1. set up the initial access list (iff fork > Berlin)
 //
+// # Access control
+//
+// The access control of the sender is checked again, as queries don't go through the AnteHandler.
+// The contracts created by the internal calls are checked against the create policy as well, and
+// the execution of the message is aborted and reverted if any of them is denied.
+//
 // # Tracer parameter
 //
 // It should be a `vm.Tracer` object or nil, if pass `nil`, it'll create a default one based on keeper options.
@@ -320,12 +334,30 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	// return error if the sender is not permitted to create or call contracts by the access control
+	accessControl := cfg.Params.AccessControl
+	createPolicy := accessControl.Create.Policy()
+	if !createPolicy.IsAllowed(msg.From()) && msg.To() == nil {
+		return nil, errorsmod.Wrapf(types.ErrCreateNotPermitted, "sender %s is not permitted to create contracts", msg.From())
+	} else if !accessControl.CanCall(msg.From()) && msg.To() != nil {
+		return nil, errorsmod.Wrapf(types.ErrCallNotPermitted, "sender %s is not permitted to call contracts", msg.From())
+	}
+
 	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
-	// set the custom precompiles to the EVM (if any)
-	if cfg.Params.HasCustomPrecompiles() {
-		customPrecompiles := cfg.Params.GetActivePrecompilesAddrs()
+	// the contracts created by the internal calls are checked against the policy too
+	guard := newCreateGuard(stateDB, createPolicy)
+	if guard != nil {
+		guard.install(evm)
+	}
+
+	// set the custom, registered and moved precompiles to the EVM (if any).
+	// The registry is loaded once, as it's swapped at begin block.
+	registry := k.precompileRegistry(ctx)
+	registeredPrecompiles, disabledPrecompiles := registry.enabled, registry.disabled
+	if cfg.Params.HasCustomPrecompiles() || len(registeredPrecompiles) > 0 || len(disabledPrecompiles) > 0 || len(cfg.MovedPrecompiles) > 0 {
+		customPrecompiles := append(cfg.Params.GetActivePrecompilesAddrs(), registeredPrecompiles...)
 
 		activePrecompiles := make([]common.Address, len(vm.PrecompiledAddressesBerlin)+len(customPrecompiles))
 		copy(activePrecompiles[:len(vm.PrecompiledAddressesBerlin)], vm.PrecompiledAddressesBerlin)
@@ -343,10 +375,24 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 			return nil, errorsmod.Wrap(types.ErrInactivePrecompile, "failed to call precompile")
 		}
 
+		// disabled instances are kept in the precompile registry, so the calls to
+		// them fail instead of reaching an empty account
+		if toAddr != nil && slices.Contains(disabledPrecompiles, *toAddr) {
+			return nil, errorsmod.Wrap(types.ErrInactivePrecompile, "failed to call registered precompile")
+		}
+
 		// NOTE: this only adds active precompiles to the EVM.
 		// This means that evm.Precompile(addr) will return false for inactive precompiles
 		// even though this is actually a reserved address.
-		precompileMap := k.Precompiles(activePrecompiles...)
+		precompileMap := k.precompilesOf(registry, activePrecompiles...)
+		// the active addresses without a precompiled contract are skipped
+		loadedPrecompiles := activePrecompiles[:0]
+		for _, address := range activePrecompiles {
+			if _, ok := precompileMap[address]; ok {
+				loadedPrecompiles = append(loadedPrecompiles, address)
+			}
+		}
+		activePrecompiles = movePrecompiles(precompileMap, loadedPrecompiles, cfg.MovedPrecompiles)
 		evm.WithPrecompiles(precompileMap, activePrecompiles)
 	}
 
@@ -380,19 +426,25 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
-	if rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil); rules.IsBerlin {
-		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	stateDB.Prepare(rules, msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
+
+	// a creation denied by the access control consumes all the gas of the message
+	if err := guard.run(func() {
+		if contractCreation {
+			// take over the nonce management from evm:
+			// - reset sender's nonce to msg.Nonce() before calling evm.
+			// - increase sender's nonce by one no matter the result.
+			stateDB.SetNonce(sender.Address(), msg.Nonce())
+			ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
+		} else {
+			ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
+		}
+	}); err != nil {
+		ret, leftoverGas, vmErr = nil, 0, err
 	}
-
 	if contractCreation {
-		// take over the nonce management from evm:
-		// - reset sender's nonce to msg.Nonce() before calling evm.
-		// - increase sender's nonce by one no matter the result.
-		stateDB.SetNonce(sender.Address(), msg.Nonce())
-		ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
 		stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
-	} else {
-		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
 	}
 
 	refundQuotient := params.RefundQuotient
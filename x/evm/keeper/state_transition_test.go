@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"math/big"
@@ -631,6 +632,40 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 			},
 			true,
 		},
+		{
+			"call contract tx with sender restricted by the access control",
+			func() {
+				config.Params.AccessControl.Call = types.AccessControlType{
+					AccessType:        types.AccessTypeRestricted,
+					AccessControlList: []string{suite.address.Hex()},
+				}
+				msg, err = newNativeMessage(
+					vmdb.GetNonce(suite.address),
+					suite.ctx.BlockHeight(),
+					suite.address,
+					chainCfg,
+					suite.signer,
+					signer,
+					ethtypes.AccessListTxType,
+					nil,
+					nil,
+				)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"create contract tx with sender not permissioned by the access control",
+			func() {
+				msg, err = suite.createContractGethMsg(vmdb.GetNonce(suite.address), signer, chainCfg, big.NewInt(1))
+				suite.Require().NoError(err)
+				config.Params.AccessControl.Create = types.AccessControlType{
+					AccessType:        types.AccessTypePermissioned,
+					AccessControlList: []string{utiltx.GenerateAddress().Hex()},
+				}
+			},
+			true,
+		},
 		{
 			"fix panic when minimumGasUsed is not uint64",
 			func() {
@@ -685,6 +720,178 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+// TestPush0 runs the EIP-3855 test cases, the PUSH0 instruction being enabled
+// from Shanghai.
+func (suite *KeeperTestSuite) TestPush0() {
+	var (
+		config *statedb.EVMConfig
+		code   []byte
+	)
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   string
+		expState common.Hash
+	}{
+		{
+			"single PUSH0",
+			func() {
+				// PUSH1 0x01, PUSH0, SSTORE
+				code = []byte{0x60, 0x01, 0x5f, 0x55}
+			},
+			"",
+			common.BigToHash(big.NewInt(1)),
+		},
+		{
+			"1024 PUSH0",
+			func() {
+				code = bytes.Repeat([]byte{0x5f}, 1024)
+			},
+			"",
+			common.Hash{},
+		},
+		{
+			"PUSH0 at Shanghai without extra EIPs",
+			func() {
+				code = []byte{0x60, 0x01, 0x5f, 0x55}
+				config.Params.ExtraEIPs = nil
+			},
+			"",
+			common.BigToHash(big.NewInt(1)),
+		},
+		{
+			"1025 PUSH0 overflows the stack",
+			func() {
+				code = bytes.Repeat([]byte{0x5f}, 1025)
+			},
+			"stack limit reached 1024 (1023)",
+			common.Hash{},
+		},
+		{
+			"PUSH0 before Shanghai",
+			func() {
+				code = []byte{0x60, 0x01, 0x5f, 0x55}
+				config.ChainConfig.ShanghaiBlock = big.NewInt(suite.ctx.BlockHeight() + 1)
+				config.Params.ExtraEIPs = nil
+			},
+			"invalid opcode: PUSH0",
+			common.Hash{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			var err error
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err = suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			vmdb := suite.StateDB()
+			vmdb.SetCode(contract, code)
+			suite.Require().NoError(vmdb.Commit())
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, &contract, nonce, big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+
+			if tc.expErr != "" {
+				suite.Require().True(res.Failed())
+				suite.Require().Equal(tc.expErr, res.VmError)
+				return
+			}
+
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(tc.expState, suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{}))
+		})
+	}
+}
+
+// TestApplyMessageWithConfigInternalCreate checks that the contracts created by
+// an internal call are checked against the create policy of the creating
+// contract, and that a denied creation reverts the whole call.
+func (suite *KeeperTestSuite) TestApplyMessageWithConfigInternalCreate() {
+	// PUSH1 0x01, PUSH1 0x00, SSTORE, PUSH1 0x00, DUP1, DUP1, CREATE, STOP
+	factoryCode := []byte{0x60, 0x01, 0x60, 0x00, 0x55, 0x60, 0x00, 0x80, 0x80, 0xf0, 0x00}
+	// PUSH4 0x600080fd (init code: PUSH1 0x00, DUP1, REVERT), PUSH1 0x00, MSTORE,
+	// PUSH1 0x04, PUSH1 0x1c, PUSH1 0x00, CREATE, POP, PUSH1 0x01, PUSH1 0x00,
+	// SSTORE, STOP
+	revertedFactoryCode := []byte{
+		0x63, 0x60, 0x00, 0x80, 0xfd, 0x60, 0x00, 0x52,
+		0x60, 0x04, 0x60, 0x1c, 0x60, 0x00, 0xf0, 0x50,
+		0x60, 0x01, 0x60, 0x00, 0x55, 0x00,
+	}
+	factory := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		code        []byte
+		createType  types.AccessType
+		listFactory bool
+		expErr      bool
+	}{
+		{"permissionless", factoryCode, types.AccessTypePermissionless, true, false},
+		{"sender restricted, factory allowed", factoryCode, types.AccessTypeRestricted, false, false},
+		{"factory restricted", factoryCode, types.AccessTypeRestricted, true, true},
+		{"factory restricted, reverted creation", revertedFactoryCode, types.AccessTypeRestricted, true, true},
+		{"factory permissioned", factoryCode, types.AccessTypePermissioned, true, false},
+		{"sender permissioned, factory not", factoryCode, types.AccessTypePermissioned, false, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			proposerAddress := suite.ctx.BlockHeader().ProposerAddress
+			config, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, proposerAddress, big.NewInt(9000))
+			suite.Require().NoError(err)
+			// the access control list holds either the factory or the sender
+			listed := suite.address
+			if tc.listFactory {
+				listed = factory
+			}
+			config.Params.AccessControl.Create = types.AccessControlType{
+				AccessType:        tc.createType,
+				AccessControlList: []string{listed.Hex()},
+			}
+
+			vmdb := suite.StateDB()
+			vmdb.SetCode(factory, tc.code)
+			suite.Require().NoError(vmdb.Commit())
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, &factory, nonce, big.NewInt(0), 100000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+			txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+
+			res, err := suite.app.EvmKeeper.ApplyMessageWithConfig(suite.ctx, msg, nil, true, config, txConfig)
+			suite.Require().NoError(err)
+
+			if tc.expErr {
+				suite.Require().True(res.Failed())
+				suite.Require().Contains(res.VmError, types.ErrCreateNotPermitted.Error())
+				suite.Require().Contains(res.VmError, factory.Hex())
+				suite.Require().Equal(msg.Gas(), res.GasUsed)
+				// the whole call is reverted
+				suite.Require().Equal(common.Hash{}, suite.app.EvmKeeper.GetState(suite.ctx, factory, common.Hash{}))
+				suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, factory))
+				return
+			}
+
+			suite.Require().False(res.Failed(), res.VmError)
+			suite.Require().Equal(common.BigToHash(big.NewInt(1)), suite.app.EvmKeeper.GetState(suite.ctx, factory, common.Hash{}))
+			suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetNonce(suite.ctx, factory))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, cfg *params.ChainConfig, gasPrice *big.Int) (core.Message, error) {
 	ethMsg, err := suite.createContractMsgTx(nonce, signer, gasPrice)
 	if err != nil {
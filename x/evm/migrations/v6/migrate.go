@@ -0,0 +1,73 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package v6
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/kato114/byte/v15/x/evm/types"
+)
+
+const (
+	// strideOutpostAddress is the address of the Stride outpost built at app
+	// startup
+	strideOutpostAddress = "0x0000000000000000000000000000000000000900"
+	// strideOutpostChannelID is the channel of the Stride outpost built at app
+	// startup
+	strideOutpostChannelID = "channel-25"
+)
+
+// MigrateStore migrates the x/evm module state from the consensus version 5 to
+// version 6. Specifically, it moves the Stride outpost, which was built at app
+// startup on a fixed channel, to the precompile registry so that governance
+// can update it. The outpost is registered as enabled if it was active, and
+// removed from the active precompiles of the params.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var params types.Params
+
+	store := ctx.KVStore(storeKey)
+	cdc.MustUnmarshal(store.Get(types.KeyPrefixParams), &params)
+
+	enabled := false
+	activePrecompiles := make([]string, 0, len(params.ActivePrecompiles))
+	for _, precompile := range params.ActivePrecompiles {
+		if strings.EqualFold(precompile, strideOutpostAddress) {
+			enabled = true
+			continue
+		}
+		activePrecompiles = append(activePrecompiles, precompile)
+	}
+	params.ActivePrecompiles = activePrecompiles
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	instance := types.PrecompileInstance{
+		Address:   common.HexToAddress(strideOutpostAddress).Hex(),
+		Type:      types.PrecompileTypeStrideOutpost,
+		Enabled:   enabled,
+		PortID:    transfertypes.PortID,
+		ChannelID: strideOutpostChannelID,
+	}
+	if err := instance.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.KeyPrefixParams, cdc.MustMarshal(&params))
+
+	registry := prefix.NewStore(store, types.KeyPrefixPrecompileInstance)
+	registry.Set(instance.GetAddressHex().Bytes(), cdc.MustMarshal(&instance))
+	return nil
+}
@@ -0,0 +1,70 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kato114/byte/v15/app"
+	"github.com/kato114/byte/v15/encoding"
+	v6 "github.com/kato114/byte/v15/x/evm/migrations/v6"
+	"github.com/kato114/byte/v15/x/evm/types"
+)
+
+func TestMigrate(t *testing.T) {
+	strideOutpost := common.HexToAddress("0x0000000000000000000000000000000000000900")
+
+	testCases := []struct {
+		name              string
+		activePrecompiles []string
+		expEnabled        bool
+	}{
+		{
+			"active stride outpost",
+			[]string{"0x0000000000000000000000000000000000000800", "0x0000000000000000000000000000000000000900"},
+			true,
+		},
+		{
+			"inactive stride outpost",
+			[]string{"0x0000000000000000000000000000000000000800"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			encCfg := encoding.MakeConfig(app.ModuleBasics)
+			cdc := encCfg.Codec
+
+			storeKey := sdk.NewKVStoreKey(types.ModuleName)
+			tKey := sdk.NewTransientStoreKey("transient_test")
+			ctx := testutil.DefaultContext(storeKey, tKey)
+			kvStore := ctx.KVStore(storeKey)
+
+			params := types.DefaultParams()
+			params.ActivePrecompiles = tc.activePrecompiles
+			kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&params))
+
+			require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+			// the outpost is removed from the active precompiles
+			var migrated types.Params
+			cdc.MustUnmarshal(kvStore.Get(types.KeyPrefixParams), &migrated)
+			require.Equal(t, []string{"0x0000000000000000000000000000000000000800"}, migrated.ActivePrecompiles)
+
+			// and kept in the precompile registry
+			var instance types.PrecompileInstance
+			bz := prefix.NewStore(kvStore, types.KeyPrefixPrecompileInstance).Get(strideOutpost.Bytes())
+			cdc.MustUnmarshal(bz, &instance)
+			require.Equal(t, strideOutpost, instance.GetAddressHex())
+			require.Equal(t, types.PrecompileTypeStrideOutpost, instance.Type)
+			require.Equal(t, "transfer", instance.PortID)
+			require.Equal(t, "channel-25", instance.ChannelID)
+			require.Equal(t, tc.expEnabled, instance.Enabled)
+		})
+	}
+}
@@ -46,4 +46,7 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// MovedPrecompiles maps the address of a precompiled contract to the
+	// address it is moved to by the state overrides of a message call
+	MovedPrecompiles map[common.Address]common.Address
 }
@@ -65,6 +65,7 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	created   bool
 }
 
 // newObject creates a state object.
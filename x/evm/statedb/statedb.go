@@ -13,6 +13,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 // revision is the identifier of a version of state.
@@ -52,6 +53,9 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// Per-transaction transient storage (EIP-1153)
+	transientStorage transientStorage
 }
 
 // New creates a new state from a given trie.
@@ -63,6 +67,8 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 		journal:      newJournal(),
 		accessList:   newAccessList(),
 
+		transientStorage: newTransientStorage(),
+
 		txConfig: txConfig,
 	}
 }
@@ -200,6 +206,15 @@ func (s *StateDB) HasSuicided(addr common.Address) bool {
 	return false
 }
 
+// IsCreated returns if the account is created by CreateAccount in current transaction.
+func (s *StateDB) IsCreated(addr common.Address) bool {
+	stateObject := s.getStateObject(addr)
+	if stateObject != nil {
+		return stateObject.created
+	}
+	return false
+}
+
 // AddPreimage records a SHA3 preimage seen by the VM.
 // AddPreimage performs a no-op since the EnablePreimageRecording flag is disabled
 // on the vm.Config during state transitions. No store trie preimages are written
@@ -263,6 +278,7 @@ func (s *StateDB) createObject(addr common.Address) (newobj, prev *stateObject)
 // Carrying over the balance ensures that Ether doesn't disappear.
 func (s *StateDB) CreateAccount(addr common.Address) {
 	newObj, prev := s.createObject(addr)
+	newObj.created = true
 	if prev != nil {
 		newObj.setBalance(prev.account.Balance)
 	}
@@ -355,6 +371,18 @@ func (s *StateDB) Suicide(addr common.Address) bool {
 	return true
 }
 
+// Prepare handles the preparatory steps for executing a state transition:
+//
+// - Prepare the access list, if Berlin is applicable at the current number
+// - Reset the transient storage (1153)
+func (s *StateDB) Prepare(rules params.Rules, sender common.Address, dst *common.Address, precompiles []common.Address, list ethtypes.AccessList) {
+	if rules.IsBerlin {
+		s.PrepareAccessList(sender, dst, precompiles, list)
+	}
+	// reset transient storage at the beginning of the transaction execution
+	s.transientStorage = newTransientStorage()
+}
+
 // PrepareAccessList handles the preparatory steps for executing a state transition with
 // regards to both EIP-2929 and EIP-2930:
 //
@@ -416,6 +444,33 @@ func (s *StateDB) SlotInAccessList(addr common.Address, slot common.Hash) (addre
 	return s.accessList.Contains(addr, slot)
 }
 
+// GetTransientState gets the transient storage value of the given key of the
+// account.
+func (s *StateDB) GetTransientState(addr common.Address, key common.Hash) common.Hash {
+	return s.transientStorage.Get(addr, key)
+}
+
+// SetTransientState sets the transient storage value of the given key of the
+// account, journaling the previous value.
+func (s *StateDB) SetTransientState(addr common.Address, key, value common.Hash) {
+	prev := s.GetTransientState(addr, key)
+	if prev == value {
+		return
+	}
+	s.journal.append(transientStorageChange{
+		account:  &addr,
+		key:      key,
+		prevalue: prev,
+	})
+	s.setTransientState(addr, key, value)
+}
+
+// setTransientState is a lower level setter for transient storage. It is
+// called during a revert to prevent modifications to the journal.
+func (s *StateDB) setTransientState(addr common.Address, key, value common.Hash) {
+	s.transientStorage.Set(addr, key, value)
+}
+
 // Snapshot returns an identifier for the current revision of the state.
 func (s *StateDB) Snapshot() int {
 	id := s.nextRevisionID
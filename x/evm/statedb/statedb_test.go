@@ -9,6 +9,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/kato114/byte/v15/x/evm/statedb"
 	"github.com/stretchr/testify/suite"
 )
@@ -116,9 +117,11 @@ func (suite *StateDBTestSuite) TestAccountOverride() {
 	// init an EOA account, account overridden only happens on EOA account.
 	db.AddBalance(address, amount)
 	db.SetNonce(address, 1)
+	suite.Require().False(db.IsCreated(address))
 
 	// override
 	db.CreateAccount(address)
+	suite.Require().True(db.IsCreated(address))
 
 	// check balance is not lost
 	suite.Require().Equal(amount, db.GetBalance(address))
@@ -385,6 +388,42 @@ func (suite *StateDBTestSuite) TestNestedSnapshot() {
 	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+
+	rev1 := db.Snapshot()
+	db.SetTransientState(address, key, value1)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+	// transient storage doesn't touch the persistent one
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
+
+	rev2 := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev2)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev1)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// transient storage is cleared at the start of each transaction
+	db.SetTransientState(address, key, value1)
+	db.Prepare(params.Rules{}, address, &address2, nil, nil)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// and never committed
+	db.SetTransientState(address, key, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+}
+
 func (suite *StateDBTestSuite) TestInvalidSnapshotId() {
 	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
 	suite.Require().Panics(func() {
@@ -0,0 +1,217 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// CallFrame is a call frame of the callTracer result, with the sub calls in
+// the order in which they were executed.
+type CallFrame struct {
+	Type    string         `json:"type"`
+	From    common.Address `json:"from"`
+	To      common.Address `json:"to"`
+	Value   *hexutil.Big   `json:"value"`
+	Gas     hexutil.Uint64 `json:"gas"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Input   hexutil.Bytes  `json:"input"`
+	Output  hexutil.Bytes  `json:"output"`
+	Error   string         `json:"error"`
+	Calls   []CallFrame    `json:"calls"`
+}
+
+var _ tracers.Tracer = &callTracer{}
+
+// callFrame is a call frame traced by the callTracer, encoded as the ones of
+// the go-ethereum callTracer. The frames into a stateful precompile hold the
+// details of the precompile call.
+type callFrame struct {
+	Type       string          `json:"type"`
+	From       string          `json:"from"`
+	To         string          `json:"to,omitempty"`
+	Value      string          `json:"value,omitempty"`
+	Gas        string          `json:"gas"`
+	GasUsed    string          `json:"gasUsed"`
+	Input      string          `json:"input"`
+	Output     string          `json:"output,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	Precompile *PrecompileCall `json:"precompile,omitempty"`
+	Calls      []callFrame     `json:"calls,omitempty"`
+}
+
+// callTracerConfig is the config of the callTracer, as the one of go-ethereum.
+type callTracerConfig struct {
+	OnlyTopCall bool `json:"onlyTopCall"` // If true, call tracer won't collect any subcalls
+}
+
+// callTracer is the go-ethereum native callTracer, extended to add the
+// stateful precompile calls to their frames as they're traced.
+type callTracer struct {
+	env       *vm.EVM
+	callstack []callFrame
+	config    callTracerConfig
+	recorder  precompileRecorder
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newCallTracer returns the callTracer with the given config.
+func newCallTracer(cfg json.RawMessage) (*callTracer, error) {
+	var config callTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	// First callframe contains tx context info
+	// and is populated on start and end.
+	return &callTracer{callstack: make([]callFrame, 1), config: config}, nil
+}
+
+// CaptureStart implements vm.EVMLogger
+func (t *callTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	t.recorder.start(env, to, create, input)
+	t.callstack[0] = callFrame{
+		Type:       "CALL",
+		From:       addrToHex(from),
+		To:         addrToHex(to),
+		Input:      bytesToHex(input),
+		Gas:        uintToHex(gas),
+		Value:      bigToHex(value),
+		Precompile: t.recorder.current(),
+	}
+	if create {
+		t.callstack[0].Type = "CREATE"
+	}
+}
+
+// CaptureEnd implements vm.EVMLogger
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.recorder.exit(err)
+	t.callstack[0].GasUsed = uintToHex(gasUsed)
+	if err != nil {
+		t.callstack[0].Error = err.Error()
+		if err.Error() == "execution reverted" && len(output) > 0 {
+			t.callstack[0].Output = bytesToHex(output)
+		}
+	} else {
+		t.callstack[0].Output = bytesToHex(output)
+	}
+}
+
+// CaptureState implements vm.EVMLogger
+func (t *callTracer) CaptureState(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, []byte, int, error) {
+}
+
+// CaptureFault implements vm.EVMLogger
+func (t *callTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+// CaptureEnter implements vm.EVMLogger
+func (t *callTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	// The recorder follows every frame, so that it's exited along the EVM
+	t.recorder.enter(typ, to, input)
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+
+	t.callstack = append(t.callstack, callFrame{
+		Type:       typ.String(),
+		From:       addrToHex(from),
+		To:         addrToHex(to),
+		Input:      bytesToHex(input),
+		Gas:        uintToHex(gas),
+		Value:      bigToHex(value),
+		Precompile: t.recorder.current(),
+	})
+}
+
+// CaptureExit implements vm.EVMLogger
+func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+	t.recorder.exit(err)
+
+	// pop call
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+	size--
+
+	call.GasUsed = uintToHex(gasUsed)
+	if err == nil {
+		call.Output = bytesToHex(output)
+	} else {
+		call.Error = err.Error()
+		if call.Type == "CREATE" || call.Type == "CREATE2" {
+			call.To = ""
+		}
+	}
+	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, call)
+}
+
+// CaptureTxStart implements vm.EVMLogger
+func (*callTracer) CaptureTxStart(uint64) {}
+
+// CaptureTxEnd implements vm.EVMLogger
+func (*callTracer) CaptureTxEnd(uint64) {}
+
+// GetResult returns the json-encoded nested list of call traces, and any
+// error arising from the encoding or forceful termination (via `Stop`).
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *callTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+func bytesToHex(s []byte) string {
+	return "0x" + common.Bytes2Hex(s)
+}
+
+func bigToHex(n *big.Int) string {
+	if n == nil {
+		return ""
+	}
+	return "0x" + n.Text(16)
+}
+
+func uintToHex(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+func addrToHex(a common.Address) string {
+	return strings.ToLower(a.Hex())
+}
@@ -0,0 +1,43 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package tracers
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// addPrecompileSelectors adds the method selectors of the internal calls into
+// stateful precompiles to a 4byteTracer result. The go-ethereum tracer skips
+// all the precompile invocations, since the standard precompiles don't define
+// an ABI. The top-level call is already accounted for by the tracer.
+func addPrecompileSelectors(res json.RawMessage, calls []*PrecompileCall) (json.RawMessage, error) {
+	if len(calls) == 0 {
+		return res, nil
+	}
+
+	ids := make(map[string]int)
+	if err := json.Unmarshal(res, &ids); err != nil {
+		return nil, err
+	}
+
+	for _, call := range calls {
+		if call.depth == 0 || len(call.input) < 4 {
+			continue
+		}
+
+		switch call.typ {
+		case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		default:
+			continue
+		}
+
+		key := hexutil.Encode(call.input[:4]) + "-" + strconv.Itoa(len(call.input)-4)
+		ids[key]++
+	}
+
+	return json.Marshal(ids)
+}
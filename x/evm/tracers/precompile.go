@@ -0,0 +1,337 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package tracers
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/kato114/byte/v15/x/evm/statedb"
+)
+
+// PrecompileCall describes the execution of a call frame into a stateful
+// precompiled contract. It contains the decoded ABI method and arguments, the
+// Cosmos events emitted during the execution and the resulting bank balance
+// changes.
+type PrecompileCall struct {
+	Method         string                 `json:"method,omitempty"`
+	Inputs         map[string]interface{} `json:"inputs,omitempty"`
+	Events         []Event                `json:"events,omitempty"`
+	BalanceChanges []BalanceChange        `json:"balanceChanges,omitempty"`
+
+	// address of the precompile
+	address common.Address
+	// call type of the frame
+	typ vm.OpCode
+	// call depth of the frame, 0 for the top-level call
+	depth int
+	// calldata of the call frame
+	input []byte
+}
+
+// Event is the JSON representation of a Cosmos SDK event.
+type Event struct {
+	Type       string           `json:"type"`
+	Attributes []EventAttribute `json:"attributes,omitempty"`
+}
+
+// EventAttribute is the JSON representation of a Cosmos SDK event attribute.
+type EventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// BalanceChange is the signed net change of an account balance on the bank
+// module for a given denomination.
+type BalanceChange struct {
+	Address common.Address `json:"address"`
+	Denom   string         `json:"denom"`
+	Amount  sdkmath.Int    `json:"amount"`
+}
+
+// methodByID is implemented by the stateful precompiles that embed the ABI.
+type methodByID interface {
+	MethodById(sigdata []byte) (*abi.Method, error)
+}
+
+// openFrame is a call frame that has been entered but not exited yet.
+type openFrame struct {
+	call        *PrecompileCall
+	eventsStart int
+}
+
+// precompileRecorder keeps track of the call frames of a transaction and
+// records the ones that execute a stateful precompile. The recorded calls are
+// stored in the order in which their frames are entered, which matches the
+// pre-order traversal of the call tree.
+type precompileRecorder struct {
+	env    *vm.EVM
+	frames []openFrame
+	// calls holds one entry per call frame, nil for the frames that do not
+	// execute a stateful precompile
+	calls []*PrecompileCall
+}
+
+// start records the top-level call frame.
+func (r *precompileRecorder) start(env *vm.EVM, to common.Address, create bool, input []byte) {
+	r.env = env
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	r.enter(typ, to, input)
+}
+
+// enter records a new call frame.
+func (r *precompileRecorder) enter(typ vm.OpCode, to common.Address, input []byte) {
+	frame := openFrame{}
+
+	if call := r.newPrecompileCall(typ, to, input); call != nil {
+		frame.call = call
+		frame.eventsStart = len(r.events())
+	}
+
+	r.calls = append(r.calls, frame.call)
+	r.frames = append(r.frames, frame)
+}
+
+// exit closes the innermost call frame and, if it executed a stateful
+// precompile successfully, collects the events it emitted.
+func (r *precompileRecorder) exit(err error) *PrecompileCall {
+	if len(r.frames) == 0 {
+		return nil
+	}
+
+	frame := r.frames[len(r.frames)-1]
+	r.frames = r.frames[:len(r.frames)-1]
+
+	if frame.call == nil || err != nil {
+		return nil
+	}
+
+	events := r.events()
+	if frame.eventsStart < len(events) {
+		frame.call.Events = FormatEvents(events[frame.eventsStart:])
+		frame.call.BalanceChanges = BalanceChangesFromEvents(events[frame.eventsStart:])
+	}
+
+	return frame.call
+}
+
+// current returns the stateful precompile call of the innermost call frame,
+// nil if the frame doesn't execute a stateful precompile.
+func (r *precompileRecorder) current() *PrecompileCall {
+	if len(r.frames) == 0 {
+		return nil
+	}
+	return r.frames[len(r.frames)-1].call
+}
+
+// precompileCalls returns the recorded stateful precompile calls in the order
+// in which their frames were entered.
+func (r *precompileRecorder) precompileCalls() []*PrecompileCall {
+	calls := make([]*PrecompileCall, 0, len(r.calls))
+	for _, call := range r.calls {
+		if call != nil {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// newPrecompileCall returns a new PrecompileCall if the address is a stateful
+// precompile of the EVM, nil otherwise.
+func (r *precompileRecorder) newPrecompileCall(typ vm.OpCode, to common.Address, input []byte) *PrecompileCall {
+	if r.env == nil || typ == vm.CREATE || typ == vm.CREATE2 {
+		return nil
+	}
+
+	precompile, found := r.env.Precompile(to)
+	if !found {
+		return nil
+	}
+
+	contract, ok := precompile.(methodByID)
+	if !ok {
+		// stateless precompiles (e.g. ecrecover) don't define an ABI
+		return nil
+	}
+
+	call := &PrecompileCall{
+		address: to,
+		typ:     typ,
+		depth:   len(r.frames),
+		input:   common.CopyBytes(input),
+	}
+
+	if len(input) < 4 {
+		return call
+	}
+
+	method, err := contract.MethodById(input[:4])
+	if err != nil {
+		return call
+	}
+
+	call.Method = method.Name
+
+	args := make(map[string]interface{})
+	if err := method.Inputs.UnpackIntoMap(args, input[4:]); err == nil && len(args) > 0 {
+		call.Inputs = args
+	}
+
+	return call
+}
+
+// events returns the Cosmos events emitted so far on the context used by the
+// EVM state.
+func (r *precompileRecorder) events() sdk.Events {
+	if r.env == nil {
+		return nil
+	}
+
+	stateDB, ok := r.env.StateDB.(*statedb.StateDB)
+	if !ok {
+		return nil
+	}
+
+	return stateDB.GetContext().EventManager().Events()
+}
+
+// FormatEvents returns the JSON representation of the given Cosmos events.
+func FormatEvents(events sdk.Events) []Event {
+	formatted := make([]Event, 0, len(events))
+	for _, event := range events {
+		formatted = append(formatted, Event{
+			Type:       event.Type,
+			Attributes: formatAttributes(event.Attributes),
+		})
+	}
+	return formatted
+}
+
+func formatAttributes(attributes []abci.EventAttribute) []EventAttribute {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	formatted := make([]EventAttribute, 0, len(attributes))
+	for _, attr := range attributes {
+		formatted = append(formatted, EventAttribute{Key: attr.Key, Value: attr.Value})
+	}
+	return formatted
+}
+
+// BalanceChangesFromEvents computes the net balance changes per account and
+// denomination from the coin_spent and coin_received events emitted by the
+// bank module. The changes are returned in the order in which the accounts
+// first appear in the events.
+func BalanceChangesFromEvents(events sdk.Events) []BalanceChange {
+	type key struct {
+		address common.Address
+		denom   string
+	}
+
+	var (
+		order  []key
+		deltas = make(map[key]*big.Int)
+	)
+
+	add := func(address common.Address, coins sdk.Coins, sign int) {
+		for _, coin := range coins {
+			k := key{address: address, denom: coin.Denom}
+			delta, ok := deltas[k]
+			if !ok {
+				delta = new(big.Int)
+				deltas[k] = delta
+				order = append(order, k)
+			}
+
+			amount := coin.Amount.BigInt()
+			if sign < 0 {
+				delta.Sub(delta, amount)
+			} else {
+				delta.Add(delta, amount)
+			}
+		}
+	}
+
+	for _, event := range events {
+		var addrKey string
+		sign := 1
+
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, sign = banktypes.AttributeKeySpender, -1
+		case banktypes.EventTypeCoinReceived:
+			addrKey = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		address, coins, err := parseCoinEvent(event, addrKey)
+		if err != nil {
+			continue
+		}
+
+		add(address, coins, sign)
+	}
+
+	changes := make([]BalanceChange, 0, len(order))
+	for _, k := range order {
+		delta := deltas[k]
+		if delta.Sign() == 0 {
+			continue
+		}
+		changes = append(changes, BalanceChange{
+			Address: k.address,
+			Denom:   k.denom,
+			Amount:  sdkmath.NewIntFromBigInt(delta),
+		})
+	}
+
+	return changes
+}
+
+// parseCoinEvent returns the account address stored under the given
+// attribute key and the coins of a bank coin event.
+func parseCoinEvent(event sdk.Event, addrKey string) (common.Address, sdk.Coins, error) {
+	var (
+		address common.Address
+		coins   sdk.Coins
+		err     error
+		found   bool
+	)
+
+	for _, attr := range event.Attributes {
+		switch attr.Key {
+		case addrKey:
+			_, bz, decodeErr := bech32.DecodeAndConvert(attr.Value)
+			if decodeErr != nil {
+				return common.Address{}, nil, decodeErr
+			}
+			address = common.BytesToAddress(bz)
+			found = true
+		case sdk.AttributeKeyAmount:
+			coins, err = sdk.ParseCoinsNormalized(attr.Value)
+			if err != nil {
+				return common.Address{}, nil, err
+			}
+		}
+	}
+
+	if !found {
+		return common.Address{}, nil, fmt.Errorf("attribute %s not found in %s event", addrKey, event.Type)
+	}
+
+	return address, coins, nil
+}
@@ -0,0 +1,65 @@
+package tracers_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	utiltx "github.com/kato114/byte/v15/testutil/tx"
+	evmtracers "github.com/kato114/byte/v15/x/evm/tracers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceChangesFromEvents(t *testing.T) {
+	sender := utiltx.GenerateAddress()
+	receiver := utiltx.GenerateAddress()
+	senderAcc := sdk.AccAddress(sender.Bytes())
+	receiverAcc := sdk.AccAddress(receiver.Bytes())
+
+	coins := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("aevmos", amt))
+	}
+
+	testCases := []struct {
+		name   string
+		events sdk.Events
+		expRes []evmtracers.BalanceChange
+	}{
+		{
+			"no bank events",
+			sdk.Events{sdk.NewEvent("delegate")},
+			[]evmtracers.BalanceChange{},
+		},
+		{
+			"spent and received coins",
+			sdk.Events{
+				banktypes.NewCoinSpentEvent(senderAcc, coins(100)),
+				banktypes.NewCoinReceivedEvent(receiverAcc, coins(100)),
+			},
+			[]evmtracers.BalanceChange{
+				{Address: sender, Denom: "aevmos", Amount: sdkmath.NewInt(-100)},
+				{Address: receiver, Denom: "aevmos", Amount: sdkmath.NewInt(100)},
+			},
+		},
+		{
+			"net changes are aggregated and zero changes omitted",
+			sdk.Events{
+				banktypes.NewCoinSpentEvent(senderAcc, coins(100)),
+				banktypes.NewCoinReceivedEvent(receiverAcc, coins(100)),
+				banktypes.NewCoinSpentEvent(receiverAcc, coins(100)),
+				banktypes.NewCoinReceivedEvent(senderAcc, coins(40)),
+			},
+			[]evmtracers.BalanceChange{
+				{Address: sender, Denom: "aevmos", Amount: sdkmath.NewInt(-60)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res := evmtracers.BalanceChangesFromEvents(tc.events)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
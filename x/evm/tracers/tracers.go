@@ -0,0 +1,107 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+
+// Package tracers implements the native EVM tracers that are aware of the
+// stateful precompiled contracts. The calls into a stateful precompile are
+// traced as regular call frames and are extended with the decoded ABI method,
+// the Cosmos events emitted and the resulting bank balance changes.
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+const (
+	// CallTracer is the name of the native call tracer.
+	CallTracer = "callTracer"
+	// PrestateTracer is the name of the native prestate tracer.
+	PrestateTracer = "prestateTracer"
+	// FourByteTracer is the name of the native 4byte tracer.
+	FourByteTracer = "4byteTracer"
+	// VMTraceTracer is the name of the native tracer that produces the
+	// Parity/OpenEthereum vmTrace of a transaction.
+	VMTraceTracer = "vmTraceTracer"
+)
+
+// New returns the tracer with the given name. The callTracer, prestateTracer
+// and 4byteTracer native tracers are extended to report the calls into
+// stateful precompiles, and the vmTraceTracer is provided for the
+// Parity-style trace namespace. Any other name is resolved through the
+// go-ethereum tracer lookups (e.g. JavaScript tracers).
+//
+// The evmDenom is used to map the bank balance changes made by the
+// precompiles to EVM account balances.
+func New(name string, ctx *tracers.Context, cfg json.RawMessage, evmDenom string) (tracers.Tracer, error) {
+	switch name {
+	case PrestateTracer:
+		return newPrestateTracer(cfg, evmDenom)
+	case VMTraceTracer:
+		return newVMTraceTracer(), nil
+	case CallTracer:
+		return newCallTracer(cfg)
+	case FourByteTracer:
+		inner, err := tracers.New(name, ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return &precompileTracer{Tracer: inner, name: name}, nil
+	default:
+		return tracers.New(name, ctx, cfg)
+	}
+}
+
+var _ tracers.Tracer = &precompileTracer{}
+
+// precompileTracer wraps a go-ethereum native tracer and adds the stateful
+// precompile calls to its result.
+type precompileTracer struct {
+	tracers.Tracer
+	recorder precompileRecorder
+	name     string
+}
+
+// CaptureStart implements vm.EVMLogger
+func (t *precompileTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.Tracer.CaptureStart(env, from, to, create, input, gas, value)
+	t.recorder.start(env, to, create, input)
+}
+
+// CaptureEnd implements vm.EVMLogger
+func (t *precompileTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) {
+	t.recorder.exit(err)
+	t.Tracer.CaptureEnd(output, gasUsed, d, err)
+}
+
+// CaptureEnter implements vm.EVMLogger
+func (t *precompileTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.Tracer.CaptureEnter(typ, from, to, input, gas, value)
+	t.recorder.enter(typ, to, input)
+}
+
+// CaptureExit implements vm.EVMLogger
+func (t *precompileTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.recorder.exit(err)
+	t.Tracer.CaptureExit(output, gasUsed, err)
+}
+
+// GetResult returns the result of the wrapped tracer, extended with the
+// stateful precompile calls.
+func (t *precompileTracer) GetResult() (json.RawMessage, error) {
+	res, err := t.Tracer.GetResult()
+	if err != nil {
+		return res, err
+	}
+
+	switch t.name {
+	case FourByteTracer:
+		return addPrecompileSelectors(res, t.recorder.precompileCalls())
+	default:
+		return res, nil
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package tracers
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// TransferLogAddress is the address that emits the synthetic ETH transfer
+	// logs, as defined by the go-ethereum eth_simulateV1 implementation.
+	TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+	// TransferTopic is the ERC-20 Transfer(address,address,uint256) event topic.
+	TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+var _ vm.EVMLogger = &TransferTracer{}
+
+// transfer is a value transfer log together with the number of logs emitted
+// by the EVM before it, which is used to merge both lists in order.
+type transfer struct {
+	position int
+	log      *ethtypes.Log
+}
+
+// logger is the subset of the StateDB methods used to know how many logs were
+// emitted by the EVM when a value transfer happens.
+type logger interface {
+	Logs() []*ethtypes.Log
+}
+
+// TransferTracer records the native value transfers of a message execution as
+// ERC-20 Transfer logs, so that they can be returned alongside the logs
+// emitted by the contracts. The transfers of the reverted call frames are
+// discarded.
+type TransferTracer struct {
+	env       *vm.EVM
+	transfers []transfer
+	// frames holds the number of transfers recorded before each call frame
+	frames []int
+}
+
+// NewTransferTracer returns a new tracer for the native value transfers.
+func NewTransferTracer() *TransferTracer {
+	return &TransferTracer{}
+}
+
+// CaptureTxStart implements vm.EVMLogger
+func (t *TransferTracer) CaptureTxStart(uint64) {}
+
+// CaptureTxEnd implements vm.EVMLogger
+func (t *TransferTracer) CaptureTxEnd(uint64) {}
+
+// CaptureStart implements vm.EVMLogger
+func (t *TransferTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, _ bool, _ []byte, _ uint64, value *big.Int) {
+	t.env = env
+	t.enter(from, to, value)
+}
+
+// CaptureEnd implements vm.EVMLogger
+func (t *TransferTracer) CaptureEnd(_ []byte, _ uint64, _ time.Duration, err error) {
+	t.exit(err)
+}
+
+// CaptureEnter implements vm.EVMLogger
+func (t *TransferTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	// the value of a delegate call belongs to the parent call frame
+	if typ == vm.DELEGATECALL {
+		value = nil
+	}
+	t.enter(from, to, value)
+}
+
+// CaptureExit implements vm.EVMLogger
+func (t *TransferTracer) CaptureExit(_ []byte, _ uint64, err error) {
+	t.exit(err)
+}
+
+// CaptureState implements vm.EVMLogger
+func (t *TransferTracer) CaptureState(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, []byte, int, error) {
+}
+
+// CaptureFault implements vm.EVMLogger
+func (t *TransferTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+// Logs merges the recorded transfer logs into the given EVM logs, in the
+// order in which they were emitted.
+func (t *TransferTracer) Logs(logs []*ethtypes.Log) []*ethtypes.Log {
+	if len(t.transfers) == 0 {
+		return logs
+	}
+
+	merged := make([]*ethtypes.Log, 0, len(logs)+len(t.transfers))
+	idx := 0
+	for _, transfer := range t.transfers {
+		for ; idx < transfer.position && idx < len(logs); idx++ {
+			merged = append(merged, logs[idx])
+		}
+		merged = append(merged, transfer.log)
+	}
+
+	return append(merged, logs[idx:]...)
+}
+
+func (t *TransferTracer) enter(from, to common.Address, value *big.Int) {
+	t.frames = append(t.frames, len(t.transfers))
+
+	if value == nil || value.Sign() == 0 {
+		return
+	}
+
+	position := 0
+	if t.env != nil {
+		if stateDB, ok := t.env.StateDB.(logger); ok {
+			position = len(stateDB.Logs())
+		}
+	}
+
+	t.transfers = append(t.transfers, transfer{
+		position: position,
+		log: &ethtypes.Log{
+			Address: TransferLogAddress,
+			Topics: []common.Hash{
+				TransferTopic,
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(to.Bytes()),
+			},
+			Data: common.BigToHash(value).Bytes(),
+		},
+	})
+}
+
+func (t *TransferTracer) exit(err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+
+	start := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+
+	// discard the transfers of the reverted call frame and its children
+	if err != nil {
+		t.transfers = t.transfers[:start]
+	}
+}
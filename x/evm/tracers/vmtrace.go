@@ -0,0 +1,278 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+var _ tracers.Tracer = &vmTraceTracer{}
+
+// VMTrace is the Parity/OpenEthereum `vmTrace` of a call frame: the code that
+// was executed and the effects of each of its operations.
+type VMTrace struct {
+	Code hexutil.Bytes `json:"code"`
+	Ops  []*VMTraceOp  `json:"ops"`
+}
+
+// VMTraceOp is a single operation of a VMTrace. The sub trace holds the
+// execution of the call frame created by the operation, if any.
+type VMTraceOp struct {
+	PC   uint64     `json:"pc"`
+	Cost uint64     `json:"cost"`
+	Ex   *VMTraceEx `json:"ex"`
+	Sub  *VMTrace   `json:"sub"`
+}
+
+// VMTraceEx holds the effects of an operation: the gas left after it, the
+// values pushed onto the stack and the memory and storage writes.
+type VMTraceEx struct {
+	Used  uint64          `json:"used"`
+	Push  []*hexutil.Big  `json:"push"`
+	Mem   *VMTraceMemory  `json:"mem"`
+	Store *VMTraceStorage `json:"store"`
+}
+
+// VMTraceMemory is a memory write of an operation.
+type VMTraceMemory struct {
+	Off  uint64        `json:"off"`
+	Data hexutil.Bytes `json:"data"`
+}
+
+// VMTraceStorage is a storage write of an operation.
+type VMTraceStorage struct {
+	Key *hexutil.Big `json:"key"`
+	Val *hexutil.Big `json:"val"`
+}
+
+// vmTraceFrame is the trace of a call frame being executed, together with
+// the operation whose effects are only known once the next one starts.
+type vmTraceFrame struct {
+	trace   *VMTrace
+	pending *VMTraceOp
+	scope   *vm.ScopeContext
+	// number of stack items pushed by the pending operation
+	pushes int
+	// memory write of the pending operation, read once it has been executed
+	memOff, memSize uint64
+}
+
+// vmTraceTracer is a native tracer that produces the Parity/OpenEthereum
+// `vmTrace` of a transaction.
+type vmTraceTracer struct {
+	env       *vm.EVM
+	frames    []*vmTraceFrame
+	root      *VMTrace
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+func newVMTraceTracer() *vmTraceTracer {
+	return &vmTraceTracer{}
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *vmTraceTracer) CaptureStart(env *vm.EVM, _ common.Address, to common.Address, create bool, input []byte, _ uint64, _ *big.Int) {
+	t.env = env
+	t.root = t.enter(to, create, input)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *vmTraceTracer) CaptureEnd([]byte, uint64, time.Duration, error) {
+	t.exit()
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *vmTraceTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, _ []byte, _ int, _ error) {
+	if atomic.LoadUint32(&t.interrupt) > 0 || len(t.frames) == 0 {
+		return
+	}
+
+	frame := t.frames[len(t.frames)-1]
+	frame.finalize(gas, scope)
+
+	traceOp := &VMTraceOp{PC: pc, Cost: cost, Ex: &VMTraceEx{Used: gas - cost, Push: []*hexutil.Big{}}}
+	frame.trace.Ops = append(frame.trace.Ops, traceOp)
+	frame.pending = traceOp
+	frame.scope = scope
+	// the values pushed are read from the stack when the next operation starts
+	frame.pushes = stackPushes(op)
+	frame.memOff, frame.memSize = memoryWrite(op, scope.Stack)
+
+	if op == vm.SSTORE && scope.Stack.Len() >= 2 {
+		traceOp.Ex.Store = &VMTraceStorage{
+			Key: (*hexutil.Big)(scope.Stack.Back(0).ToBig()),
+			Val: (*hexutil.Big)(scope.Stack.Back(1).ToBig()),
+		}
+	}
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *vmTraceTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *vmTraceTracer) CaptureEnter(typ vm.OpCode, _ common.Address, to common.Address, input []byte, _ uint64, _ *big.Int) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+
+	create := typ == vm.CREATE || typ == vm.CREATE2
+	sub := t.enter(to, create, input)
+
+	// a selfdestruct doesn't execute any code
+	if typ == vm.SELFDESTRUCT {
+		return
+	}
+
+	if n := len(t.frames); n > 1 {
+		if parent := t.frames[n-2]; parent.pending != nil {
+			parent.pending.Sub = sub
+		}
+	}
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *vmTraceTracer) CaptureExit([]byte, uint64, error) {
+	t.exit()
+}
+
+// CaptureTxStart implements the EVMLogger interface.
+func (t *vmTraceTracer) CaptureTxStart(uint64) {}
+
+// CaptureTxEnd implements the EVMLogger interface.
+func (t *vmTraceTracer) CaptureTxEnd(uint64) {}
+
+// GetResult returns the json-encoded vmTrace of the transaction.
+func (t *vmTraceTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *vmTraceTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+func (t *vmTraceTracer) enter(to common.Address, create bool, input []byte) *VMTrace {
+	trace := &VMTrace{Ops: []*VMTraceOp{}}
+	if create {
+		trace.Code = common.CopyBytes(input)
+	} else if t.env != nil {
+		trace.Code = t.env.StateDB.GetCode(to)
+	}
+
+	t.frames = append(t.frames, &vmTraceFrame{trace: trace})
+	return trace
+}
+
+func (t *vmTraceTracer) exit() {
+	if len(t.frames) == 0 {
+		return
+	}
+
+	frame := t.frames[len(t.frames)-1]
+	frame.finalize(0, nil)
+	t.frames = t.frames[:len(t.frames)-1]
+}
+
+// finalize records the effects of the pending operation of the frame. The
+// scope is the one of the next operation of the frame, or nil if the frame
+// exited.
+func (f *vmTraceFrame) finalize(gas uint64, scope *vm.ScopeContext) {
+	op := f.pending
+	if op == nil {
+		return
+	}
+	f.pending = nil
+
+	// the stack is only known if another operation follows in the frame
+	if scope != nil {
+		op.Ex.Used = gas
+		if scope.Stack.Len() >= f.pushes {
+			for i := f.pushes - 1; i >= 0; i-- {
+				op.Ex.Push = append(op.Ex.Push, (*hexutil.Big)(scope.Stack.Back(i).ToBig()))
+			}
+		}
+	}
+
+	if f.memSize > 0 && f.scope != nil && uint64(f.scope.Memory.Len()) >= f.memOff+f.memSize {
+		op.Ex.Mem = &VMTraceMemory{
+			Off:  f.memOff,
+			Data: f.scope.Memory.GetCopy(int64(f.memOff), int64(f.memSize)), // #nosec G701 -- bounded by the memory length
+		}
+	}
+}
+
+// memoryWrite returns the offset and size of the memory written by the
+// operation, given the stack before its execution.
+func memoryWrite(op vm.OpCode, stack *vm.Stack) (offset, size uint64) {
+	var offsetPos, sizePos int
+	switch op {
+	case vm.MSTORE:
+		if stack.Len() < 1 {
+			return 0, 0
+		}
+		return stack.Back(0).Uint64(), 32
+	case vm.MSTORE8:
+		if stack.Len() < 1 {
+			return 0, 0
+		}
+		return stack.Back(0).Uint64(), 1
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY:
+		offsetPos, sizePos = 0, 2
+	case vm.EXTCODECOPY:
+		offsetPos, sizePos = 1, 3
+	case vm.CALL, vm.CALLCODE:
+		offsetPos, sizePos = 5, 6
+	case vm.DELEGATECALL, vm.STATICCALL:
+		offsetPos, sizePos = 4, 5
+	default:
+		return 0, 0
+	}
+
+	if stack.Len() <= sizePos {
+		return 0, 0
+	}
+
+	off, sz := stack.Back(offsetPos), stack.Back(sizePos)
+	if !off.IsUint64() || !sz.IsUint64() {
+		return 0, 0
+	}
+	return off.Uint64(), sz.Uint64()
+}
+
+// stackPushes returns the number of stack items reported as pushed by the
+// operation, following the OpenEthereum conventions.
+func stackPushes(op vm.OpCode) int {
+	switch {
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		return int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		return int(op-vm.SWAP1) + 2
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		return 0
+	}
+
+	switch op {
+	case vm.STOP, vm.POP, vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.JUMP, vm.JUMPI, vm.JUMPDEST,
+		vm.CALLDATACOPY, vm.CODECOPY, vm.EXTCODECOPY, vm.RETURNDATACOPY,
+		vm.RETURN, vm.REVERT, vm.SELFDESTRUCT, vm.INVALID:
+		return 0
+	default:
+		return 1
+	}
+}
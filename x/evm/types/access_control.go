@@ -0,0 +1,120 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kato114/byte/v15/types"
+)
+
+// DefaultAccessControl returns the default access control, which allows
+// anyone to create and call contracts.
+func DefaultAccessControl() AccessControl {
+	return AccessControl{
+		Create: AccessControlType{
+			AccessType: AccessTypePermissionless,
+		},
+		Call: AccessControlType{
+			AccessType: AccessTypePermissionless,
+		},
+	}
+}
+
+// Validate performs a stateless validation of the access control policies.
+func (ac AccessControl) Validate() error {
+	if err := ac.Create.Validate(); err != nil {
+		return fmt.Errorf("invalid create access control: %w", err)
+	}
+
+	if err := ac.Call.Validate(); err != nil {
+		return fmt.Errorf("invalid call access control: %w", err)
+	}
+
+	return nil
+}
+
+// CanCreate returns true if the given address is allowed to create contracts.
+func (ac AccessControl) CanCreate(address common.Address) bool {
+	return ac.Create.IsAllowed(address)
+}
+
+// CanCall returns true if the given address is allowed to call contracts.
+func (ac AccessControl) CanCall(address common.Address) bool {
+	return ac.Call.IsAllowed(address)
+}
+
+// Validate checks the access type and the addresses of the access control
+// list of the policy.
+func (act AccessControlType) Validate() error {
+	if _, ok := AccessType_name[int32(act.AccessType)]; !ok {
+		return fmt.Errorf("invalid access type %d", act.AccessType)
+	}
+
+	seenAddresses := make(map[common.Address]bool)
+	for _, address := range act.AccessControlList {
+		if err := types.ValidateAddress(address); err != nil {
+			return fmt.Errorf("invalid address %s in access control list", address)
+		}
+
+		addr := common.HexToAddress(address)
+		if seenAddresses[addr] {
+			return fmt.Errorf("duplicate address %s in access control list", address)
+		}
+		seenAddresses[addr] = true
+	}
+
+	return nil
+}
+
+// IsAllowed returns true if the policy allows the operation to the given
+// address. Use Policy to check several addresses against the same policy.
+func (act AccessControlType) IsAllowed(address common.Address) bool {
+	return act.Policy().IsAllowed(address)
+}
+
+// AccessPolicy is the access control policy of an operation, with the
+// addresses of its access control list parsed once.
+type AccessPolicy struct {
+	accessType AccessType
+	addresses  map[common.Address]struct{}
+}
+
+// Policy parses the access control list of the policy.
+func (act AccessControlType) Policy() AccessPolicy {
+	addresses := make(map[common.Address]struct{}, len(act.AccessControlList))
+	for _, addr := range act.AccessControlList {
+		addresses[common.HexToAddress(addr)] = struct{}{}
+	}
+
+	return AccessPolicy{
+		accessType: act.AccessType,
+		addresses:  addresses,
+	}
+}
+
+// IsPermissionless returns true if the policy allows the operation to any address.
+func (p AccessPolicy) IsPermissionless() bool {
+	return p.accessType == AccessTypePermissionless
+}
+
+// IsAllowed returns true if the policy allows the operation to the given
+// address:
+//   - permissionless: any address is allowed
+//   - restricted: any address but the ones of the access control list is allowed
+//   - permissioned: only the addresses of the access control list are allowed
+func (p AccessPolicy) IsAllowed(address common.Address) bool {
+	_, listed := p.addresses[address]
+
+	switch p.accessType {
+	case AccessTypePermissionless:
+		return true
+	case AccessTypeRestricted:
+		return !listed
+	case AccessTypePermissioned:
+		return listed
+	default:
+		return false
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessControlTypeIsAllowed(t *testing.T) {
+	listed := common.HexToAddress("0xaBcDeF0000000000000000000000000000000000")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000000")
+
+	testCases := []struct {
+		name       string
+		accessType AccessType
+		expListed  bool
+		expOther   bool
+	}{
+		{"permissionless", AccessTypePermissionless, true, true},
+		{"restricted", AccessTypeRestricted, false, true},
+		{"permissioned", AccessTypePermissioned, true, false},
+		{"unknown access type", AccessType(3), false, false},
+	}
+
+	for _, tc := range testCases {
+		act := AccessControlType{
+			AccessType: tc.accessType,
+			// addresses are compared regardless of the checksum
+			AccessControlList: []string{"0xabcdef0000000000000000000000000000000000"},
+		}
+
+		require.Equal(t, tc.expListed, act.IsAllowed(listed), tc.name)
+		require.Equal(t, tc.expOther, act.IsAllowed(other), tc.name)
+		require.Equal(t, tc.accessType == AccessTypePermissionless, act.Policy().IsPermissionless(), tc.name)
+	}
+}
+
+func TestDefaultAccessControl(t *testing.T) {
+	ac := DefaultAccessControl()
+	require.NoError(t, ac.Validate())
+
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	require.True(t, ac.CanCreate(addr))
+	require.True(t, ac.CanCall(addr))
+
+	// the zero value is permissionless too, so the params stored before the
+	// access control was introduced don't restrict anyone
+	require.True(t, AccessControl{}.CanCreate(addr))
+	require.True(t, AccessControl{}.CanCall(addr))
+}
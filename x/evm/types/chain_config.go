@@ -63,7 +63,6 @@ func DefaultChainConfig() ChainConfig {
 	grayGlacierBlock := sdk.ZeroInt()
 	mergeNetsplitBlock := sdk.ZeroInt()
 	shanghaiBlock := sdk.ZeroInt()
-	cancunBlock := sdk.ZeroInt()
 
 	return ChainConfig{
 		HomesteadBlock:      &homesteadBlock,
@@ -84,7 +83,9 @@ func DefaultChainConfig() ChainConfig {
 		GrayGlacierBlock:    &grayGlacierBlock,
 		MergeNetsplitBlock:  &mergeNetsplitBlock,
 		ShanghaiBlock:       &shanghaiBlock,
-		CancunBlock:         &cancunBlock,
+		// Cancun is enabled by an upgrade once the go-ethereum fork ships its
+		// instructions
+		CancunBlock: nil,
 	}
 }
 
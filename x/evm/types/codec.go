@@ -25,7 +25,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName       = "ethermint/MsgUpdateParams"
+	registerPrecompileName = "ethermint/MsgRegisterPrecompile"
+	removePrecompileName   = "ethermint/MsgRemovePrecompile"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -44,6 +46,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgRegisterPrecompile{},
+		&MsgRemovePrecompile{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -91,4 +95,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgRegisterPrecompile{}, registerPrecompileName, nil)
+	cdc.RegisterConcrete(&MsgRemovePrecompile{}, removePrecompileName, nil)
 }
@@ -0,0 +1,98 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EntryPointVersion defines a release of the ERC-4337 EntryPoint contract.
+type EntryPointVersion string
+
+const (
+	// EntryPointV06 is the v0.6 release of the EntryPoint
+	EntryPointV06 EntryPointVersion = "v0.6"
+	// EntryPointV07 is the v0.7 release of the EntryPoint
+	EntryPointV07 EntryPointVersion = "v0.7"
+)
+
+var (
+	// EntryPointV06Address is the canonical address of the v0.6 EntryPoint,
+	// deployed through the deterministic deployment proxy.
+	EntryPointV06Address = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	// EntryPointV07Address is the canonical address of the v0.7 EntryPoint,
+	// deployed through the deterministic deployment proxy.
+	EntryPointV07Address = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+)
+
+// ParseEntryPointVersion returns the EntryPoint version of the given string.
+func ParseEntryPointVersion(version string) (EntryPointVersion, error) {
+	switch EntryPointVersion(version) {
+	case EntryPointV06, EntryPointV07:
+		return EntryPointVersion(version), nil
+	default:
+		return "", fmt.Errorf("unsupported EntryPoint version %s, expected %s or %s", version, EntryPointV06, EntryPointV07)
+	}
+}
+
+// Address returns the canonical address of the EntryPoint version.
+func (v EntryPointVersion) Address() common.Address {
+	switch v {
+	case EntryPointV06:
+		return EntryPointV06Address
+	case EntryPointV07:
+		return EntryPointV07Address
+	default:
+		return common.Address{}
+	}
+}
+
+// SenderCreatorAddress returns the address of the SenderCreator helper that
+// the EntryPoint deploys from its constructor, and whose address is embedded
+// in the EntryPoint runtime code.
+func (v EntryPointVersion) SenderCreatorAddress() common.Address {
+	// the SenderCreator is the first contract created by the EntryPoint, so the
+	// EntryPoint nonce is 1 (EIP-161)
+	return crypto.CreateAddress(v.Address(), 1)
+}
+
+// EntryPointVersionOf returns the EntryPoint version deployed at the given
+// canonical address.
+func EntryPointVersionOf(address common.Address) (EntryPointVersion, bool) {
+	switch address {
+	case EntryPointV06Address:
+		return EntryPointV06, true
+	case EntryPointV07Address:
+		return EntryPointV07, true
+	default:
+		return "", false
+	}
+}
+
+// EntryPointGenesisAccounts returns the genesis accounts that pre-deploy the
+// EntryPoint of the given version at its canonical address, together with
+// the SenderCreator the EntryPoint expects to find at its CREATE address. The
+// codes are the runtime bytecodes of the official release artifacts.
+func EntryPointGenesisAccounts(version EntryPointVersion, entryPointCode, senderCreatorCode []byte) ([]GenesisAccount, error) {
+	if _, err := ParseEntryPointVersion(string(version)); err != nil {
+		return nil, err
+	}
+
+	if len(entryPointCode) == 0 || len(senderCreatorCode) == 0 {
+		return nil, fmt.Errorf("empty EntryPoint %s runtime code", version)
+	}
+
+	return []GenesisAccount{
+		{
+			Address: version.Address().Hex(),
+			Code:    common.Bytes2Hex(entryPointCode),
+		},
+		{
+			Address: version.SenderCreatorAddress().Hex(),
+			Code:    common.Bytes2Hex(senderCreatorCode),
+		},
+	}, nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryPointVersion(t *testing.T) {
+	testCases := []struct {
+		version       string
+		address       common.Address
+		senderCreator common.Address
+	}{
+		{"v0.6", EntryPointV06Address, common.HexToAddress("0x7fc98430eaedbb6070b35b39d798725049088348")},
+		{"v0.7", EntryPointV07Address, common.HexToAddress("0xEFC2c1444eBCC4Db75e7613d20C6a62fF67A167C")},
+	}
+
+	for _, tc := range testCases {
+		version, err := ParseEntryPointVersion(tc.version)
+		require.NoError(t, err)
+		require.Equal(t, tc.address, version.Address())
+		require.Equal(t, tc.senderCreator, version.SenderCreatorAddress())
+
+		found, ok := EntryPointVersionOf(tc.address)
+		require.True(t, ok)
+		require.Equal(t, version, found)
+	}
+
+	_, err := ParseEntryPointVersion("v0.5")
+	require.Error(t, err)
+
+	_, ok := EntryPointVersionOf(common.Address{})
+	require.False(t, ok)
+}
+
+func TestEntryPointGenesisAccounts(t *testing.T) {
+	code := []byte{0x60, 0x00}
+
+	accounts, err := EntryPointGenesisAccounts(EntryPointV07, code, code)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	require.Equal(t, EntryPointV07Address.Hex(), accounts[0].Address)
+	require.Equal(t, EntryPointV07.SenderCreatorAddress().Hex(), accounts[1].Address)
+	for _, account := range accounts {
+		require.NoError(t, account.Validate())
+	}
+
+	_, err = EntryPointGenesisAccounts(EntryPointV06, nil, code)
+	require.Error(t, err)
+
+	_, err = EntryPointGenesisAccounts("v0.5", code, code)
+	require.Error(t, err)
+}
@@ -30,6 +30,10 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrInactivePrecompile
+	codeErrCreateNotPermitted
+	codeErrCallNotPermitted
+	codeErrInvalidPrecompileInstance
+	codeErrPrecompileNotFound
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -82,6 +86,18 @@ var (
 
 	// ErrInactivePrecompile returns an error if a call is made to an inactive precompile
 	ErrInactivePrecompile = errorsmod.Register(ModuleName, codeErrInactivePrecompile, "precompile not enabled")
+
+	// ErrCreateNotPermitted returns an error if the access control policy doesn't allow the sender to create contracts
+	ErrCreateNotPermitted = errorsmod.Register(ModuleName, codeErrCreateNotPermitted, "EVM Create operation is not permitted")
+
+	// ErrCallNotPermitted returns an error if the access control policy doesn't allow the sender to call contracts
+	ErrCallNotPermitted = errorsmod.Register(ModuleName, codeErrCallNotPermitted, "EVM Call operation is not permitted")
+
+	// ErrInvalidPrecompileInstance returns an error if a precompile instance of the registry can't be instantiated
+	ErrInvalidPrecompileInstance = errorsmod.Register(ModuleName, codeErrInvalidPrecompileInstance, "invalid precompile instance")
+
+	// ErrPrecompileNotFound returns an error if no precompile instance is registered at the given address
+	ErrPrecompileNotFound = errorsmod.Register(ModuleName, codeErrPrecompileNotFound, "precompile instance not found")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -24,25 +24,102 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AccessType defines the types of permissions for the operations
+type AccessType int32
+
+const (
+	// ACCESS_TYPE_PERMISSIONLESS does not restrict the operation to anyone
+	AccessTypePermissionless AccessType = 0
+	// ACCESS_TYPE_RESTRICTED denies the operation to the addresses of the
+	// access control list
+	AccessTypeRestricted AccessType = 1
+	// ACCESS_TYPE_PERMISSIONED only allows the operation to the addresses of
+	// the access control list
+	AccessTypePermissioned AccessType = 2
+)
+
+var AccessType_name = map[int32]string{
+	0: "ACCESS_TYPE_PERMISSIONLESS",
+	1: "ACCESS_TYPE_RESTRICTED",
+	2: "ACCESS_TYPE_PERMISSIONED",
+}
+
+var AccessType_value = map[string]int32{
+	"ACCESS_TYPE_PERMISSIONLESS": 0,
+	"ACCESS_TYPE_RESTRICTED":     1,
+	"ACCESS_TYPE_PERMISSIONED":   2,
+}
+
+func (x AccessType) String() string {
+	return proto.EnumName(AccessType_name, int32(x))
+}
+
+func (AccessType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{0}
+}
+
+// PrecompileType defines the kinds of parameterized precompiled contracts that
+// can be instantiated through the precompile registry
+type PrecompileType int32
+
+const (
+	// PRECOMPILE_TYPE_UNSPECIFIED defines an invalid precompile type
+	PrecompileTypeUnspecified PrecompileType = 0
+	// PRECOMPILE_TYPE_STRIDE_OUTPOST defines a Stride outpost bound to an IBC
+	// port and channel
+	PrecompileTypeStrideOutpost PrecompileType = 1
+	// PRECOMPILE_TYPE_OSMOSIS_OUTPOST defines an Osmosis outpost bound to an IBC
+	// port and channel and to the Osmosis XCS contract
+	PrecompileTypeOsmosisOutpost PrecompileType = 2
+	// PRECOMPILE_TYPE_ERC20 defines the ERC20 precompile of a registered token
+	// pair
+	PrecompileTypeERC20 PrecompileType = 3
+)
+
+var PrecompileType_name = map[int32]string{
+	0: "PRECOMPILE_TYPE_UNSPECIFIED",
+	1: "PRECOMPILE_TYPE_STRIDE_OUTPOST",
+	2: "PRECOMPILE_TYPE_OSMOSIS_OUTPOST",
+	3: "PRECOMPILE_TYPE_ERC20",
+}
+
+var PrecompileType_value = map[string]int32{
+	"PRECOMPILE_TYPE_UNSPECIFIED":     0,
+	"PRECOMPILE_TYPE_STRIDE_OUTPOST":  1,
+	"PRECOMPILE_TYPE_OSMOSIS_OUTPOST": 2,
+	"PRECOMPILE_TYPE_ERC20":           3,
+}
+
+func (x PrecompileType) String() string {
+	return proto.EnumName(PrecompileType_name, int32(x))
+}
+
+func (PrecompileType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// evm_denom represents the token denomination used to run the EVM state
 	// transitions.
-	EvmDenom string `protobuf:"bytes,1,opt,name=evm_denom,json=evmDenom,proto3" json:"evm_denom,omitempty" yaml:"evm_denom"`
+	EvmDenom string `protobuf:"bytes,1,opt,name=evm_denom,proto3" json:"evm_denom,omitempty" yaml:"evm_denom"`
 	// enable_create toggles state transitions that use the vm.Create function
-	EnableCreate bool `protobuf:"varint,2,opt,name=enable_create,json=enableCreate,proto3" json:"enable_create,omitempty" yaml:"enable_create"`
+	EnableCreate bool `protobuf:"varint,2,opt,name=enable_create,proto3" json:"enable_create,omitempty" yaml:"enable_create"`
 	// enable_call toggles state transitions that use the vm.Call function
-	EnableCall bool `protobuf:"varint,3,opt,name=enable_call,json=enableCall,proto3" json:"enable_call,omitempty" yaml:"enable_call"`
+	EnableCall bool `protobuf:"varint,3,opt,name=enable_call,proto3" json:"enable_call,omitempty" yaml:"enable_call"`
 	// extra_eips defines the additional EIPs for the vm.Config
-	ExtraEIPs []int64 `protobuf:"varint,4,rep,packed,name=extra_eips,json=extraEips,proto3" json:"extra_eips,omitempty" yaml:"extra_eips"`
+	ExtraEIPs []int64 `protobuf:"varint,4,rep,packed,name=extra_eips,proto3" json:"extra_eips,omitempty" yaml:"extra_eips"`
 	// chain_config defines the EVM chain configuration parameters
-	ChainConfig ChainConfig `protobuf:"bytes,5,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config" yaml:"chain_config"`
+	ChainConfig ChainConfig `protobuf:"bytes,5,opt,name=chain_config,proto3" json:"chain_config" yaml:"chain_config"`
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
-	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,proto3" json:"allow_unprotected_txs,omitempty"`
 	// active_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
-	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty"`
+	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,proto3" json:"active_precompiles,omitempty"`
+	// access_control defines the permission policy of the EVM for the contract
+	// deployments and calls
+	AccessControl AccessControl `protobuf:"bytes,8,opt,name=access_control,proto3" json:"access_control" yaml:"access_control"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -127,55 +204,271 @@ func (m *Params) GetActivePrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetAccessControl() AccessControl {
+	if m != nil {
+		return m.AccessControl
+	}
+	return AccessControl{}
+}
+
+// AccessControl defines the permission policy of the EVM
+// for creating and calling contracts
+type AccessControl struct {
+	// create defines the permission policy for creating contracts
+	Create AccessControlType `protobuf:"bytes,1,opt,name=create,proto3" json:"create" yaml:"create"`
+	// call defines the permission policy for calling contracts
+	Call AccessControlType `protobuf:"bytes,2,opt,name=call,proto3" json:"call" yaml:"call"`
+}
+
+func (m *AccessControl) Reset()         { *m = AccessControl{} }
+func (m *AccessControl) String() string { return proto.CompactTextString(m) }
+func (*AccessControl) ProtoMessage()    {}
+func (*AccessControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *AccessControl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessControl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl.Merge(m, src)
+}
+func (m *AccessControl) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl proto.InternalMessageInfo
+
+func (m *AccessControl) GetCreate() AccessControlType {
+	if m != nil {
+		return m.Create
+	}
+	return AccessControlType{}
+}
+
+func (m *AccessControl) GetCall() AccessControlType {
+	if m != nil {
+		return m.Call
+	}
+	return AccessControlType{}
+}
+
+// AccessControlType defines the permission type for policies
+type AccessControlType struct {
+	// access_type defines which type of permission is required for the operation
+	AccessType AccessType `protobuf:"varint,1,opt,name=access_type,proto3,enum=ethermint.evm.v1.AccessType" json:"access_type,omitempty" yaml:"access_type"`
+	// access_control_list defines the hex addresses of the accounts that are
+	// denied the operation when the access type is restricted, or allowed the
+	// operation when the access type is permissioned. It is ignored when the
+	// access type is permissionless.
+	AccessControlList []string `protobuf:"bytes,2,rep,name=access_control_list,proto3" json:"access_control_list,omitempty" yaml:"access_control_list"`
+}
+
+func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
+func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
+func (*AccessControlType) ProtoMessage()    {}
+func (*AccessControlType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+}
+func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessControlType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessControlType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessControlType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControlType.Merge(m, src)
+}
+func (m *AccessControlType) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessControlType) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControlType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControlType proto.InternalMessageInfo
+
+func (m *AccessControlType) GetAccessType() AccessType {
+	if m != nil {
+		return m.AccessType
+	}
+	return AccessTypePermissionless
+}
+
+func (m *AccessControlType) GetAccessControlList() []string {
+	if m != nil {
+		return m.AccessControlList
+	}
+	return nil
+}
+
+// PrecompileInstance defines a parameterized precompiled contract registered
+// by governance
+type PrecompileInstance struct {
+	// address defines the hex address at which the precompile is instantiated.
+	// For ERC20 precompiles it must be the ERC20 address of the token pair.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// type defines the kind of precompile to instantiate
+	Type PrecompileType `protobuf:"varint,2,opt,name=type,proto3,enum=ethermint.evm.v1.PrecompileType" json:"type,omitempty"`
+	// enabled defines if the precompile can be called. Disabled instances are
+	// kept in the registry but calls to them fail.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// port_id defines the IBC port of the outpost
+	PortID string `protobuf:"bytes,4,opt,name=port_id,proto3" json:"port_id,omitempty"`
+	// channel_id defines the IBC channel of the outpost
+	ChannelID string `protobuf:"bytes,5,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	// osmosis_xcs_contract defines the bech32 address of the Osmosis XCS
+	// contract used by the Osmosis outpost
+	OsmosisXCSContract string `protobuf:"bytes,6,opt,name=osmosis_xcs_contract,proto3" json:"osmosis_xcs_contract,omitempty"`
+}
+
+func (m *PrecompileInstance) Reset()         { *m = PrecompileInstance{} }
+func (m *PrecompileInstance) String() string { return proto.CompactTextString(m) }
+func (*PrecompileInstance) ProtoMessage()    {}
+func (*PrecompileInstance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+}
+func (m *PrecompileInstance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileInstance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileInstance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileInstance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileInstance.Merge(m, src)
+}
+func (m *PrecompileInstance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileInstance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileInstance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileInstance proto.InternalMessageInfo
+
+func (m *PrecompileInstance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileInstance) GetType() PrecompileType {
+	if m != nil {
+		return m.Type
+	}
+	return PrecompileTypeUnspecified
+}
+
+func (m *PrecompileInstance) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *PrecompileInstance) GetPortID() string {
+	if m != nil {
+		return m.PortID
+	}
+	return ""
+}
+
+func (m *PrecompileInstance) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *PrecompileInstance) GetOsmosisXCSContract() string {
+	if m != nil {
+		return m.OsmosisXCSContract
+	}
+	return ""
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
 	// homestead_block switch (nil no fork, 0 = already homestead)
-	HomesteadBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=homestead_block,json=homesteadBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"homestead_block,omitempty" yaml:"homestead_block"`
+	HomesteadBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=homestead_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"homestead_block,omitempty" yaml:"homestead_block"`
 	// dao_fork_block corresponds to TheDAO hard-fork switch block (nil no fork)
-	DAOForkBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=dao_fork_block,json=daoForkBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"dao_fork_block,omitempty" yaml:"dao_fork_block"`
+	DAOForkBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=dao_fork_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"dao_fork_block,omitempty" yaml:"dao_fork_block"`
 	// dao_fork_support defines whether the nodes supports or opposes the DAO hard-fork
-	DAOForkSupport bool `protobuf:"varint,3,opt,name=dao_fork_support,json=daoForkSupport,proto3" json:"dao_fork_support,omitempty" yaml:"dao_fork_support"`
+	DAOForkSupport bool `protobuf:"varint,3,opt,name=dao_fork_support,proto3" json:"dao_fork_support,omitempty" yaml:"dao_fork_support"`
 	// eip150_block: EIP150 implements the Gas price changes
 	// (https://github.com/ethereum/EIPs/issues/150) EIP150 HF block (nil no fork)
-	EIP150Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=eip150_block,json=eip150Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip150_block,omitempty" yaml:"eip150_block"`
+	EIP150Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=eip150_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip150_block,omitempty" yaml:"eip150_block"`
 	// eip150_hash: EIP150 HF hash (needed for header only clients as only gas pricing changed)
-	EIP150Hash string `protobuf:"bytes,5,opt,name=eip150_hash,json=eip150Hash,proto3" json:"eip150_hash,omitempty" yaml:"byzantium_block"`
+	EIP150Hash string `protobuf:"bytes,5,opt,name=eip150_hash,proto3" json:"eip150_hash,omitempty" yaml:"byzantium_block"`
 	// eip155_block: EIP155Block HF block
-	EIP155Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=eip155_block,json=eip155Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip155_block,omitempty" yaml:"eip155_block"`
+	EIP155Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=eip155_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip155_block,omitempty" yaml:"eip155_block"`
 	// eip158_block: EIP158 HF block
-	EIP158Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=eip158_block,json=eip158Block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip158_block,omitempty" yaml:"eip158_block"`
+	EIP158Block *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=eip158_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"eip158_block,omitempty" yaml:"eip158_block"`
 	// byzantium_block: Byzantium switch block (nil no fork, 0 = already on byzantium)
-	ByzantiumBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=byzantium_block,json=byzantiumBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"byzantium_block,omitempty" yaml:"byzantium_block"`
+	ByzantiumBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=byzantium_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"byzantium_block,omitempty" yaml:"byzantium_block"`
 	// constantinople_block: Constantinople switch block (nil no fork, 0 = already activated)
-	ConstantinopleBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=constantinople_block,json=constantinopleBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"constantinople_block,omitempty" yaml:"constantinople_block"`
+	ConstantinopleBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=constantinople_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"constantinople_block,omitempty" yaml:"constantinople_block"`
 	// petersburg_block: Petersburg switch block (nil same as Constantinople)
-	PetersburgBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=petersburg_block,json=petersburgBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"petersburg_block,omitempty" yaml:"petersburg_block"`
+	PetersburgBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=petersburg_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"petersburg_block,omitempty" yaml:"petersburg_block"`
 	// istanbul_block: Istanbul switch block (nil no fork, 0 = already on istanbul)
-	IstanbulBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=istanbul_block,json=istanbulBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"istanbul_block,omitempty" yaml:"istanbul_block"`
+	IstanbulBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=istanbul_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"istanbul_block,omitempty" yaml:"istanbul_block"`
 	// muir_glacier_block: Eip-2384 (bomb delay) switch block (nil no fork, 0 = already activated)
-	MuirGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=muir_glacier_block,json=muirGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"muir_glacier_block,omitempty" yaml:"muir_glacier_block"`
+	MuirGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=muir_glacier_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"muir_glacier_block,omitempty" yaml:"muir_glacier_block"`
 	// berlin_block: Berlin switch block (nil = no fork, 0 = already on berlin)
-	BerlinBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=berlin_block,json=berlinBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"berlin_block,omitempty" yaml:"berlin_block"`
+	BerlinBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=berlin_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"berlin_block,omitempty" yaml:"berlin_block"`
 	// london_block: London switch block (nil = no fork, 0 = already on london)
-	LondonBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=london_block,json=londonBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"london_block,omitempty" yaml:"london_block"`
+	LondonBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=london_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"london_block,omitempty" yaml:"london_block"`
 	// arrow_glacier_block: Eip-4345 (bomb delay) switch block (nil = no fork, 0 = already activated)
-	ArrowGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,18,opt,name=arrow_glacier_block,json=arrowGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"arrow_glacier_block,omitempty" yaml:"arrow_glacier_block"`
+	ArrowGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,18,opt,name=arrow_glacier_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"arrow_glacier_block,omitempty" yaml:"arrow_glacier_block"`
 	// gray_glacier_block: EIP-5133 (bomb delay) switch block (nil = no fork, 0 = already activated)
-	GrayGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=gray_glacier_block,json=grayGlacierBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gray_glacier_block,omitempty" yaml:"gray_glacier_block"`
+	GrayGlacierBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=gray_glacier_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gray_glacier_block,omitempty" yaml:"gray_glacier_block"`
 	// merge_netsplit_block: Virtual fork after The Merge to use as a network splitter
-	MergeNetsplitBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=merge_netsplit_block,json=mergeNetsplitBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"merge_netsplit_block,omitempty" yaml:"merge_netsplit_block"`
+	MergeNetsplitBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=merge_netsplit_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"merge_netsplit_block,omitempty" yaml:"merge_netsplit_block"`
 	// shanghai_block switch block (nil = no fork, 0 = already on shanghai)
-	ShanghaiBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,22,opt,name=shanghai_block,json=shanghaiBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
+	ShanghaiBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,22,opt,name=shanghai_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shanghai_block,omitempty" yaml:"shanghai_block"`
 	// cancun_block switch block (nil = no fork, 0 = already on cancun)
-	CancunBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,23,opt,name=cancun_block,json=cancunBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
+	CancunBlock *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,23,opt,name=cancun_block,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cancun_block,omitempty" yaml:"cancun_block"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,7 +523,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,7 +580,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,13 +637,13 @@ type Log struct {
 	// data which is supplied by the contract, usually ABI-encoded
 	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	// block_number of the block in which the transaction was included
-	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"blockNumber"`
+	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,proto3" json:"blockNumber"`
 	// tx_hash is the transaction hash
-	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"transactionHash"`
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,proto3" json:"transactionHash"`
 	// tx_index of the transaction in the block
-	TxIndex uint64 `protobuf:"varint,6,opt,name=tx_index,json=txIndex,proto3" json:"transactionIndex"`
+	TxIndex uint64 `protobuf:"varint,6,opt,name=tx_index,proto3" json:"transactionIndex"`
 	// block_hash of the block in which the transaction was included
-	BlockHash string `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"blockHash"`
+	BlockHash string `protobuf:"bytes,7,opt,name=block_hash,proto3" json:"blockHash"`
 	// index of the log in the block
 	Index uint64 `protobuf:"varint,8,opt,name=index,proto3" json:"logIndex"`
 	// removed is true if this log was reverted due to a chain
@@ -363,7 +656,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,25 +753,25 @@ type TxResult struct {
 	// contract_address contains the ethereum address of the created contract (if
 	// any). If the state transition is an evm.Call, the contract address will be
 	// empty.
-	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
 	// bloom represents the bloom filter bytes
 	Bloom []byte `protobuf:"bytes,2,opt,name=bloom,proto3" json:"bloom,omitempty"`
 	// tx_logs contains the transaction hash and the proto-compatible ethereum
 	// logs.
-	TxLogs TransactionLogs `protobuf:"bytes,3,opt,name=tx_logs,json=txLogs,proto3" json:"tx_logs" yaml:"tx_logs"`
+	TxLogs TransactionLogs `protobuf:"bytes,3,opt,name=tx_logs,proto3" json:"tx_logs" yaml:"tx_logs"`
 	// ret defines the bytes from the execution.
 	Ret []byte `protobuf:"bytes,4,opt,name=ret,proto3" json:"ret,omitempty"`
 	// reverted flag is set to true when the call has been reverted
 	Reverted bool `protobuf:"varint,5,opt,name=reverted,proto3" json:"reverted,omitempty"`
 	// gas_used notes the amount of gas consumed while execution
-	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,14 +805,14 @@ type AccessTuple struct {
 	// address is a hex formatted ethereum address
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// storage_keys are hex formatted hashes of the storage keys
-	StorageKeys []string `protobuf:"bytes,2,rep,name=storage_keys,json=storageKeys,proto3" json:"storageKeys"`
+	StorageKeys []string `protobuf:"bytes,2,rep,name=storage_keys,proto3" json:"storageKeys"`
 }
 
 func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,9 +851,9 @@ type TraceConfig struct {
 	// reexec defines the number of blocks the tracer is willing to go back
 	Reexec uint64 `protobuf:"varint,3,opt,name=reexec,proto3" json:"reexec,omitempty"`
 	// disable_stack switches stack capture
-	DisableStack bool `protobuf:"varint,5,opt,name=disable_stack,json=disableStack,proto3" json:"disableStack"`
+	DisableStack bool `protobuf:"varint,5,opt,name=disable_stack,proto3" json:"disableStack"`
 	// disable_storage switches storage capture
-	DisableStorage bool `protobuf:"varint,6,opt,name=disable_storage,json=disableStorage,proto3" json:"disableStorage"`
+	DisableStorage bool `protobuf:"varint,6,opt,name=disable_storage,proto3" json:"disableStorage"`
 	// debug can be used to print output during capture end
 	Debug bool `protobuf:"varint,8,opt,name=debug,proto3" json:"debug,omitempty"`
 	// limit defines the maximum length of output, but zero means unlimited
@@ -568,18 +861,18 @@ type TraceConfig struct {
 	// overrides can be used to execute a trace using future fork rules
 	Overrides *ChainConfig `protobuf:"bytes,10,opt,name=overrides,proto3" json:"overrides,omitempty"`
 	// enable_memory switches memory capture
-	EnableMemory bool `protobuf:"varint,11,opt,name=enable_memory,json=enableMemory,proto3" json:"enableMemory"`
+	EnableMemory bool `protobuf:"varint,11,opt,name=enable_memory,proto3" json:"enableMemory"`
 	// enable_return_data switches the capture of return data
-	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
+	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,proto3" json:"enableReturnData"`
 	// tracer_json_config configures the tracer using a JSON string
-	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerConfig"`
+	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,proto3" json:"tracerConfig"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +979,12 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 }
 
 func init() {
+	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("ethermint.evm.v1.PrecompileType", PrecompileType_name, PrecompileType_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*AccessControl)(nil), "ethermint.evm.v1.AccessControl")
+	proto.RegisterType((*AccessControlType)(nil), "ethermint.evm.v1.AccessControlType")
+	proto.RegisterType((*PrecompileInstance)(nil), "ethermint.evm.v1.PrecompileInstance")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -699,110 +997,126 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x4e, 0x23, 0xc9,
-	0x15, 0x06, 0xdc, 0x40, 0xbb, 0x6c, 0xec, 0xa6, 0xf0, 0xb0, 0xde, 0x19, 0x85, 0x26, 0x7d, 0x11,
-	0x11, 0x69, 0x07, 0x16, 0x56, 0x28, 0xa3, 0x5d, 0x25, 0x0a, 0x9e, 0x61, 0x77, 0x21, 0x93, 0x0d,
-	0x2a, 0x58, 0x45, 0x8a, 0x14, 0xb5, 0xca, 0xdd, 0xb5, 0xed, 0x5e, 0xba, 0xbb, 0xac, 0xaa, 0x6a,
-	0x8f, 0x9d, 0xe4, 0x01, 0x22, 0xe5, 0x26, 0x4f, 0x10, 0xed, 0x63, 0xe4, 0x11, 0x56, 0xb9, 0xda,
-	0xcb, 0x28, 0x17, 0xad, 0x88, 0xb9, 0xe3, 0xd2, 0x4f, 0x10, 0xd5, 0x8f, 0xdb, 0x3f, 0xa0, 0x24,
-	0xf8, 0x06, 0xea, 0x3b, 0xe7, 0xd4, 0xf7, 0x55, 0x9d, 0x3a, 0x45, 0x9d, 0x06, 0x3c, 0x27, 0xa2,
-	0x47, 0x58, 0x1a, 0x67, 0xe2, 0x88, 0x0c, 0xd2, 0xa3, 0xc1, 0xb1, 0xfc, 0x75, 0xd8, 0x67, 0x54,
-	0x50, 0xe8, 0x94, 0xbe, 0x43, 0x69, 0x1c, 0x1c, 0x3f, 0x6f, 0x45, 0x34, 0xa2, 0xca, 0x79, 0x24,
-	0x47, 0x3a, 0xce, 0xfb, 0x7b, 0x05, 0x6c, 0x5c, 0x61, 0x86, 0x53, 0x0e, 0x8f, 0x41, 0x95, 0x0c,
-	0x52, 0x3f, 0x24, 0x19, 0x4d, 0xdb, 0xab, 0xfb, 0xab, 0x07, 0xd5, 0x4e, 0x6b, 0x5c, 0xb8, 0xce,
-	0x08, 0xa7, 0xc9, 0xa7, 0x5e, 0xe9, 0xf2, 0x90, 0x4d, 0x06, 0xe9, 0x1b, 0x39, 0x84, 0x3f, 0x07,
-	0x5b, 0x24, 0xc3, 0xdd, 0x84, 0xf8, 0x01, 0x23, 0x58, 0x90, 0xf6, 0xda, 0xfe, 0xea, 0x81, 0xdd,
-	0x69, 0x8f, 0x0b, 0xb7, 0x65, 0xa6, 0xcd, 0xba, 0x3d, 0x54, 0xd7, 0xf8, 0xb5, 0x82, 0xf0, 0x67,
-	0xa0, 0x36, 0xf1, 0xe3, 0x24, 0x69, 0x57, 0xd4, 0xe4, 0xdd, 0x71, 0xe1, 0xc2, 0xf9, 0xc9, 0x38,
-	0x49, 0x3c, 0x04, 0xcc, 0x54, 0x9c, 0x24, 0xf0, 0x0c, 0x00, 0x32, 0x14, 0x0c, 0xfb, 0x24, 0xee,
-	0xf3, 0xb6, 0xb5, 0x5f, 0x39, 0xa8, 0x74, 0xbc, 0xbb, 0xc2, 0xad, 0x9e, 0x4b, 0xeb, 0xf9, 0xc5,
-	0x15, 0x1f, 0x17, 0xee, 0xb6, 0x21, 0x29, 0x03, 0x3d, 0x54, 0x55, 0xe0, 0x3c, 0xee, 0x73, 0xf8,
-	0x7b, 0x50, 0x0f, 0x7a, 0x38, 0xce, 0xfc, 0x80, 0x66, 0xdf, 0xc4, 0x51, 0x7b, 0x7d, 0x7f, 0xf5,
-	0xa0, 0x76, 0xf2, 0xa3, 0xc3, 0xc5, 0xbc, 0x1d, 0xbe, 0x96, 0x51, 0xaf, 0x55, 0x50, 0xe7, 0xc5,
-	0xf7, 0x85, 0xbb, 0x32, 0x2e, 0xdc, 0x1d, 0x4d, 0x3d, 0x4b, 0xe0, 0xa1, 0x5a, 0x30, 0x8d, 0x84,
-	0x27, 0xe0, 0x19, 0x4e, 0x12, 0xfa, 0xce, 0xcf, 0x33, 0x99, 0x68, 0x12, 0x08, 0x12, 0xfa, 0x62,
-	0xc8, 0xdb, 0x1b, 0x72, 0x93, 0x68, 0x47, 0x39, 0xbf, 0x9e, 0xfa, 0x6e, 0x86, 0x1c, 0xbe, 0x04,
-	0x10, 0x07, 0x22, 0x1e, 0x10, 0xbf, 0xcf, 0x48, 0x40, 0xd3, 0x7e, 0x9c, 0x10, 0xde, 0xde, 0xdc,
-	0xaf, 0x1c, 0x54, 0xd1, 0xb6, 0xf6, 0x5c, 0x4d, 0x1d, 0xde, 0xdf, 0xb6, 0x41, 0x6d, 0x66, 0x71,
-	0x30, 0x05, 0xcd, 0x1e, 0x4d, 0x09, 0x17, 0x04, 0x87, 0x7e, 0x37, 0xa1, 0xc1, 0xad, 0x39, 0xc5,
-	0x37, 0xff, 0x2a, 0xdc, 0x9f, 0x44, 0xb1, 0xe8, 0xe5, 0xdd, 0xc3, 0x80, 0xa6, 0x47, 0x01, 0xe5,
-	0x29, 0xe5, 0xe6, 0xd7, 0x4b, 0x1e, 0xde, 0x1e, 0x89, 0x51, 0x9f, 0xf0, 0xc3, 0x8b, 0x4c, 0x8c,
-	0x0b, 0x77, 0x57, 0xef, 0x6d, 0x81, 0xca, 0x43, 0x8d, 0xd2, 0xd2, 0x91, 0x06, 0x38, 0x02, 0x8d,
-	0x10, 0x53, 0xff, 0x1b, 0xca, 0x6e, 0x8d, 0xda, 0x9a, 0x52, 0xbb, 0xfe, 0xff, 0xd5, 0xee, 0x0a,
-	0xb7, 0xfe, 0xe6, 0xec, 0x37, 0x9f, 0x53, 0x76, 0xab, 0x38, 0xc7, 0x85, 0xfb, 0x4c, 0xab, 0xcf,
-	0x33, 0x7b, 0xa8, 0x1e, 0x62, 0x5a, 0x86, 0xc1, 0xdf, 0x02, 0xa7, 0x0c, 0xe0, 0x79, 0xbf, 0x4f,
-	0x99, 0x30, 0xc5, 0xf3, 0xf2, 0xae, 0x70, 0x1b, 0x86, 0xf2, 0x5a, 0x7b, 0xc6, 0x85, 0xfb, 0xc1,
-	0x02, 0xa9, 0x99, 0xe3, 0xa1, 0x86, 0xa1, 0x35, 0xa1, 0x90, 0x83, 0x3a, 0x89, 0xfb, 0xc7, 0xa7,
-	0x1f, 0x9b, 0x1d, 0x59, 0x6a, 0x47, 0x57, 0x4f, 0xda, 0x51, 0xed, 0xfc, 0xe2, 0xea, 0xf8, 0xf4,
-	0xe3, 0xc9, 0x86, 0x4c, 0xa9, 0xcc, 0xd2, 0x7a, 0xa8, 0xa6, 0xa1, 0xde, 0xcd, 0x05, 0x30, 0xd0,
-	0xef, 0x61, 0xde, 0x53, 0x85, 0x58, 0xed, 0x1c, 0xdc, 0x15, 0x2e, 0xd0, 0x4c, 0x5f, 0x62, 0xde,
-	0x9b, 0x9e, 0x4b, 0x77, 0xf4, 0x07, 0x9c, 0x89, 0x38, 0x4f, 0x27, 0x5c, 0x40, 0x4f, 0x96, 0x51,
-	0xe5, 0xfa, 0x4f, 0xcd, 0xfa, 0x37, 0x96, 0x5e, 0xff, 0xe9, 0x63, 0xeb, 0x3f, 0x9d, 0x5f, 0xbf,
-	0x8e, 0x29, 0x45, 0x5f, 0x19, 0xd1, 0xcd, 0xa5, 0x45, 0x5f, 0x3d, 0x26, 0xfa, 0x6a, 0x5e, 0x54,
-	0xc7, 0xc8, 0x62, 0x5f, 0xc8, 0x44, 0xdb, 0x5e, 0xbe, 0xd8, 0x1f, 0x24, 0xb5, 0x51, 0x5a, 0xb4,
-	0xdc, 0x9f, 0x40, 0x2b, 0xa0, 0x19, 0x17, 0xd2, 0x96, 0xd1, 0x7e, 0x42, 0x8c, 0x66, 0x55, 0x69,
-	0x5e, 0x3c, 0x49, 0xf3, 0x85, 0xf9, 0xe3, 0xf1, 0x08, 0x9f, 0x87, 0x76, 0xe6, 0xcd, 0x5a, 0xbd,
-	0x0f, 0x9c, 0x3e, 0x11, 0x84, 0xf1, 0x6e, 0xce, 0x22, 0xa3, 0x0c, 0x94, 0xf2, 0xf9, 0x93, 0x94,
-	0xcd, 0x3d, 0x58, 0xe4, 0xf2, 0x50, 0x73, 0x6a, 0xd2, 0x8a, 0xdf, 0x82, 0x46, 0x2c, 0x97, 0xd1,
-	0xcd, 0x13, 0xa3, 0x57, 0x53, 0x7a, 0xaf, 0x9f, 0xa4, 0x67, 0x2e, 0xf3, 0x3c, 0x93, 0x87, 0xb6,
-	0x26, 0x06, 0xad, 0x95, 0x03, 0x98, 0xe6, 0x31, 0xf3, 0xa3, 0x04, 0x07, 0x31, 0x61, 0x46, 0xaf,
-	0xae, 0xf4, 0xbe, 0x78, 0x92, 0xde, 0x87, 0x5a, 0xef, 0x21, 0x9b, 0x87, 0x1c, 0x69, 0xfc, 0x42,
-	0xdb, 0xb4, 0x6c, 0x08, 0xea, 0x5d, 0xc2, 0x92, 0x38, 0x33, 0x82, 0x5b, 0x4a, 0xf0, 0xec, 0x49,
-	0x82, 0xa6, 0x4e, 0x67, 0x79, 0x3c, 0x54, 0xd3, 0xb0, 0x54, 0x49, 0x68, 0x16, 0xd2, 0x89, 0xca,
-	0xf6, 0xf2, 0x2a, 0xb3, 0x3c, 0x1e, 0xaa, 0x69, 0xa8, 0x55, 0x86, 0x60, 0x07, 0x33, 0x46, 0xdf,
-	0x2d, 0xe4, 0x10, 0x2a, 0xb1, 0x2f, 0x9f, 0x24, 0xf6, 0x5c, 0x8b, 0x3d, 0x42, 0xe7, 0xa1, 0x6d,
-	0x65, 0x9d, 0xcb, 0x62, 0x0e, 0x60, 0xc4, 0xf0, 0x68, 0x41, 0xb8, 0xb5, 0xfc, 0xe1, 0x3d, 0x64,
-	0xf3, 0x90, 0x23, 0x8d, 0x73, 0xb2, 0x7f, 0x04, 0xad, 0x94, 0xb0, 0x88, 0xf8, 0x19, 0x11, 0xbc,
-	0x9f, 0xc4, 0xc2, 0x08, 0x3f, 0x5b, 0xfe, 0x3e, 0x3e, 0xc6, 0xe7, 0x21, 0xa8, 0xcc, 0x5f, 0x19,
-	0x6b, 0x79, 0x39, 0x78, 0x0f, 0x67, 0x51, 0x0f, 0xc7, 0x46, 0x76, 0x77, 0xf9, 0xcb, 0x31, 0xcf,
-	0xe4, 0xa1, 0xad, 0x89, 0xa1, 0xac, 0x9f, 0x00, 0x67, 0x41, 0x3e, 0xa9, 0x9f, 0x0f, 0x96, 0xaf,
-	0x9f, 0x59, 0x1e, 0xd9, 0xad, 0x28, 0xa8, 0x54, 0x2e, 0x2d, 0xbb, 0xe1, 0x34, 0x2f, 0x2d, 0xbb,
-	0xe9, 0x38, 0x97, 0x96, 0xed, 0x38, 0xdb, 0x97, 0x96, 0xbd, 0xe3, 0xb4, 0xd0, 0xd6, 0x88, 0x26,
-	0xd4, 0x1f, 0x7c, 0xa2, 0x27, 0xa1, 0x1a, 0x79, 0x87, 0xb9, 0xf9, 0x1b, 0x89, 0x1a, 0x01, 0x16,
-	0x38, 0x19, 0x71, 0x93, 0x2a, 0xe4, 0xe8, 0x04, 0xce, 0xbc, 0xda, 0x47, 0x60, 0xfd, 0x5a, 0xc8,
-	0x3e, 0xcf, 0x01, 0x95, 0x5b, 0x32, 0xd2, 0xdd, 0x08, 0x92, 0x43, 0xd8, 0x02, 0xeb, 0x03, 0x9c,
-	0xe4, 0xba, 0x61, 0xac, 0x22, 0x0d, 0xbc, 0x2b, 0xd0, 0xbc, 0x61, 0x38, 0xe3, 0xb2, 0xd7, 0xa1,
-	0xd9, 0x5b, 0x1a, 0x71, 0x08, 0x81, 0xa5, 0x5e, 0x45, 0x3d, 0x57, 0x8d, 0xe1, 0x4f, 0x81, 0x95,
-	0xd0, 0x88, 0xb7, 0xd7, 0xf6, 0x2b, 0x07, 0xb5, 0x93, 0x67, 0x0f, 0x5b, 0xb6, 0xb7, 0x34, 0x42,
-	0x2a, 0xc4, 0xfb, 0xc7, 0x1a, 0xa8, 0xbc, 0xa5, 0x11, 0x6c, 0x83, 0x4d, 0x1c, 0x86, 0x8c, 0x70,
-	0x6e, 0x98, 0x26, 0x10, 0xee, 0x82, 0x0d, 0x41, 0xfb, 0x71, 0xa0, 0xe9, 0xaa, 0xc8, 0x20, 0x29,
-	0x1c, 0x62, 0x81, 0x55, 0x5f, 0x51, 0x47, 0x6a, 0x0c, 0x4f, 0x40, 0x5d, 0xed, 0xcc, 0xcf, 0xf2,
-	0xb4, 0x4b, 0x98, 0x6a, 0x0f, 0xac, 0x4e, 0xf3, 0xbe, 0x70, 0x6b, 0xca, 0xfe, 0x95, 0x32, 0xa3,
-	0x59, 0x00, 0x3f, 0x02, 0x9b, 0x62, 0x38, 0xfb, 0xb2, 0xef, 0xdc, 0x17, 0x6e, 0x53, 0x4c, 0xb7,
-	0x29, 0x1f, 0x6e, 0xb4, 0x21, 0x86, 0xea, 0x01, 0x3f, 0x02, 0xb6, 0x18, 0xfa, 0x71, 0x16, 0x92,
-	0xa1, 0x7a, 0xbc, 0xad, 0x4e, 0xeb, 0xbe, 0x70, 0x9d, 0x99, 0xf0, 0x0b, 0xe9, 0x43, 0x9b, 0x62,
-	0xa8, 0x06, 0xf0, 0x23, 0x00, 0xf4, 0x92, 0x94, 0x82, 0x7e, 0x7a, 0xb7, 0xee, 0x0b, 0xb7, 0xaa,
-	0xac, 0x8a, 0x7b, 0x3a, 0x84, 0x1e, 0x58, 0xd7, 0xdc, 0xb6, 0xe2, 0xae, 0xdf, 0x17, 0xae, 0x9d,
-	0xd0, 0x48, 0x73, 0x6a, 0x97, 0x4c, 0x15, 0x23, 0x29, 0x1d, 0x90, 0x50, 0xbd, 0x6e, 0x36, 0x9a,
-	0x40, 0xef, 0x2f, 0x6b, 0xc0, 0xbe, 0x19, 0x22, 0xc2, 0xf3, 0x44, 0xc0, 0xcf, 0x81, 0x13, 0xd0,
-	0x4c, 0x30, 0x1c, 0x08, 0x7f, 0x2e, 0xb5, 0x9d, 0x17, 0xd3, 0x97, 0x66, 0x31, 0xc2, 0x43, 0xcd,
-	0x89, 0xe9, 0xcc, 0xe4, 0xbf, 0x05, 0xd6, 0xbb, 0x09, 0xa5, 0xa9, 0xaa, 0x84, 0x3a, 0xd2, 0x00,
-	0x22, 0x95, 0x35, 0x75, 0xca, 0x15, 0xd5, 0x98, 0xff, 0xf8, 0xe1, 0x29, 0x2f, 0x94, 0x4a, 0x67,
-	0xd7, 0x34, 0xe7, 0x0d, 0xad, 0x6d, 0xe6, 0x7b, 0x32, 0xb7, 0xaa, 0x94, 0x1c, 0x50, 0x61, 0x44,
-	0xa8, 0x43, 0xab, 0x23, 0x39, 0x84, 0xcf, 0x81, 0xcd, 0xc8, 0x80, 0x30, 0x41, 0x42, 0x75, 0x38,
-	0x36, 0x2a, 0x31, 0xfc, 0x10, 0xd8, 0x11, 0xe6, 0x7e, 0xce, 0x49, 0xa8, 0x4f, 0x02, 0x6d, 0x46,
-	0x98, 0x7f, 0xcd, 0x49, 0xf8, 0xa9, 0xf5, 0xe7, 0xef, 0xdc, 0x15, 0x0f, 0x83, 0xda, 0x59, 0x10,
-	0x10, 0xce, 0x6f, 0xf2, 0x7e, 0x42, 0xfe, 0x4b, 0x85, 0x9d, 0x80, 0x3a, 0x17, 0x94, 0xe1, 0x88,
-	0xf8, 0xb7, 0x64, 0x64, 0xea, 0x4c, 0x57, 0x8d, 0xb1, 0xff, 0x8a, 0x8c, 0x38, 0x9a, 0x05, 0x46,
-	0xe2, 0x3b, 0x0b, 0xd4, 0x6e, 0x18, 0x0e, 0x88, 0xe9, 0xf0, 0x65, 0xad, 0x4a, 0xc8, 0x8c, 0x84,
-	0x41, 0x52, 0x5b, 0xc4, 0x29, 0xa1, 0xb9, 0x30, 0xf7, 0x69, 0x02, 0xe5, 0x0c, 0x46, 0xc8, 0x90,
-	0x04, 0x2a, 0x8d, 0x16, 0x32, 0x08, 0x9e, 0x82, 0xad, 0x30, 0xe6, 0xea, 0xeb, 0x8a, 0x0b, 0x1c,
-	0xdc, 0xea, 0xed, 0x77, 0x9c, 0xfb, 0xc2, 0xad, 0x1b, 0xc7, 0xb5, 0xb4, 0xa3, 0x39, 0x04, 0x3f,
-	0x03, 0xcd, 0xe9, 0x34, 0xb5, 0x5a, 0xfd, 0x3d, 0xd3, 0x81, 0xf7, 0x85, 0xdb, 0x28, 0x43, 0x95,
-	0x07, 0x2d, 0x60, 0x79, 0xd2, 0x21, 0xe9, 0xe6, 0x91, 0x2a, 0x3e, 0x1b, 0x69, 0x20, 0xad, 0x49,
-	0x9c, 0xc6, 0x42, 0x15, 0xdb, 0x3a, 0xd2, 0x00, 0x7e, 0x06, 0xaa, 0x74, 0x40, 0x18, 0x8b, 0x43,
-	0xc2, 0x55, 0xab, 0xf3, 0xbf, 0x3e, 0xcd, 0xd0, 0x34, 0x5e, 0x6e, 0xce, 0x7c, 0x39, 0xa6, 0x24,
-	0xa5, 0x6c, 0xa4, 0x7a, 0x17, 0xb3, 0x39, 0xed, 0xf8, 0xb5, 0xb2, 0xa3, 0x39, 0x04, 0x3b, 0x00,
-	0x9a, 0x69, 0x8c, 0x88, 0x9c, 0x65, 0xbe, 0xba, 0xff, 0x75, 0x35, 0x57, 0xdd, 0x42, 0xed, 0x45,
-	0xca, 0xf9, 0x06, 0x0b, 0x8c, 0x1e, 0x58, 0xe0, 0x2f, 0x00, 0xd4, 0x67, 0xe2, 0x7f, 0xcb, 0x69,
-	0xf9, 0x6d, 0xa9, 0x5b, 0x0b, 0xa5, 0xaf, 0xbd, 0x66, 0xcd, 0x8e, 0x46, 0x97, 0x9c, 0x9a, 0x5d,
-	0x5c, 0x5a, 0xb6, 0xe5, 0xac, 0x5f, 0x5a, 0xf6, 0xa6, 0x63, 0x97, 0xf9, 0x33, 0xbb, 0x40, 0x3b,
-	0x13, 0x3c, 0xb3, 0xbc, 0xce, 0x2f, 0xbf, 0xbf, 0xdb, 0x5b, 0xfd, 0xe1, 0x6e, 0x6f, 0xf5, 0xdf,
-	0x77, 0x7b, 0xab, 0x7f, 0x7d, 0xbf, 0xb7, 0xf2, 0xc3, 0xfb, 0xbd, 0x95, 0x7f, 0xbe, 0xdf, 0x5b,
-	0xf9, 0xdd, 0xec, 0xfb, 0x40, 0x06, 0xf2, 0x79, 0xd0, 0x3f, 0x07, 0xc7, 0xa7, 0x47, 0x43, 0xf5,
-	0x0f, 0x03, 0xf5, 0x46, 0x74, 0x37, 0xd4, 0x3f, 0x02, 0x3e, 0xf9, 0x4f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x36, 0x0e, 0xa8, 0xa0, 0x4e, 0x10, 0x00, 0x00,
+	// 1899 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x98, 0x4b, 0x6f, 0x23, 0xc7,
+	0x11, 0xc7, 0x45, 0x91, 0x92, 0xc8, 0x22, 0x45, 0xce, 0xb6, 0x1e, 0xa6, 0xa9, 0x5d, 0x0d, 0x3d,
+	0x1b, 0x7b, 0x05, 0x3b, 0x96, 0x56, 0xeb, 0x55, 0xb0, 0x70, 0x1e, 0x80, 0xf8, 0x58, 0x9b, 0xb2,
+	0x76, 0x45, 0x90, 0xda, 0x3c, 0x61, 0x0c, 0x9a, 0x33, 0xbd, 0xe4, 0x44, 0x33, 0xd3, 0xc4, 0x74,
+	0x93, 0x2b, 0xe6, 0x13, 0x18, 0x3a, 0xe5, 0x94, 0x4b, 0xa0, 0x20, 0x80, 0xaf, 0xf9, 0x10, 0x39,
+	0xfa, 0x68, 0x20, 0x97, 0x20, 0x08, 0x06, 0x81, 0xf6, 0xa6, 0xa3, 0x2e, 0x01, 0x72, 0x0a, 0xa6,
+	0xbb, 0x29, 0xbe, 0x14, 0x64, 0xa5, 0x93, 0x34, 0x5d, 0xd5, 0xbf, 0x7f, 0x75, 0x55, 0xcd, 0x74,
+	0x37, 0xa1, 0x40, 0x78, 0x87, 0x04, 0x9e, 0xe3, 0xf3, 0x1d, 0xd2, 0xf7, 0x76, 0xfa, 0xbb, 0xd1,
+	0x9f, 0xed, 0x6e, 0x40, 0x39, 0x45, 0xda, 0xb5, 0x6d, 0x3b, 0x1a, 0xec, 0xef, 0x16, 0x56, 0xdb,
+	0xb4, 0x4d, 0x85, 0x71, 0x27, 0xfa, 0x4f, 0xfa, 0x19, 0x7f, 0x89, 0xc3, 0x62, 0x1d, 0x07, 0xd8,
+	0x63, 0xe8, 0x11, 0xa4, 0x48, 0xdf, 0x33, 0x6d, 0xe2, 0x53, 0x2f, 0x1f, 0x2b, 0xc6, 0xb6, 0x52,
+	0xa5, 0xd5, 0xab, 0x50, 0xd7, 0x06, 0xd8, 0x73, 0x3f, 0x37, 0xae, 0x4d, 0x06, 0xda, 0x81, 0x65,
+	0xe2, 0xe3, 0x96, 0x4b, 0x4c, 0x2b, 0x20, 0x98, 0x93, 0xfc, 0x7c, 0x31, 0xb6, 0x95, 0x2c, 0xe5,
+	0xaf, 0x42, 0x7d, 0x55, 0x39, 0x8f, 0x9b, 0x0d, 0xf4, 0x09, 0xa4, 0x87, 0x23, 0xd8, 0x75, 0xf3,
+	0x71, 0xe1, 0xbe, 0x7e, 0x15, 0xea, 0x68, 0xd2, 0x1d, 0xbb, 0xae, 0x81, 0x7e, 0x04, 0x40, 0x4e,
+	0x79, 0x80, 0x4d, 0xe2, 0x74, 0x59, 0x3e, 0x51, 0x8c, 0x6f, 0xc5, 0x4b, 0xc6, 0x45, 0xa8, 0xa7,
+	0xaa, 0xd1, 0x68, 0xb5, 0x56, 0x67, 0x57, 0xa1, 0x7e, 0x4f, 0x4d, 0xbc, 0x76, 0x34, 0x50, 0x1d,
+	0x32, 0x56, 0x07, 0x3b, 0xbe, 0x69, 0x51, 0xff, 0xb5, 0xd3, 0xce, 0x2f, 0x14, 0x63, 0x5b, 0xe9,
+	0x27, 0x0f, 0xb6, 0xa7, 0x13, 0xb1, 0x5d, 0x8e, 0xbc, 0xca, 0xc2, 0xa9, 0xb4, 0xf1, 0x5d, 0xa8,
+	0xcf, 0x5d, 0x85, 0xfa, 0x8a, 0xe4, 0x8d, 0x03, 0x0c, 0xf4, 0x00, 0xd6, 0xb0, 0xeb, 0xd2, 0x37,
+	0x66, 0xcf, 0x8f, 0x92, 0x45, 0x2c, 0x4e, 0x6c, 0x93, 0x9f, 0xb2, 0xfc, 0x62, 0xb4, 0x00, 0x54,
+	0x00, 0x84, 0x2d, 0xee, 0xf4, 0x89, 0xd9, 0x0d, 0x88, 0x45, 0xbd, 0xae, 0xe3, 0x12, 0x96, 0x5f,
+	0x2a, 0xc6, 0xb7, 0x52, 0xe8, 0xe7, 0x90, 0xc5, 0x96, 0x45, 0x18, 0x8b, 0x60, 0x3c, 0xa0, 0x6e,
+	0x3e, 0x29, 0xc2, 0xd1, 0x67, 0xc3, 0xd9, 0x17, 0x7e, 0x65, 0xe9, 0x56, 0x7a, 0xa0, 0x02, 0x5a,
+	0x93, 0x01, 0x4d, 0x42, 0x0c, 0xe3, 0xdb, 0x18, 0x2c, 0x4f, 0x4c, 0x40, 0x07, 0xb0, 0xa8, 0xaa,
+	0x10, 0x13, 0x0a, 0x0f, 0xff, 0x8f, 0xc2, 0xf1, 0xa0, 0x4b, 0x4a, 0x6b, 0x4a, 0x65, 0x59, 0x2d,
+	0x5b, 0xd5, 0xe9, 0x39, 0x24, 0x44, 0x81, 0xe6, 0xdf, 0x9d, 0xb4, 0xa2, 0x48, 0x69, 0x45, 0x8a,
+	0x4a, 0x68, 0xfc, 0x31, 0x06, 0xf7, 0x66, 0x5c, 0x51, 0x0d, 0xd2, 0x6a, 0x39, 0x7c, 0xd0, 0x95,
+	0xe1, 0x66, 0x9f, 0xdc, 0xff, 0x5f, 0x22, 0x82, 0x3e, 0xd6, 0x23, 0x63, 0x53, 0x0d, 0xf4, 0x63,
+	0x58, 0x99, 0xcc, 0x8c, 0xe9, 0x3a, 0x8c, 0xe7, 0xe7, 0xa3, 0xdc, 0x97, 0x36, 0xaf, 0x42, 0xbd,
+	0x70, 0x53, 0xfa, 0x84, 0x93, 0x61, 0xfc, 0x33, 0x06, 0xa8, 0x7e, 0x5d, 0xb1, 0x9a, 0xcf, 0x38,
+	0xf6, 0x2d, 0x82, 0x72, 0xb0, 0x84, 0x6d, 0x3b, 0x20, 0x8c, 0xc9, 0xe6, 0x47, 0xdb, 0x90, 0x10,
+	0x81, 0xce, 0x8b, 0x40, 0x8b, 0xb3, 0x81, 0x8e, 0x20, 0x62, 0x7d, 0x39, 0x58, 0x92, 0x8d, 0x6c,
+	0xcb, 0x0e, 0x47, 0x1b, 0xb0, 0xd4, 0xa5, 0x01, 0x37, 0x1d, 0x3b, 0x9f, 0x10, 0xaf, 0x13, 0x5c,
+	0x84, 0xfa, 0x62, 0x9d, 0x06, 0xbc, 0x56, 0x41, 0x1f, 0x00, 0x58, 0x1d, 0xec, 0xfb, 0xc4, 0x8d,
+	0xec, 0x0b, 0xc2, 0xbe, 0x1c, 0xb5, 0x79, 0x59, 0x8e, 0xd6, 0x2a, 0xe8, 0x29, 0xac, 0x52, 0xe6,
+	0x51, 0xe6, 0x30, 0xf3, 0xd4, 0x52, 0xab, 0xc0, 0x16, 0x17, 0xed, 0x97, 0x2a, 0xad, 0x5f, 0x84,
+	0x3a, 0x3a, 0x92, 0xf6, 0x5f, 0x96, 0x9b, 0x65, 0x65, 0x35, 0xfe, 0x9d, 0x85, 0xf4, 0x58, 0x8b,
+	0xa3, 0xaf, 0x21, 0xd7, 0xa1, 0x1e, 0x61, 0x9c, 0x60, 0xdb, 0x6c, 0xb9, 0xd4, 0x3a, 0x51, 0x2f,
+	0x77, 0xe5, 0x1f, 0xa1, 0xfe, 0x51, 0xdb, 0xe1, 0x9d, 0x5e, 0x6b, 0xdb, 0xa2, 0xde, 0x8e, 0x25,
+	0xc4, 0xd4, 0x9f, 0x4f, 0x99, 0x7d, 0xb2, 0x13, 0x2d, 0x9f, 0x6d, 0xd7, 0x7c, 0x7e, 0x15, 0xea,
+	0xeb, 0x32, 0xa3, 0x53, 0x28, 0x03, 0x9d, 0x40, 0xd6, 0xc6, 0xd4, 0x7c, 0x4d, 0x83, 0x13, 0x45,
+	0x9f, 0x17, 0xf4, 0xe6, 0xbb, 0xd3, 0x2f, 0x42, 0x3d, 0x53, 0xd9, 0x3f, 0x7a, 0x4e, 0x83, 0x93,
+	0x52, 0x44, 0x18, 0xb5, 0xff, 0x24, 0xd9, 0x40, 0x5f, 0x80, 0x76, 0x3d, 0xc4, 0x7a, 0xdd, 0x28,
+	0xbb, 0xea, 0x6b, 0xf2, 0xe9, 0x45, 0xa8, 0x67, 0x15, 0xa4, 0x29, 0x2d, 0x57, 0xa1, 0xfe, 0xde,
+	0x14, 0x46, 0xcd, 0x31, 0xd0, 0x6b, 0xc8, 0x10, 0xa7, 0xbb, 0xbb, 0xf7, 0x58, 0xc5, 0x2c, 0xeb,
+	0x53, 0xbf, 0x55, 0xcc, 0xe9, 0x6a, 0xad, 0xbe, 0xbb, 0xf7, 0x78, 0x18, 0xb2, 0xfa, 0x84, 0x8c,
+	0x63, 0x0d, 0xf4, 0x53, 0x48, 0xab, 0x81, 0x0e, 0x66, 0x1d, 0x55, 0xe6, 0xad, 0x8b, 0x50, 0x07,
+	0x39, 0xf9, 0x4b, 0xcc, 0x3a, 0xa3, 0xe4, 0xb6, 0x06, 0xbf, 0xc3, 0x3e, 0x77, 0x7a, 0xde, 0x70,
+	0xfa, 0x30, 0xcc, 0x3d, 0x15, 0xe6, 0xe2, 0x9d, 0xc3, 0xdc, 0xbb, 0x29, 0xcc, 0xbd, 0x69, 0x9d,
+	0x67, 0x4a, 0x67, 0xe9, 0xce, 0x3a, 0xcf, 0x6e, 0xd2, 0x79, 0x36, 0xd4, 0xf9, 0x1a, 0x72, 0x53,
+	0x6b, 0xcc, 0x27, 0xef, 0xde, 0x8b, 0x33, 0xe9, 0x6a, 0xc3, 0xaa, 0x45, 0xc5, 0xfb, 0xcc, 0x1d,
+	0x9f, 0x76, 0x5d, 0xa2, 0x34, 0x52, 0x42, 0xa3, 0x76, 0x2b, 0x8d, 0x0d, 0xf5, 0x41, 0xbb, 0x81,
+	0x67, 0x20, 0x13, 0xb4, 0x2e, 0xe1, 0x24, 0x60, 0xad, 0x5e, 0xd0, 0x56, 0x22, 0x20, 0x44, 0xaa,
+	0xb7, 0x12, 0x51, 0xfd, 0x39, 0xcd, 0x32, 0xd0, 0x6f, 0x20, 0xeb, 0x44, 0xba, 0xad, 0x9e, 0xab,
+	0xf0, 0x69, 0x81, 0x2f, 0xdf, 0x0a, 0xaf, 0xde, 0xa2, 0x49, 0x92, 0x81, 0x2c, 0x40, 0x5e, 0xcf,
+	0x09, 0xcc, 0xb6, 0x8b, 0x2d, 0x87, 0x04, 0x4a, 0x20, 0x23, 0x04, 0xbe, 0xb8, 0x95, 0xc0, 0xfb,
+	0x52, 0x60, 0x96, 0x66, 0xa0, 0x5f, 0x40, 0xa6, 0x45, 0x02, 0xd7, 0xf1, 0x15, 0x7e, 0x59, 0xe0,
+	0xf7, 0x6f, 0x85, 0x57, 0x3d, 0x34, 0xce, 0x11, 0x60, 0x97, 0xfa, 0x36, 0x1d, 0x82, 0xef, 0xdd,
+	0x1d, 0x3c, 0xce, 0x31, 0x10, 0x81, 0x15, 0x1c, 0x04, 0xf4, 0xcd, 0x54, 0x5e, 0x90, 0xe0, 0x7f,
+	0x79, 0x2b, 0xfe, 0x70, 0xfb, 0x99, 0xc5, 0x89, 0xec, 0xb7, 0x03, 0x3c, 0x98, 0x52, 0x59, 0xbd,
+	0x7b, 0xf6, 0x67, 0x69, 0xe2, 0x4d, 0xf0, 0x48, 0xd0, 0x26, 0xa6, 0x4f, 0x38, 0xeb, 0xba, 0x0e,
+	0x57, 0x32, 0x6b, 0x77, 0x7f, 0x13, 0x6e, 0xe2, 0x89, 0x46, 0x65, 0x1d, 0xec, 0xb7, 0x3b, 0xd8,
+	0x51, 0x12, 0xeb, 0x77, 0x6f, 0xd4, 0x49, 0x92, 0x28, 0xb5, 0x85, 0x7d, 0xab, 0x37, 0x2c, 0xf5,
+	0x7b, 0x77, 0x2f, 0xf5, 0x38, 0xc7, 0x38, 0x48, 0x24, 0xb3, 0x5a, 0xee, 0x20, 0x91, 0xcc, 0x69,
+	0xda, 0x41, 0x22, 0xa9, 0x69, 0xf7, 0x0e, 0x12, 0xc9, 0x15, 0x6d, 0xb5, 0xb1, 0x3c, 0xa0, 0x2e,
+	0x35, 0xfb, 0x9f, 0x49, 0xbf, 0x46, 0x9a, 0xbc, 0xc1, 0x4c, 0x7d, 0x5d, 0x1a, 0x59, 0x0b, 0x73,
+	0xec, 0x0e, 0x98, 0x5a, 0x7a, 0x43, 0x93, 0x09, 0x19, 0xdb, 0x9e, 0x1e, 0xc2, 0x42, 0x93, 0x63,
+	0x4e, 0x50, 0x1a, 0xe2, 0x27, 0x64, 0xa0, 0x8e, 0x11, 0xcb, 0xb0, 0xd0, 0xc7, 0x6e, 0x4f, 0x9e,
+	0x23, 0x52, 0x46, 0x05, 0x72, 0xc7, 0x01, 0xf6, 0x59, 0x74, 0x74, 0xa4, 0xfe, 0x21, 0x6d, 0x33,
+	0x94, 0x81, 0x84, 0xd8, 0x1d, 0xa4, 0xff, 0x43, 0x48, 0xb8, 0xb4, 0xcd, 0xc4, 0x61, 0x26, 0xfd,
+	0x64, 0x6d, 0xf6, 0xd8, 0x71, 0x48, 0xdb, 0xc6, 0x7f, 0x62, 0x10, 0x3f, 0xa4, 0xed, 0xd9, 0x43,
+	0x4b, 0x16, 0x16, 0x39, 0xed, 0x3a, 0x96, 0x9c, 0x9f, 0x8a, 0xd8, 0x36, 0xe6, 0x58, 0xec, 0x92,
+	0x19, 0xf4, 0x21, 0x64, 0x44, 0xa8, 0xa6, 0xdf, 0xf3, 0x5a, 0x24, 0x10, 0xdb, 0x5e, 0xa2, 0x94,
+	0xbb, 0x0c, 0xf5, 0xb4, 0x18, 0x7f, 0x29, 0x86, 0xd1, 0x0f, 0x60, 0x89, 0x9f, 0x8e, 0xef, 0x58,
+	0x2b, 0x97, 0xa1, 0x9e, 0xe3, 0xa3, 0xb0, 0xa3, 0x6d, 0x0b, 0x7d, 0x04, 0x49, 0x7e, 0x6a, 0x3a,
+	0xbe, 0x4d, 0x4e, 0xc5, 0xc6, 0x94, 0x28, 0xad, 0x5e, 0x86, 0xba, 0x36, 0xe6, 0x56, 0x8b, 0x6c,
+	0xd1, 0x49, 0x47, 0x8a, 0x0a, 0xa0, 0xdc, 0x5a, 0x96, 0x2f, 0x43, 0x3d, 0x25, 0x46, 0x05, 0x6a,
+	0x03, 0x16, 0x24, 0x27, 0x29, 0x38, 0x99, 0xcb, 0x50, 0x4f, 0xba, 0xb4, 0x2d, 0xe7, 0xe7, 0x60,
+	0x29, 0x20, 0x1e, 0xed, 0x13, 0x5b, 0x7c, 0xc8, 0x93, 0xc6, 0xdf, 0x62, 0x90, 0x3c, 0x3e, 0x6d,
+	0x10, 0xd6, 0x73, 0x39, 0xda, 0x03, 0x6d, 0x78, 0x30, 0x32, 0x27, 0x52, 0x51, 0xda, 0x18, 0x7d,
+	0x60, 0xa7, 0x3d, 0x8c, 0xa8, 0x2a, 0x2d, 0x97, 0x52, 0x4f, 0x54, 0x25, 0x83, 0x0e, 0xc4, 0x8a,
+	0x45, 0xde, 0xe3, 0xe2, 0xf0, 0xfb, 0xc1, 0x6c, 0xde, 0xa7, 0xca, 0x56, 0x5a, 0x57, 0x47, 0xdf,
+	0xac, 0xd4, 0x50, 0xf3, 0x8d, 0xa8, 0xfa, 0x01, 0xe1, 0x22, 0xb7, 0x19, 0xa4, 0x41, 0x32, 0x20,
+	0x7d, 0x12, 0x70, 0x22, 0x0f, 0x79, 0xc9, 0x68, 0xa4, 0x8d, 0x99, 0xd9, 0x63, 0xc4, 0x96, 0x69,
+	0xfb, 0x3c, 0xf1, 0xcd, 0x9f, 0xf5, 0x39, 0xe3, 0x2b, 0x48, 0xab, 0x93, 0x6f, 0xaf, 0xeb, 0xde,
+	0x70, 0x1c, 0xfd, 0x10, 0x32, 0x8c, 0xd3, 0x00, 0xb7, 0x89, 0x79, 0x42, 0x06, 0xaa, 0xbe, 0xb2,
+	0x76, 0x6a, 0xfc, 0x2b, 0x32, 0x60, 0x0a, 0xf6, 0xa7, 0x38, 0xa4, 0x8f, 0x03, 0x6c, 0x11, 0x75,
+	0x08, 0x8c, 0xda, 0x22, 0x7a, 0x0c, 0x14, 0x2c, 0x07, 0x4b, 0xdc, 0xf1, 0x08, 0xed, 0x71, 0xd9,
+	0x96, 0x91, 0x43, 0x40, 0xc8, 0x29, 0xb1, 0xc4, 0xfa, 0x13, 0xe8, 0x11, 0x2c, 0xdb, 0x0e, 0x13,
+	0xd7, 0x32, 0xc6, 0xb1, 0x75, 0x22, 0x83, 0x2f, 0x69, 0x97, 0xa1, 0x9e, 0x51, 0x86, 0x66, 0x34,
+	0x8e, 0x3e, 0x81, 0xdc, 0xc8, 0x51, 0x84, 0x21, 0xaf, 0x47, 0x25, 0x74, 0x19, 0xea, 0xd9, 0x6b,
+	0x57, 0x61, 0x89, 0xb2, 0x6e, 0x93, 0x56, 0xaf, 0x2d, 0xea, 0x9c, 0x8c, 0x1e, 0x5d, 0xc7, 0x73,
+	0xb8, 0xa8, 0xeb, 0x02, 0x7a, 0x0c, 0x29, 0xda, 0x27, 0x41, 0xe0, 0xd8, 0x84, 0xe5, 0xe1, 0x1d,
+	0xae, 0x6f, 0x51, 0x94, 0xea, 0xee, 0xe8, 0x11, 0x8f, 0x06, 0x83, 0x7c, 0x7a, 0x14, 0xa5, 0x34,
+	0xbc, 0x10, 0xe3, 0xe8, 0x31, 0x20, 0xe5, 0x18, 0x10, 0xde, 0x0b, 0x7c, 0x53, 0xbc, 0x14, 0x19,
+	0xe1, 0x2d, 0xba, 0x56, 0x5a, 0x1b, 0xc2, 0x58, 0xc1, 0x1c, 0xa3, 0x1f, 0x02, 0x92, 0x19, 0x33,
+	0x7f, 0xcb, 0xe8, 0xf5, 0xa5, 0x52, 0xee, 0x62, 0x82, 0x2f, 0xad, 0x32, 0x90, 0x83, 0x44, 0x32,
+	0xa1, 0x2d, 0x1c, 0x24, 0x92, 0x4b, 0x5a, 0xb2, 0x31, 0x5c, 0xb4, 0x8a, 0xa9, 0xb1, 0x32, 0x7c,
+	0x1e, 0x93, 0xfe, 0xf8, 0xaf, 0x31, 0x80, 0xd1, 0x45, 0x07, 0xfd, 0x04, 0x0a, 0xfb, 0xe5, 0x72,
+	0xb5, 0xd9, 0x34, 0x8f, 0x7f, 0x55, 0xaf, 0x9a, 0xf5, 0x6a, 0xe3, 0x45, 0xad, 0xd9, 0xac, 0x1d,
+	0xbd, 0x3c, 0xac, 0x36, 0x9b, 0xda, 0x5c, 0xe1, 0xfe, 0xd9, 0x79, 0x31, 0x3f, 0xf2, 0xaf, 0x47,
+	0x29, 0x61, 0xcc, 0xa1, 0xbe, 0x4b, 0x18, 0x43, 0x4f, 0x61, 0x7d, 0x7c, 0x76, 0xa3, 0xda, 0x3c,
+	0x6e, 0xd4, 0xca, 0xc7, 0xd5, 0x8a, 0x16, 0x2b, 0xe4, 0xcf, 0xce, 0x8b, 0xab, 0xa3, 0x99, 0x0d,
+	0xc2, 0x78, 0xe0, 0x44, 0x37, 0x59, 0xf4, 0x0c, 0xf2, 0x37, 0x6b, 0x56, 0x2b, 0xda, 0x7c, 0xa1,
+	0x70, 0x76, 0x5e, 0x5c, 0xbf, 0x49, 0x91, 0xd8, 0x85, 0xc4, 0x37, 0xdf, 0x6e, 0xce, 0x7d, 0xfc,
+	0x87, 0x79, 0xc8, 0x4e, 0x5d, 0x81, 0x7e, 0x06, 0x1b, 0xf5, 0x46, 0xb5, 0x7c, 0xf4, 0xa2, 0x5e,
+	0x3b, 0xac, 0x4a, 0xec, 0xab, 0x97, 0xcd, 0x7a, 0xb5, 0x5c, 0x7b, 0x5e, 0xab, 0x56, 0xb4, 0xb9,
+	0xc2, 0x83, 0xb3, 0xf3, 0xe2, 0xfb, 0x93, 0x93, 0x5e, 0xf9, 0xac, 0x4b, 0x2c, 0xe7, 0xb5, 0x43,
+	0x6c, 0x54, 0x86, 0xcd, 0xe9, 0xf9, 0xd1, 0x52, 0x2a, 0x55, 0xf3, 0xe8, 0xd5, 0x71, 0xfd, 0xa8,
+	0x79, 0xac, 0xc5, 0x0a, 0xfa, 0xd9, 0x79, 0x71, 0x63, 0x12, 0xd1, 0xe4, 0x51, 0xcf, 0x1c, 0xf5,
+	0x78, 0x97, 0x32, 0x8e, 0xaa, 0xa0, 0x4f, 0x43, 0x8e, 0x9a, 0x2f, 0x8e, 0x9a, 0xb5, 0xe6, 0x35,
+	0x65, 0xbe, 0x50, 0x3c, 0x3b, 0x2f, 0xde, 0x9f, 0xa4, 0xa8, 0xbb, 0xd4, 0x10, 0xf3, 0x04, 0xd6,
+	0xa6, 0x31, 0xd5, 0x46, 0xf9, 0xc9, 0x63, 0x2d, 0x5e, 0x78, 0xef, 0xec, 0xbc, 0xb8, 0x32, 0x39,
+	0x59, 0x98, 0x64, 0x62, 0x4a, 0xfb, 0xdf, 0x5d, 0x6c, 0xc6, 0xbe, 0xbf, 0xd8, 0x8c, 0xfd, 0xeb,
+	0x62, 0x33, 0xf6, 0xfb, 0xb7, 0x9b, 0x73, 0xdf, 0xbf, 0xdd, 0x9c, 0xfb, 0xfb, 0xdb, 0xcd, 0xb9,
+	0x5f, 0x3f, 0x1a, 0xdb, 0xb6, 0x4e, 0x30, 0xa7, 0xbb, 0xbb, 0x4f, 0x77, 0x5a, 0x03, 0x4e, 0x76,
+	0xfa, 0xbb, 0x7b, 0x3b, 0xa7, 0xe2, 0x57, 0x1c, 0xb1, 0x77, 0xb5, 0x16, 0xc5, 0xaf, 0x33, 0x9f,
+	0xfd, 0x77, 0x00, 0x13, 0xd4, 0x80, 0x30, 0xe3, 0x11, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -825,6 +1139,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AccessControl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.ActivePrecompiles) > 0 {
 		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActivePrecompiles[iNdEx])
@@ -855,21 +1179,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x2a
 	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -903,7 +1227,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
+func (m *AccessControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -913,83 +1237,229 @@ func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccessControl) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CancunBlock != nil {
-		{
-			size := m.CancunBlock.Size()
-			i -= size
-			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	if m.ShanghaiBlock != nil {
-		{
-			size := m.ShanghaiBlock.Size()
-			i -= size
-			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Create.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
 	}
-	if m.MergeNetsplitBlock != nil {
-		{
-			size := m.MergeNetsplitBlock.Size()
-			i -= size
-			if _, err := m.MergeNetsplitBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessControlType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.GrayGlacierBlock != nil {
-		{
-			size := m.GrayGlacierBlock.Size()
-			i -= size
-			if _, err := m.GrayGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	return dAtA[:n], nil
+}
+
+func (m *AccessControlType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessControlType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccessControlList) > 0 {
+		for iNdEx := len(m.AccessControlList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AccessControlList[iNdEx])
+			copy(dAtA[i:], m.AccessControlList[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AccessControlList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
 	}
-	if m.ArrowGlacierBlock != nil {
-		{
-			size := m.ArrowGlacierBlock.Size()
-			i -= size
-			if _, err := m.ArrowGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
+	if m.AccessType != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.AccessType))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecompileInstance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileInstance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileInstance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OsmosisXCSContract) > 0 {
+		i -= len(m.OsmosisXCSContract)
+		copy(dAtA[i:], m.OsmosisXCSContract)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.OsmosisXCSContract)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PortID) > 0 {
+		i -= len(m.PortID)
+		copy(dAtA[i:], m.PortID)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.PortID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancunBlock != nil {
+		{
+			size := m.CancunBlock.Size()
+			i -= size
+			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.ShanghaiBlock != nil {
+		{
+			size := m.ShanghaiBlock.Size()
+			i -= size
+			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MergeNetsplitBlock != nil {
+		{
+			size := m.MergeNetsplitBlock.Size()
+			i -= size
+			if _, err := m.MergeNetsplitBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.GrayGlacierBlock != nil {
+		{
+			size := m.GrayGlacierBlock.Size()
+			i -= size
+			if _, err := m.GrayGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.ArrowGlacierBlock != nil {
+		{
+			size := m.ArrowGlacierBlock.Size()
+			i -= size
+			if _, err := m.ArrowGlacierBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0x92
 	}
@@ -1594,6 +2064,70 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	l = m.AccessControl.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Create.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	return n
+}
+
+func (m *AccessControlType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessType != 0 {
+		n += 1 + sovEvm(uint64(m.AccessType))
+	}
+	if len(m.AccessControlList) > 0 {
+		for _, s := range m.AccessControlList {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrecompileInstance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovEvm(uint64(m.Type))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.PortID)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.OsmosisXCSContract)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -2122,6 +2656,473 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessControlType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+			}
+			m.AccessType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessType |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControlList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessControlList = append(m.AccessControlList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileInstance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileInstance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileInstance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PrecompileType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmosisXCSContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsmosisXCSContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ package types
 import (
 	"fmt"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/kato114/byte/v15/types"
 )
 
@@ -16,12 +18,13 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
-// DefaultGenesisState sets default evm genesis state with empty accounts and default params and
-// chain config values.
+// DefaultGenesisState sets default evm genesis state with empty accounts, default params and
+// chain config values, and the default precompile registry instances.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Accounts: []GenesisAccount{},
-		Params:   DefaultParams(),
+		Accounts:            []GenesisAccount{},
+		Params:              DefaultParams(),
+		PrecompileInstances: DefaultPrecompileInstances(),
 	}
 }
 
@@ -47,5 +50,16 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	if err := ValidatePrecompileInstances(gs.PrecompileInstances); err != nil {
+		return fmt.Errorf("invalid precompile registry: %w", err)
+	}
+
+	activePrecompiles := gs.Params.GetActivePrecompilesAddrs()
+	for _, instance := range gs.PrecompileInstances {
+		if slices.Contains(activePrecompiles, instance.GetAddressHex()) {
+			return fmt.Errorf("precompile %s is both active and managed by the precompile registry", instance.Address)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -29,6 +29,9 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// precompile_instances defines the parameterized precompiles of the
+	// precompile registry.
+	PrecompileInstances []PrecompileInstance `protobuf:"bytes,3,rep,name=precompile_instances,proto3" json:"precompile_instances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +81,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPrecompileInstances() []PrecompileInstance {
+	if m != nil {
+		return m.PrecompileInstances
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,26 +162,28 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4e, 0x83, 0x40,
-	0x18, 0xe7, 0x6c, 0xd3, 0xda, 0xab, 0x51, 0x73, 0x31, 0x91, 0x30, 0x5c, 0x49, 0x07, 0xc3, 0x74,
-	0x84, 0x1a, 0x9d, 0x95, 0xc5, 0xd5, 0xd0, 0xcd, 0xed, 0x0a, 0x5f, 0x28, 0x03, 0x1c, 0xe1, 0xae,
-	0x44, 0x57, 0x47, 0x27, 0x9f, 0xc3, 0x27, 0xe9, 0xd8, 0xd1, 0x49, 0x0d, 0xbc, 0x88, 0xe1, 0xa0,
-	0x4d, 0x94, 0xe5, 0xf2, 0xdd, 0xfd, 0xfe, 0x7d, 0xf7, 0xc3, 0x14, 0xd4, 0x1a, 0x8a, 0x34, 0xc9,
-	0x94, 0x0b, 0x65, 0xea, 0x96, 0x9e, 0x1b, 0x43, 0x06, 0x32, 0x91, 0x2c, 0x2f, 0x84, 0x12, 0xe4,
-	0xfc, 0x80, 0x33, 0x28, 0x53, 0x56, 0x7a, 0x96, 0xd5, 0x53, 0x34, 0x80, 0x66, 0x5b, 0x17, 0xb1,
-	0x88, 0x85, 0x1e, 0xdd, 0x66, 0x6a, 0x5f, 0xe7, 0x6f, 0x08, 0x9f, 0x3c, 0xb4, 0xae, 0x4b, 0xc5,
-	0x15, 0x10, 0x1f, 0x1f, 0xf3, 0x30, 0x14, 0x9b, 0x4c, 0x49, 0x13, 0xd9, 0x03, 0x67, 0xba, 0xb0,
-	0xd9, 0xff, 0x1c, 0xd6, 0x29, 0xee, 0x5b, 0xa2, 0x3f, 0xdc, 0x7e, 0xcd, 0x8c, 0xe0, 0xa0, 0x23,
-	0xb7, 0x78, 0x94, 0xf3, 0x82, 0xa7, 0xd2, 0x3c, 0xb2, 0x91, 0x33, 0x5d, 0x98, 0x7d, 0x87, 0x47,
-	0x8d, 0x77, 0xca, 0x8e, 0x3d, 0x7f, 0x45, 0xf8, 0xf4, 0xaf, 0x35, 0x31, 0xf1, 0x98, 0x47, 0x51,
-	0x01, 0xb2, 0xd9, 0x06, 0x39, 0x93, 0x60, 0x7f, 0x25, 0x04, 0x0f, 0x43, 0x11, 0x81, 0x8e, 0x98,
-	0x04, 0x7a, 0x26, 0x3e, 0x1e, 0x4b, 0x25, 0x0a, 0x1e, 0x83, 0x39, 0xd0, 0xbb, 0x5f, 0xf6, 0x93,
-	0xf5, 0x37, 0xfd, 0xb3, 0x26, 0xf8, 0xe3, 0x7b, 0x36, 0x5e, 0xb6, 0xfc, 0x60, 0x2f, 0xf4, 0xef,
-	0xb6, 0x15, 0x45, 0xbb, 0x8a, 0xa2, 0x9f, 0x8a, 0xa2, 0xf7, 0x9a, 0x1a, 0xbb, 0x9a, 0x1a, 0x9f,
-	0x35, 0x35, 0x9e, 0xae, 0xe2, 0x44, 0xad, 0x37, 0x2b, 0x16, 0x8a, 0xb4, 0xe9, 0x55, 0xc8, 0xee,
-	0x2c, 0xbd, 0x1b, 0xf7, 0x59, 0x17, 0xae, 0x5e, 0x72, 0x90, 0xab, 0x91, 0xae, 0xf6, 0xfa, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0xc9, 0x33, 0x2a, 0xc0, 0x01, 0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4e, 0xfa, 0x40,
+	0x10, 0xc6, 0xbb, 0x7f, 0x08, 0xfc, 0x59, 0x88, 0x98, 0x86, 0xc4, 0x86, 0xc3, 0x42, 0x88, 0x89,
+	0x9c, 0x76, 0x53, 0xd4, 0x44, 0x8f, 0x70, 0x51, 0x6f, 0x26, 0xdc, 0xbc, 0x98, 0xa5, 0x4c, 0x4a,
+	0xd5, 0x76, 0x9b, 0xee, 0xd0, 0xc8, 0x5b, 0xf8, 0x1c, 0x3e, 0x86, 0x27, 0x8e, 0x1c, 0x3d, 0xa9,
+	0x81, 0x17, 0x31, 0xdd, 0x36, 0x24, 0x5a, 0x6f, 0x93, 0xfd, 0x7e, 0xdf, 0x7c, 0xb3, 0x33, 0x94,
+	0x01, 0x2e, 0x20, 0x09, 0x83, 0x08, 0x05, 0xa4, 0xa1, 0x48, 0x5d, 0xe1, 0x43, 0x04, 0x3a, 0xd0,
+	0x3c, 0x4e, 0x14, 0x2a, 0xfb, 0x70, 0xaf, 0x73, 0x48, 0x43, 0x9e, 0xba, 0xdd, 0x6e, 0xc9, 0x91,
+	0x09, 0x86, 0xee, 0x76, 0x7c, 0xe5, 0x2b, 0x53, 0x8a, 0xac, 0xca, 0x5f, 0x07, 0x6f, 0x84, 0xb6,
+	0xae, 0xf2, 0xae, 0x53, 0x94, 0x08, 0xf6, 0x05, 0xfd, 0x2f, 0x3d, 0x4f, 0x2d, 0x23, 0xd4, 0x0e,
+	0xe9, 0x57, 0x86, 0xcd, 0x51, 0x9f, 0xff, 0xce, 0xe1, 0x85, 0x63, 0x9c, 0x83, 0x93, 0xea, 0xfa,
+	0xa3, 0x67, 0xd9, 0x9c, 0xd6, 0x62, 0x99, 0xc8, 0x50, 0x3b, 0xff, 0xfa, 0x64, 0xd8, 0x1c, 0x39,
+	0x65, 0xdf, 0xad, 0xd1, 0x0b, 0xfe, 0x9a, 0x76, 0xe2, 0x04, 0x3c, 0x15, 0xc6, 0xc1, 0x13, 0xdc,
+	0x07, 0x91, 0x46, 0x19, 0x79, 0xa0, 0x9d, 0x8a, 0x49, 0x3d, 0xfe, 0xc3, 0xbd, 0xa7, 0x6f, 0x0a,
+	0x38, 0xef, 0x34, 0x78, 0xa0, 0x07, 0x3f, 0x27, 0xb2, 0xdb, 0xb4, 0x2e, 0xe7, 0xf3, 0x04, 0x74,
+	0xf6, 0x09, 0x32, 0x6c, 0xd8, 0x2d, 0x5a, 0xf5, 0xd4, 0x1c, 0xcc, 0x68, 0x0d, 0xfb, 0x92, 0xd6,
+	0x35, 0xaa, 0x44, 0xfa, 0x50, 0xa4, 0x1d, 0x95, 0xd3, 0xcc, 0x3a, 0x26, 0xed, 0x2c, 0xe0, 0xf5,
+	0xb3, 0x57, 0x9f, 0xe6, 0xfc, 0x64, 0xbc, 0xde, 0x32, 0xb2, 0xd9, 0x32, 0xf2, 0xb5, 0x65, 0xe4,
+	0x65, 0xc7, 0xac, 0xcd, 0x8e, 0x59, 0xef, 0x3b, 0x66, 0xdd, 0x9d, 0xf8, 0x01, 0x2e, 0x96, 0x33,
+	0xee, 0xa9, 0x50, 0x3c, 0x4a, 0x54, 0xae, 0x7b, 0x26, 0x66, 0x2b, 0x04, 0x91, 0xba, 0xe7, 0xe2,
+	0xd9, 0x1c, 0x04, 0x57, 0x31, 0xe8, 0x59, 0xcd, 0xac, 0xfe, 0xf4, 0x7b, 0x00, 0x4e, 0x5b, 0x2b,
+	0xe0, 0xe0, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +206,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrecompileInstances) > 0 {
+		for iNdEx := len(m.PrecompileInstances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrecompileInstances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +323,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PrecompileInstances) > 0 {
+		for _, e := range m.PrecompileInstances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +457,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileInstances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrecompileInstances = append(m.PrecompileInstances, PrecompileInstance{})
+			if err := m.PrecompileInstances[len(m.PrecompileInstances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -211,6 +211,20 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "active precompile managed by the registry",
+			genState: func() *GenesisState {
+				instance := NewPrecompileInstance(common.HexToAddress("0x0000000000000000000000000000000000000a00"), PrecompileTypeStrideOutpost, true)
+				instance.PortID = "transfer"
+				instance.ChannelID = "channel-7"
+
+				genState := DefaultGenesisState()
+				genState.Params.ActivePrecompiles = append(genState.Params.ActivePrecompiles, instance.Address)
+				genState.PrecompileInstances = append(genState.PrecompileInstances, instance)
+				return genState
+			}(),
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
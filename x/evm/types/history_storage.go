@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// HistoryServeWindow is the number of block hashes kept by the history
+// storage contract.
+const HistoryServeWindow = 8192
+
+var (
+	// HistoryStorageAddress is the address of the history storage contract, as
+	// defined on EIP-2935.
+	HistoryStorageAddress = common.HexToAddress("0x0000F90827F1C53a10cb7A02335B175320002935")
+
+	// HistoryStorageCode is the runtime code of the history storage contract.
+	// It's called with a 32 bytes block number and returns the hash of the
+	// block, stored at the slot (number % HistoryServeWindow) of the contract
+	// storage. It reverts if the block is not one of the last HistoryServeWindow
+	// ones.
+	//
+	// Unlike EIP-2935, the hashes are written by the EVM module on BeginBlock
+	// instead of a system call, so the contract only serves reads:
+	//
+	//	PUSH1 0x20 CALLDATASIZE EQ ISZERO PUSH1 0x2a JUMPI
+	//	PUSH1 0x00 CALLDATALOAD DUP1 NUMBER GT ISZERO PUSH1 0x2a JUMPI
+	//	PUSH2 0x2000 DUP2 NUMBER SUB GT PUSH1 0x2a JUMPI
+	//	PUSH2 0x2000 SWAP1 MOD SLOAD PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	//	JUMPDEST PUSH1 0x00 DUP1 REVERT
+	HistoryStorageCode = hexutil.MustDecode(
+		"0x6020361415602a5760003580431115602a5761200081430311602a57612000900654600052602060" +
+			"00f35b600080fd",
+	)
+)
+
+// HistoryStorageSlot returns the slot of the history storage contract that
+// holds the hash of the given block.
+func HistoryStorageSlot(height int64) common.Hash {
+	return common.BigToHash(big.NewInt(height % HistoryServeWindow))
+}
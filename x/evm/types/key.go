@@ -28,6 +28,7 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixPrecompileInstance
 )
 
 // prefix bytes for the EVM transient store
@@ -40,9 +41,10 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixCode    = []byte{prefixCode}
-	KeyPrefixStorage = []byte{prefixStorage}
-	KeyPrefixParams  = []byte{prefixParams}
+	KeyPrefixCode               = []byte{prefixCode}
+	KeyPrefixStorage            = []byte{prefixStorage}
+	KeyPrefixParams             = []byte{prefixParams}
+	KeyPrefixPrecompileInstance = []byte{prefixPrecompileInstance}
 )
 
 // Transient Store key prefixes
@@ -0,0 +1,105 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// OverrideAccount indicates the overriding fields of an account during the
+// execution of a message call.
+//
+// NOTE: state and stateDiff can't be specified at the same time. If state is
+// set, the message execution will only use the data in the given state. If
+// stateDiff is set, all the diffs will be applied first and then the message
+// call will be executed. If movePrecompileToAddress is set, the precompiled
+// contract at the account address is made available at the given address
+// instead, so that the original address can be overridden (e.g. with code).
+type OverrideAccount struct {
+	Nonce            *hexutil.Uint64              `json:"nonce"`
+	Code             *hexutil.Bytes               `json:"code"`
+	Balance          **hexutil.Big                `json:"balance"`
+	State            *map[common.Hash]common.Hash `json:"state"`
+	StateDiff        *map[common.Hash]common.Hash `json:"stateDiff"`
+	MovePrecompileTo *common.Address              `json:"movePrecompileToAddress,omitempty"`
+}
+
+// StateOverride is the collection of overridden accounts, in the same format
+// as the go-ethereum JSON-RPC `stateOverrides` argument.
+type StateOverride map[common.Address]OverrideAccount
+
+// Validate performs a stateless validation of the account overrides.
+func (diff StateOverride) Validate() error {
+	destinations := make(map[common.Address]bool)
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		if account.MovePrecompileTo == nil {
+			continue
+		}
+
+		dst := *account.MovePrecompileTo
+		if dst == addr {
+			return fmt.Errorf("account %s cannot be moved to its own address", addr.Hex())
+		}
+		if _, ok := diff[dst]; ok {
+			return fmt.Errorf("account %s is already overridden", dst.Hex())
+		}
+		if destinations[dst] {
+			return fmt.Errorf("account %s is the destination of more than one precompile", dst.Hex())
+		}
+		destinations[dst] = true
+	}
+	return nil
+}
+
+// MovedPrecompiles returns the mapping of the precompiled contract addresses
+// to the addresses they are moved to.
+func (diff StateOverride) MovedPrecompiles() map[common.Address]common.Address {
+	moved := make(map[common.Address]common.Address)
+	for addr, account := range diff {
+		if account.MovePrecompileTo != nil {
+			moved[addr] = *account.MovePrecompileTo
+		}
+	}
+	return moved
+}
+
+// BlockOverrides is the set of header fields to override when executing a
+// message call, in the same format as the go-ethereum JSON-RPC
+// `blockOverrides` argument.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// Validate performs a stateless validation of the block overrides.
+func (bo BlockOverrides) Validate() error {
+	if bo.Number != nil && (bo.Number.ToInt().Sign() <= 0 || !bo.Number.ToInt().IsInt64()) {
+		return fmt.Errorf("invalid block number override %s", bo.Number)
+	}
+	if bo.Time != nil && uint64(*bo.Time) > math.MaxInt64 {
+		return fmt.Errorf("invalid block time override %d", uint64(*bo.Time))
+	}
+	if bo.BaseFee != nil && bo.BaseFee.ToInt().Sign() < 0 {
+		return fmt.Errorf("invalid base fee override %s", bo.BaseFee)
+	}
+	return nil
+}
+
+// TraceCallConfig is the config for the `debug_traceCall` JSON-RPC method. It
+// extends the trace config with the state and block overrides that are applied
+// before executing the call.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides,omitempty"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides,omitempty"`
+}
@@ -31,7 +31,6 @@ var (
 		"0x0000000000000000000000000000000000000801", // Distribution precompile
 		"0x0000000000000000000000000000000000000802", // ICS20 transfer precompile
 		"0x0000000000000000000000000000000000000803", // Vesting precompile
-		"0x0000000000000000000000000000000000000900", // Stride outpost
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
 	// On v15, EIP 3855 was enabled
@@ -46,6 +45,7 @@ func NewParams(
 	enableCall bool,
 	config ChainConfig,
 	extraEIPs []int64,
+	accessControl AccessControl,
 	activePrecompiles ...string,
 ) Params {
 	return Params{
@@ -56,6 +56,7 @@ func NewParams(
 		ExtraEIPs:           extraEIPs,
 		ChainConfig:         config,
 		ActivePrecompiles:   activePrecompiles,
+		AccessControl:       accessControl,
 	}
 }
 
@@ -72,6 +73,7 @@ func DefaultParams() Params {
 		ExtraEIPs:           DefaultExtraEIPs,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
 		ActivePrecompiles:   AvailableEVMExtensions,
+		AccessControl:       DefaultAccessControl(),
 	}
 }
 
@@ -101,7 +103,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validatePrecompiles(p.ActivePrecompiles)
+	if err := validatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
+	return validateAccessControl(p.AccessControl)
 }
 
 // EIPs returns the ExtraEIPS as a int slice
@@ -192,13 +198,47 @@ func validatePrecompiles(i interface{}) error {
 			return fmt.Errorf("invalid precompile %s", precompile)
 		}
 
+		// the Stride outpost is managed by the precompile registry
+		if common.HexToAddress(precompile) == common.HexToAddress(StrideOutpostAddress) {
+			return fmt.Errorf("precompile %s is managed by the precompile registry", precompile)
+		}
+
 		seenPrecompiles[precompile] = true
 	}
 
 	return nil
 }
 
+func validateAccessControl(i interface{}) error {
+	accessControl, ok := i.(AccessControl)
+	if !ok {
+		return fmt.Errorf("invalid access control type: %T", i)
+	}
+
+	return accessControl.Validate()
+}
+
 // IsLondon returns if london hardfork is enabled.
 func IsLondon(ethConfig *params.ChainConfig, height int64) bool {
 	return ethConfig.IsLondon(big.NewInt(height))
 }
+
+// IsShanghai returns if shanghai hardfork is enabled.
+func IsShanghai(ethConfig *params.ChainConfig, height int64) bool {
+	return ethConfig.IsShanghai(big.NewInt(height))
+}
+
+// ForkEIPs returns the EIPs of the enabled hardforks that are not part of the
+// jump tables of the go-ethereum fork, and are enabled as extra EIPs instead.
+//
+// NOTE: the go-ethereum fork has no EIP-1153 (TSTORE, TLOAD) and EIP-5656
+// (MCOPY) instructions yet, so the transient storage of the StateDB can't be
+// reached by contracts until the fork ships them for Cancun.
+func ForkEIPs(ethConfig *params.ChainConfig, height int64) []int {
+	var eips []int
+	if IsShanghai(ethConfig, height) {
+		// PUSH0 instruction
+		eips = append(eips, 3855)
+	}
+	return eips
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/params"
@@ -18,9 +19,62 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(DefaultEVMDenom, false, true, true, DefaultChainConfig(), extraEips),
+			NewParams(DefaultEVMDenom, false, true, true, DefaultChainConfig(), extraEips, DefaultAccessControl()),
 			false,
 		},
+		{
+			"valid access control",
+			NewParams(DefaultEVMDenom, false, true, true, DefaultChainConfig(), extraEips, AccessControl{
+				Create: AccessControlType{
+					AccessType:        AccessTypePermissioned,
+					AccessControlList: []string{"0x1000000000000000000000000000000000000000"},
+				},
+				Call: AccessControlType{
+					AccessType:        AccessTypeRestricted,
+					AccessControlList: []string{"0x2000000000000000000000000000000000000000"},
+				},
+			}),
+			false,
+		},
+		{
+			"invalid access type",
+			NewParams(DefaultEVMDenom, false, true, true, DefaultChainConfig(), extraEips, AccessControl{
+				Create: AccessControlType{AccessType: AccessType(3)},
+			}),
+			true,
+		},
+		{
+			"invalid access control address",
+			NewParams(DefaultEVMDenom, false, true, true, DefaultChainConfig(), extraEips, AccessControl{
+				Call: AccessControlType{
+					AccessType:        AccessTypeRestricted,
+					AccessControlList: []string{"invalid"},
+				},
+			}),
+			true,
+		},
+		{
+			"duplicate access control address",
+			NewParams(DefaultEVMDenom, false, true, true, DefaultChainConfig(), extraEips, AccessControl{
+				Create: AccessControlType{
+					AccessType: AccessTypePermissioned,
+					AccessControlList: []string{
+						"0x1000000000000000000000000000000000000000",
+						"0x1000000000000000000000000000000000000000",
+					},
+				},
+			}),
+			true,
+		},
+		{
+			"precompile managed by the registry",
+			func() Params {
+				params := DefaultParams()
+				params.ActivePrecompiles = append(params.ActivePrecompiles, StrideOutpostAddress)
+				return params
+			}(),
+			true,
+		},
 		{
 			"empty",
 			Params{},
@@ -56,7 +110,7 @@ func TestParamsValidate(t *testing.T) {
 
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
-	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips)
+	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, DefaultAccessControl())
 	actual := params.EIPs()
 
 	require.Equal(t, []int{2929, 1884, 1344}, actual)
@@ -128,3 +182,34 @@ func TestIsLondon(t *testing.T) {
 		require.Equal(t, IsLondon(ethConfig, tc.height), tc.result)
 	}
 }
+
+func TestForkEIPs(t *testing.T) {
+	ethConfig := DefaultChainConfig().EthereumConfig(big.NewInt(9000))
+	ethConfig.ShanghaiBlock = big.NewInt(10)
+
+	testCases := []struct {
+		name   string
+		height int64
+		result []int
+	}{
+		{
+			"Before shanghai block",
+			5,
+			nil,
+		},
+		{
+			"shanghai block",
+			10,
+			[]int{3855},
+		},
+		{
+			"After shanghai block",
+			11,
+			[]int{3855},
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.result, ForkEIPs(ethConfig, tc.height), tc.name)
+	}
+}
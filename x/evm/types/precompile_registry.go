@@ -0,0 +1,134 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/kato114/byte/v15/types"
+)
+
+const (
+	// StrideOutpostAddress is the address of the Stride outpost, which was
+	// built at app startup before the precompile registry
+	StrideOutpostAddress = "0x0000000000000000000000000000000000000900"
+	// StrideOutpostChannelID is the channel of the Stride outpost built at app
+	// startup
+	StrideOutpostChannelID = "channel-25"
+)
+
+// DefaultPrecompileInstances returns the precompile registry instances of the
+// default genesis state: the Stride outpost, at the address and on the
+// channel it had as a static precompile.
+func DefaultPrecompileInstances() []PrecompileInstance {
+	return []PrecompileInstance{
+		{
+			Address:   StrideOutpostAddress,
+			Type:      PrecompileTypeStrideOutpost,
+			Enabled:   true,
+			PortID:    transfertypes.PortID,
+			ChannelID: StrideOutpostChannelID,
+		},
+	}
+}
+
+// NewPrecompileInstance returns a new precompile instance of the given type
+// at the given address.
+func NewPrecompileInstance(address common.Address, precompileType PrecompileType, enabled bool) PrecompileInstance {
+	return PrecompileInstance{
+		Address: address.Hex(),
+		Type:    precompileType,
+		Enabled: enabled,
+	}
+}
+
+// GetAddressHex returns the address of the precompile instance.
+func (pi PrecompileInstance) GetAddressHex() common.Address {
+	return common.HexToAddress(pi.Address)
+}
+
+// Validate performs a stateless validation of the precompile instance. The
+// instance address can't be the one of a static precompiled contract.
+func (pi PrecompileInstance) Validate() error {
+	if err := types.ValidateAddress(pi.Address); err != nil {
+		return fmt.Errorf("invalid precompile address %s: %w", pi.Address, err)
+	}
+
+	if IsStaticPrecompile(pi.GetAddressHex()) {
+		return fmt.Errorf("address %s is reserved by a static precompile", pi.Address)
+	}
+
+	switch pi.Type {
+	case PrecompileTypeStrideOutpost:
+		return validateOutpostChannel(pi.PortID, pi.ChannelID)
+	case PrecompileTypeOsmosisOutpost:
+		if err := validateOutpostChannel(pi.PortID, pi.ChannelID); err != nil {
+			return err
+		}
+		if _, _, err := bech32.DecodeAndConvert(pi.OsmosisXCSContract); err != nil {
+			return fmt.Errorf("invalid osmosis XCS contract %s: %w", pi.OsmosisXCSContract, err)
+		}
+		return nil
+	case PrecompileTypeERC20:
+		if pi.PortID != "" || pi.ChannelID != "" || pi.OsmosisXCSContract != "" {
+			return fmt.Errorf("ERC20 precompile %s doesn't take IBC configuration", pi.Address)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid precompile type %d", pi.Type)
+	}
+}
+
+// IsStaticPrecompile returns true if the address belongs to one of the
+// precompiled contracts that are built at app startup.
+func IsStaticPrecompile(address common.Address) bool {
+	if _, ok := vm.PrecompiledContractsBerlin[address]; ok {
+		return true
+	}
+
+	for _, extension := range AvailableEVMExtensions {
+		if strings.EqualFold(extension, address.Hex()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidatePrecompileInstances checks each precompile instance and that no
+// address is registered twice.
+func ValidatePrecompileInstances(instances []PrecompileInstance) error {
+	seenAddresses := make(map[common.Address]bool)
+	for _, instance := range instances {
+		if err := instance.Validate(); err != nil {
+			return err
+		}
+
+		address := instance.GetAddressHex()
+		if seenAddresses[address] {
+			return fmt.Errorf("duplicate precompile instance %s", instance.Address)
+		}
+		seenAddresses[address] = true
+	}
+
+	return nil
+}
+
+func validateOutpostChannel(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return fmt.Errorf("invalid outpost port %s: %w", portID, err)
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return fmt.Errorf("invalid outpost channel %s: %w", channelID, err)
+	}
+
+	return nil
+}
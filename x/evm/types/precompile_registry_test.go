@@ -0,0 +1,149 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecompileInstanceValidate(t *testing.T) {
+	address := common.HexToAddress("0x0000000000000000000000000000000000000a00")
+
+	strideOutpost := NewPrecompileInstance(address, PrecompileTypeStrideOutpost, true)
+	strideOutpost.PortID = "transfer"
+	strideOutpost.ChannelID = "channel-25"
+
+	osmosisOutpost := NewPrecompileInstance(address, PrecompileTypeOsmosisOutpost, true)
+	osmosisOutpost.PortID = "transfer"
+	osmosisOutpost.ChannelID = "channel-0"
+	xcsContract, err := bech32.ConvertAndEncode("osmo", address.Bytes())
+	require.NoError(t, err)
+	osmosisOutpost.OsmosisXCSContract = xcsContract
+
+	testCases := []struct {
+		name     string
+		malleate func() PrecompileInstance
+		expError bool
+	}{
+		{
+			"valid stride outpost",
+			func() PrecompileInstance { return strideOutpost },
+			false,
+		},
+		{
+			"valid disabled stride outpost",
+			func() PrecompileInstance {
+				instance := strideOutpost
+				instance.Enabled = false
+				return instance
+			},
+			false,
+		},
+		{
+			"valid osmosis outpost",
+			func() PrecompileInstance { return osmosisOutpost },
+			false,
+		},
+		{
+			"valid ERC20 precompile",
+			func() PrecompileInstance {
+				return NewPrecompileInstance(address, PrecompileTypeERC20, true)
+			},
+			false,
+		},
+		{
+			"invalid address",
+			func() PrecompileInstance {
+				instance := strideOutpost
+				instance.Address = "0x1"
+				return instance
+			},
+			true,
+		},
+		{
+			"address of a static precompile",
+			func() PrecompileInstance {
+				instance := strideOutpost
+				instance.Address = "0x0000000000000000000000000000000000000803"
+				return instance
+			},
+			true,
+		},
+		{
+			"address of an ethereum precompile",
+			func() PrecompileInstance {
+				instance := strideOutpost
+				instance.Address = "0x0000000000000000000000000000000000000001"
+				return instance
+			},
+			true,
+		},
+		{
+			"unspecified type",
+			func() PrecompileInstance {
+				return NewPrecompileInstance(address, PrecompileTypeUnspecified, true)
+			},
+			true,
+		},
+		{
+			"outpost without channel",
+			func() PrecompileInstance {
+				instance := strideOutpost
+				instance.ChannelID = ""
+				return instance
+			},
+			true,
+		},
+		{
+			"outpost with invalid port",
+			func() PrecompileInstance {
+				instance := strideOutpost
+				instance.PortID = "a"
+				return instance
+			},
+			true,
+		},
+		{
+			"osmosis outpost with invalid XCS contract",
+			func() PrecompileInstance {
+				instance := osmosisOutpost
+				instance.OsmosisXCSContract = "placeholder"
+				return instance
+			},
+			true,
+		},
+		{
+			"ERC20 precompile with channel",
+			func() PrecompileInstance {
+				instance := NewPrecompileInstance(address, PrecompileTypeERC20, true)
+				instance.ChannelID = "channel-0"
+				return instance
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.malleate().Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidatePrecompileInstances(t *testing.T) {
+	first := NewPrecompileInstance(common.HexToAddress("0x0000000000000000000000000000000000000a00"), PrecompileTypeERC20, true)
+	second := NewPrecompileInstance(common.HexToAddress("0x0000000000000000000000000000000000000a01"), PrecompileTypeERC20, false)
+
+	require.NoError(t, ValidatePrecompileInstances(nil))
+	require.NoError(t, ValidatePrecompileInstances([]PrecompileInstance{first, second}))
+
+	// addresses are compared regardless of the checksum
+	duplicate := first
+	duplicate.Address = "0x0000000000000000000000000000000000000A00"
+	require.Error(t, ValidatePrecompileInstances([]PrecompileInstance{first, duplicate}))
+}
@@ -24,3 +24,15 @@ func (m QueryTraceBlockRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker
 	}
 	return nil
 }
+
+func (m QueryStorageRangeAtRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.Predecessors {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.UnpackInterfaces(unpacker)
+}
@@ -0,0 +1,112 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/kato114/byte/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// MaxSimulateBlocks is the maximum number of blocks that can be simulated on a
+// single eth_simulateV1 request.
+const MaxSimulateBlocks = 256
+
+// MaxSimulateCalls is the maximum number of calls, over all the blocks, that
+// can be simulated on a single eth_simulateV1 request.
+const MaxSimulateCalls = 1000
+
+// SimulateTimestampIncrement is the default number of seconds between two
+// consecutive simulated blocks.
+const SimulateTimestampIncrement = 12
+
+const (
+	// SimErrCodeReverted is the JSON-RPC error code of a reverted call.
+	SimErrCodeReverted = 3
+	// SimErrCodeVMError is the JSON-RPC error code of a call that failed with
+	// an EVM error other than a revert.
+	SimErrCodeVMError = -32015
+)
+
+// SimulateOpts are the inputs of the `eth_simulateV1` JSON-RPC method.
+type SimulateOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	// TraceTransfers adds the native value transfers as ERC-20 Transfer logs
+	// emitted by the 0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE address
+	TraceTransfers bool `json:"traceTransfers"`
+	// Validation enables the nonce, base fee and balance checks performed on
+	// regular transactions
+	Validation bool `json:"validation"`
+}
+
+// SimBlock is a simulated block: the calls are executed in order on top of the
+// state resulting from the previous blocks, with the overrides applied first.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride    `json:"stateOverrides,omitempty"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// Validate performs a stateless validation of the simulation options.
+func (opts SimulateOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+
+	calls := 0
+	for i, block := range opts.BlockStateCalls {
+		calls += len(block.Calls)
+		if calls > MaxSimulateCalls {
+			return fmt.Errorf("too many calls: more than %d", MaxSimulateCalls)
+		}
+
+		if block.BlockOverrides != nil {
+			if err := block.BlockOverrides.Validate(); err != nil {
+				return fmt.Errorf("block %d: %w", i, err)
+			}
+		}
+		if block.StateOverrides != nil {
+			if err := block.StateOverrides.Validate(); err != nil {
+				return fmt.Errorf("block %d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult struct {
+	Number        hexutil.Uint64  `json:"number"`
+	Hash          common.Hash     `json:"hash"`
+	ParentHash    common.Hash     `json:"parentHash"`
+	Timestamp     hexutil.Uint64  `json:"timestamp"`
+	GasLimit      hexutil.Uint64  `json:"gasLimit"`
+	GasUsed       hexutil.Uint64  `json:"gasUsed"`
+	Miner         common.Address  `json:"miner"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	Calls         []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call. The data holds the hex
+// encoded return data of a reverted call.
+type SimCallError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+	Data    string `json:"data,omitempty"`
+}
@@ -46,8 +46,9 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
-	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
-	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
+	TxHash *common.Hash `json:"txHash,omitempty"` // Hash of the traced transaction
+	Result interface{}  `json:"result,omitempty"` // Trace results produced by the tracer
+	Error  string       `json:"error,omitempty"`  // Trace failure produced by the tracer
 }
 
 var _ vm.EVMLogger = &NoOpTracer{}
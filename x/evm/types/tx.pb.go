@@ -88,7 +88,7 @@ type LegacyTx struct {
 	// nonce corresponds to the account nonce (transaction sequence).
 	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// gas_price defines the value for each gas unit
-	GasPrice *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_price,omitempty"`
+	GasPrice *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=gas_price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_price,omitempty"`
 	// gas defines the gas limit defined for the transaction.
 	GasLimit uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
 	// to is the hex formatted address of the recipient
@@ -141,11 +141,11 @@ var xxx_messageInfo_LegacyTx proto.InternalMessageInfo
 // AccessListTx is the data of EIP-2930 access list transactions.
 type AccessListTx struct {
 	// chain_id of the destination EVM chain
-	ChainID *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chainID"`
+	ChainID *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=chain_id,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chainID"`
 	// nonce corresponds to the account nonce (transaction sequence).
 	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// gas_price defines the value for each gas unit
-	GasPrice *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_price,omitempty"`
+	GasPrice *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=gas_price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_price,omitempty"`
 	// gas defines the gas limit defined for the transaction.
 	GasLimit uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
 	// to is the recipient address in hex format
@@ -200,13 +200,13 @@ var xxx_messageInfo_AccessListTx proto.InternalMessageInfo
 // DynamicFeeTx is the data of EIP-1559 dinamic fee transactions.
 type DynamicFeeTx struct {
 	// chain_id of the destination EVM chain
-	ChainID *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chainID"`
+	ChainID *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=chain_id,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chainID"`
 	// nonce corresponds to the account nonce (transaction sequence).
 	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	// gas_tip_cap defines the max value for the gas tip
-	GasTipCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=gas_tip_cap,json=gasTipCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_tip_cap,omitempty"`
+	GasTipCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=gas_tip_cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_tip_cap,omitempty"`
 	// gas_fee_cap defines the max value for the gas fee
-	GasFeeCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=gas_fee_cap,json=gasFeeCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_fee_cap,omitempty"`
+	GasFeeCap *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=gas_fee_cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"gas_fee_cap,omitempty"`
 	// gas defines the gas limit defined for the transaction.
 	GasLimit uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
 	// to is the hex formatted address of the recipient
@@ -308,9 +308,9 @@ type MsgEthereumTxResponse struct {
 	// opcode)
 	Ret []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
 	// vm_error is the error returned by vm execution
-	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	VmError string `protobuf:"bytes,4,opt,name=vm_error,proto3" json:"vm_error,omitempty"`
 	// gas_used specifies how much gas was consumed by the transaction
-	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,proto3" json:"gas_used,omitempty"`
 }
 
 func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
@@ -440,6 +440,194 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterPrecompile defines a Msg for adding a precompile instance to the
+// registry or for replacing the instance registered at the same address.
+type MsgRegisterPrecompile struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// instance defines the precompile instance to register.
+	Instance PrecompileInstance `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance"`
+}
+
+func (m *MsgRegisterPrecompile) Reset()         { *m = MsgRegisterPrecompile{} }
+func (m *MsgRegisterPrecompile) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPrecompile) ProtoMessage()    {}
+func (*MsgRegisterPrecompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgRegisterPrecompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPrecompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPrecompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPrecompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPrecompile.Merge(m, src)
+}
+func (m *MsgRegisterPrecompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPrecompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPrecompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPrecompile proto.InternalMessageInfo
+
+func (m *MsgRegisterPrecompile) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRegisterPrecompile) GetInstance() PrecompileInstance {
+	if m != nil {
+		return m.Instance
+	}
+	return PrecompileInstance{}
+}
+
+// MsgRegisterPrecompileResponse defines the response structure for executing a
+// MsgRegisterPrecompile message.
+type MsgRegisterPrecompileResponse struct {
+}
+
+func (m *MsgRegisterPrecompileResponse) Reset()         { *m = MsgRegisterPrecompileResponse{} }
+func (m *MsgRegisterPrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPrecompileResponse) ProtoMessage()    {}
+func (*MsgRegisterPrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgRegisterPrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPrecompileResponse.Merge(m, src)
+}
+func (m *MsgRegisterPrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPrecompileResponse proto.InternalMessageInfo
+
+// MsgRemovePrecompile defines a Msg for deleting a precompile instance from
+// the registry.
+type MsgRemovePrecompile struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address defines the hex address of the precompile instance to remove.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgRemovePrecompile) Reset()         { *m = MsgRemovePrecompile{} }
+func (m *MsgRemovePrecompile) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePrecompile) ProtoMessage()    {}
+func (*MsgRemovePrecompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgRemovePrecompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePrecompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePrecompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePrecompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePrecompile.Merge(m, src)
+}
+func (m *MsgRemovePrecompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePrecompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePrecompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePrecompile proto.InternalMessageInfo
+
+func (m *MsgRemovePrecompile) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemovePrecompile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgRemovePrecompileResponse defines the response structure for executing a
+// MsgRemovePrecompile message.
+type MsgRemovePrecompileResponse struct {
+}
+
+func (m *MsgRemovePrecompileResponse) Reset()         { *m = MsgRemovePrecompileResponse{} }
+func (m *MsgRemovePrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePrecompileResponse) ProtoMessage()    {}
+func (*MsgRemovePrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgRemovePrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePrecompileResponse.Merge(m, src)
+}
+func (m *MsgRemovePrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePrecompileResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -449,74 +637,79 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterPrecompile)(nil), "ethermint.evm.v1.MsgRegisterPrecompile")
+	proto.RegisterType((*MsgRegisterPrecompileResponse)(nil), "ethermint.evm.v1.MsgRegisterPrecompileResponse")
+	proto.RegisterType((*MsgRemovePrecompile)(nil), "ethermint.evm.v1.MsgRemovePrecompile")
+	proto.RegisterType((*MsgRemovePrecompileResponse)(nil), "ethermint.evm.v1.MsgRemovePrecompileResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 977 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x13, 0xe7, 0xdf, 0x24, 0x2c, 0xd5, 0x68, 0xab, 0x3a, 0x01, 0xe2, 0x34, 0x07, 0x48,
-	0x2b, 0xc5, 0xd6, 0x2e, 0xd0, 0xc3, 0x9e, 0xba, 0xe9, 0x6e, 0xab, 0x56, 0xbb, 0xa2, 0x32, 0xe9,
-	0x85, 0x22, 0x45, 0xb3, 0xce, 0xec, 0xc4, 0x22, 0xf6, 0x58, 0x9e, 0x89, 0x95, 0x70, 0xec, 0x89,
-	0x1b, 0x20, 0xbe, 0x00, 0x07, 0x4e, 0x9c, 0x90, 0xe8, 0x07, 0xe0, 0x58, 0x71, 0xaa, 0xe0, 0x82,
-	0x38, 0x18, 0x94, 0x45, 0x42, 0xda, 0x1b, 0x7c, 0x02, 0x34, 0x33, 0x4e, 0xb2, 0x69, 0xd8, 0x16,
-	0x4a, 0x51, 0x4f, 0x9e, 0xe7, 0xf7, 0xe6, 0xbd, 0x37, 0xbf, 0xdf, 0x6f, 0xfe, 0x80, 0x1a, 0xe6,
-	0x43, 0x1c, 0xf9, 0x5e, 0xc0, 0x6d, 0x1c, 0xfb, 0x76, 0xbc, 0x65, 0xf3, 0x89, 0x15, 0x46, 0x94,
-	0x53, 0x78, 0x61, 0xe1, 0xb2, 0x70, 0xec, 0x5b, 0xf1, 0x56, 0xfd, 0x92, 0x4b, 0x99, 0x4f, 0x99,
-	0xed, 0x33, 0x22, 0x22, 0x7d, 0x46, 0x54, 0x68, 0xbd, 0xa6, 0x1c, 0x7d, 0x69, 0xd9, 0xca, 0x48,
-	0x5d, 0xf5, 0xb5, 0x02, 0x22, 0x99, 0xf2, 0x6d, 0x12, 0x4a, 0xa8, 0x9a, 0x23, 0x46, 0xe9, 0xdf,
-	0xd7, 0x09, 0xa5, 0x64, 0x84, 0x6d, 0x14, 0x7a, 0x36, 0x0a, 0x02, 0xca, 0x11, 0xf7, 0x68, 0x30,
-	0xcf, 0x57, 0x4b, 0xbd, 0xd2, 0x3a, 0x1a, 0x1f, 0xdb, 0x28, 0x98, 0x2a, 0x57, 0xeb, 0x53, 0x0d,
-	0xbc, 0x72, 0xc8, 0xc8, 0xbe, 0x28, 0x88, 0xc7, 0x7e, 0x6f, 0x02, 0xdb, 0x40, 0x1f, 0x20, 0x8e,
-	0x0c, 0xad, 0xa9, 0xb5, 0x2b, 0xdb, 0x9b, 0x96, 0x9a, 0x6b, 0xcd, 0xe7, 0x5a, 0xbb, 0xc1, 0xd4,
-	0x91, 0x11, 0xb0, 0x06, 0x74, 0xe6, 0x7d, 0x8c, 0x8d, 0x6c, 0x53, 0x6b, 0x6b, 0xdd, 0xfc, 0x69,
-	0x62, 0x6a, 0x1d, 0x47, 0xfe, 0x82, 0x26, 0xd0, 0x87, 0x88, 0x0d, 0x8d, 0x5c, 0x53, 0x6b, 0x97,
-	0xbb, 0x95, 0x3f, 0x13, 0xb3, 0x18, 0x8d, 0xc2, 0x9d, 0x56, 0xa7, 0xe5, 0x48, 0x07, 0x84, 0x40,
-	0x3f, 0x8e, 0xa8, 0x6f, 0xe8, 0x22, 0xc0, 0x91, 0xe3, 0x1d, 0xfd, 0x93, 0x2f, 0xcd, 0x4c, 0xeb,
-	0xdb, 0x2c, 0x28, 0x1d, 0x60, 0x82, 0xdc, 0x69, 0x6f, 0x02, 0x37, 0x41, 0x3e, 0xa0, 0x81, 0x8b,
-	0x65, 0x37, 0xba, 0xa3, 0x0c, 0x78, 0x0b, 0x94, 0x09, 0x12, 0xc8, 0x79, 0xae, 0xaa, 0x5e, 0xee,
-	0x5e, 0xfd, 0x39, 0x31, 0xdf, 0x24, 0x1e, 0x1f, 0x8e, 0x8f, 0x2c, 0x97, 0xfa, 0x29, 0x9e, 0xe9,
-	0xa7, 0xc3, 0x06, 0x1f, 0xd9, 0x7c, 0x1a, 0x62, 0x66, 0xdd, 0x0e, 0xb8, 0x53, 0x22, 0x88, 0xdd,
-	0x15, 0x73, 0x61, 0x03, 0xe4, 0x08, 0x62, 0xb2, 0x4b, 0xbd, 0x5b, 0x9d, 0x25, 0x66, 0xe9, 0x16,
-	0x62, 0x07, 0x9e, 0xef, 0x71, 0x47, 0x38, 0xe0, 0x06, 0xc8, 0x72, 0x9a, 0xf6, 0x98, 0xe5, 0x14,
-	0xde, 0x01, 0xf9, 0x18, 0x8d, 0xc6, 0xd8, 0xc8, 0xcb, 0xa2, 0xef, 0xfc, 0xf3, 0xa2, 0xb3, 0xc4,
-	0x2c, 0xec, 0xfa, 0x74, 0x1c, 0x70, 0x47, 0xa5, 0x10, 0x08, 0x48, 0x9c, 0x0b, 0x4d, 0xad, 0x5d,
-	0x4d, 0x11, 0xad, 0x02, 0x2d, 0x36, 0x8a, 0xf2, 0x87, 0x16, 0x0b, 0x2b, 0x32, 0x4a, 0xca, 0x8a,
-	0x84, 0xc5, 0x8c, 0xb2, 0xb2, 0xd8, 0xce, 0x86, 0xc0, 0xea, 0xfb, 0x87, 0x9d, 0x42, 0x6f, 0xb2,
-	0x87, 0x38, 0x6a, 0xfd, 0x91, 0x03, 0xd5, 0x5d, 0xd7, 0xc5, 0x8c, 0x1d, 0x78, 0x8c, 0xf7, 0x26,
-	0xf0, 0x3e, 0x28, 0xb9, 0x43, 0xe4, 0x05, 0x7d, 0x6f, 0x20, 0xc1, 0x2b, 0x77, 0xaf, 0xff, 0xab,
-	0x6e, 0x8b, 0x37, 0xc4, 0xec, 0xdb, 0x7b, 0xa7, 0x89, 0x59, 0x74, 0xd5, 0xd0, 0x49, 0x07, 0x83,
-	0x25, 0x2d, 0xd9, 0x73, 0x69, 0xc9, 0xfd, 0x77, 0x5a, 0xf4, 0xa7, 0xd3, 0x92, 0x5f, 0xa7, 0xa5,
-	0xf0, 0xe2, 0x68, 0x29, 0x9e, 0xa1, 0xe5, 0x3e, 0x28, 0x21, 0x89, 0x2d, 0x66, 0x46, 0xa9, 0x99,
-	0x6b, 0x57, 0xb6, 0xdf, 0xb0, 0x9e, 0xdc, 0xe8, 0x96, 0x42, 0xbf, 0x37, 0x0e, 0x47, 0xb8, 0xdb,
-	0x7c, 0x94, 0x98, 0x99, 0xd3, 0xc4, 0x04, 0x68, 0x41, 0xc9, 0xd7, 0xbf, 0x98, 0x60, 0x49, 0x90,
-	0xb3, 0x48, 0xa8, 0x38, 0x2f, 0xaf, 0x70, 0x0e, 0x56, 0x38, 0xaf, 0x9c, 0xc7, 0xf9, 0x77, 0x3a,
-	0xa8, 0xee, 0x4d, 0x03, 0xe4, 0x7b, 0xee, 0x4d, 0x8c, 0x5f, 0x0e, 0xe7, 0x77, 0x40, 0x45, 0x70,
-	0xce, 0xbd, 0xb0, 0xef, 0xa2, 0xf0, 0x39, 0x58, 0x17, 0x92, 0xe9, 0x79, 0xe1, 0x0d, 0x14, 0xce,
-	0x73, 0x1d, 0x63, 0x2c, 0x73, 0xe9, 0xcf, 0x95, 0xeb, 0x26, 0xc6, 0x22, 0x57, 0x2a, 0xa1, 0xfc,
-	0xd3, 0x25, 0x54, 0x58, 0x97, 0x50, 0xf1, 0xc5, 0x49, 0xa8, 0x74, 0x8e, 0x84, 0xca, 0xff, 0x8b,
-	0x84, 0xc0, 0x8a, 0x84, 0x2a, 0x2b, 0x12, 0xaa, 0x9e, 0x27, 0xa1, 0x16, 0xa8, 0xef, 0x4f, 0x38,
-	0x0e, 0x98, 0x47, 0x83, 0xf7, 0x42, 0x79, 0x67, 0x2c, 0xaf, 0x82, 0xf4, 0x40, 0xfe, 0x4a, 0x03,
-	0x17, 0x57, 0xae, 0x08, 0x07, 0xb3, 0x90, 0x06, 0x4c, 0x2e, 0x54, 0x9e, 0xf2, 0x9a, 0x3a, 0xc4,
-	0xe5, 0xc1, 0x7e, 0x05, 0xe8, 0x23, 0x4a, 0x98, 0x91, 0x95, 0x8b, 0xbc, 0xb8, 0xbe, 0xc8, 0x03,
-	0x4a, 0x1c, 0x19, 0x02, 0x2f, 0x80, 0x5c, 0x84, 0xb9, 0xd4, 0x4c, 0xd5, 0x11, 0x43, 0x58, 0x03,
-	0xa5, 0xd8, 0xef, 0xe3, 0x28, 0xa2, 0x51, 0x7a, 0xea, 0x16, 0x63, 0x7f, 0x5f, 0x98, 0xc2, 0x25,
-	0xc4, 0x31, 0x66, 0x78, 0xa0, 0x58, 0x75, 0x8a, 0x04, 0xb1, 0x7b, 0x0c, 0x0f, 0xd2, 0x36, 0x3f,
-	0xd7, 0xc0, 0xab, 0x87, 0x8c, 0xdc, 0x0b, 0x07, 0x88, 0xe3, 0xbb, 0x28, 0x42, 0x3e, 0x83, 0xd7,
-	0x40, 0x19, 0x8d, 0xf9, 0x90, 0x46, 0x1e, 0x9f, 0xa6, 0x3b, 0xc2, 0xf8, 0xe1, 0x61, 0x67, 0x33,
-	0xbd, 0x6d, 0x77, 0x07, 0x83, 0x08, 0x33, 0xf6, 0x3e, 0x8f, 0xbc, 0x80, 0x38, 0xcb, 0x50, 0x78,
-	0x0d, 0x14, 0x42, 0x99, 0x41, 0x8a, 0xbd, 0xb2, 0x6d, 0xac, 0x2f, 0x43, 0x55, 0xe8, 0xea, 0x82,
-	0x26, 0x27, 0x8d, 0xde, 0xd9, 0x78, 0xf0, 0xfb, 0x37, 0x57, 0x97, 0x79, 0x5a, 0x35, 0x70, 0xe9,
-	0x89, 0x96, 0xe6, 0xd8, 0x6d, 0x27, 0x1a, 0xc8, 0x1d, 0x32, 0x02, 0xa7, 0x00, 0x9c, 0xb9, 0x7c,
-	0xcd, 0xf5, 0x42, 0x2b, 0xd0, 0xd7, 0xdf, 0x7a, 0x46, 0xc0, 0x3c, 0x7f, 0xeb, 0xf2, 0x83, 0x1f,
-	0x7f, 0xfb, 0x22, 0xfb, 0x5a, 0xab, 0x26, 0xde, 0x0e, 0x94, 0x2d, 0x1e, 0x12, 0x69, 0x64, 0x9f,
-	0x4f, 0xe0, 0x87, 0xa0, 0xba, 0x82, 0xd6, 0xe5, 0xbf, 0xcd, 0x7d, 0x36, 0xa4, 0x7e, 0xe5, 0x99,
-	0x21, 0xf3, 0x06, 0xba, 0xd7, 0x1f, 0xcd, 0x1a, 0xda, 0xe3, 0x59, 0x43, 0xfb, 0x75, 0xd6, 0xd0,
-	0x3e, 0x3b, 0x69, 0x64, 0x1e, 0x9f, 0x34, 0x32, 0x3f, 0x9d, 0x34, 0x32, 0x1f, 0x9c, 0xdd, 0x58,
-	0x8b, 0xe6, 0x28, 0xb3, 0xe3, 0xad, 0x77, 0xed, 0x89, 0x6c, 0x54, 0x6e, 0xae, 0xa3, 0x82, 0x7c,
-	0x73, 0xbc, 0xfd, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0x09, 0x53, 0x2a, 0x70, 0x09, 0x00,
-	0x00,
+	// 1006 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xda, 0x6b, 0x7b, 0xfd, 0xbc, 0x6d, 0xa3, 0x69, 0xaa, 0xae, 0x5d, 0xe2, 0x75, 0x97,
+	0x1f, 0x0d, 0x45, 0xde, 0x55, 0x4c, 0xb9, 0x44, 0x20, 0xb0, 0x9b, 0x82, 0x22, 0x25, 0xa2, 0x04,
+	0x73, 0x41, 0x48, 0xd6, 0x64, 0x3d, 0x59, 0x8f, 0xea, 0xdd, 0x59, 0xed, 0x8c, 0x8d, 0xcd, 0x01,
+	0x89, 0x72, 0xe1, 0x88, 0xe0, 0x1f, 0xe0, 0xcc, 0x01, 0x71, 0xe8, 0x81, 0x3f, 0xa1, 0xe2, 0x54,
+	0xc1, 0x05, 0x71, 0x30, 0xc8, 0x41, 0x42, 0xca, 0x0d, 0xfe, 0x02, 0xb4, 0x3f, 0x6c, 0xc7, 0xb5,
+	0xa3, 0xa6, 0x51, 0xc4, 0xc9, 0xb3, 0x7e, 0xdf, 0xbc, 0xf7, 0xcd, 0xf7, 0xbd, 0x7d, 0x3b, 0x50,
+	0x24, 0xa2, 0x43, 0x02, 0x97, 0x7a, 0xc2, 0x22, 0x7d, 0xd7, 0xea, 0x6f, 0x5a, 0x62, 0x60, 0xfa,
+	0x01, 0x13, 0x0c, 0xad, 0x4e, 0x43, 0x26, 0xe9, 0xbb, 0x66, 0x7f, 0xb3, 0x74, 0xdd, 0x66, 0xdc,
+	0x65, 0xdc, 0x72, 0xb9, 0x13, 0x22, 0x5d, 0xee, 0xc4, 0xd0, 0x52, 0x31, 0x0e, 0xb4, 0xa2, 0x27,
+	0x2b, 0x7e, 0x48, 0x42, 0xa5, 0x85, 0x02, 0x61, 0xb2, 0x38, 0xb6, 0xe6, 0x30, 0x87, 0xc5, 0x7b,
+	0xc2, 0x55, 0xf2, 0xef, 0x0b, 0x0e, 0x63, 0x4e, 0x97, 0x58, 0xd8, 0xa7, 0x16, 0xf6, 0x3c, 0x26,
+	0xb0, 0xa0, 0xcc, 0x9b, 0xe4, 0x2b, 0x26, 0xd1, 0xe8, 0xe9, 0xa0, 0x77, 0x68, 0x61, 0x6f, 0x18,
+	0x87, 0x8c, 0x4f, 0xe1, 0xd2, 0x1e, 0x77, 0xee, 0x85, 0xf5, 0x48, 0xcf, 0x6d, 0x0e, 0x90, 0x01,
+	0x72, 0x1b, 0x0b, 0xac, 0x49, 0x15, 0x69, 0xa3, 0x50, 0x5b, 0x33, 0xe3, 0xad, 0xe6, 0x64, 0xab,
+	0x59, 0xf7, 0x86, 0xe8, 0x2a, 0xc8, 0x9c, 0x7e, 0x46, 0xb4, 0x54, 0x45, 0xda, 0x90, 0x1a, 0x99,
+	0xe3, 0x91, 0x2e, 0x55, 0x51, 0x11, 0xe4, 0x0e, 0xe6, 0x1d, 0x2d, 0x5d, 0x91, 0x36, 0xf2, 0x8d,
+	0xc2, 0xbf, 0x23, 0x3d, 0x17, 0x74, 0xfd, 0x2d, 0xa3, 0x6a, 0x20, 0x15, 0xe4, 0xc3, 0x80, 0xb9,
+	0x9a, 0x1c, 0x86, 0xb6, 0xe4, 0xaf, 0xbe, 0xd3, 0x57, 0x8c, 0x2f, 0x53, 0xa0, 0xec, 0x12, 0x07,
+	0xdb, 0xc3, 0xe6, 0x00, 0x5d, 0x82, 0x8c, 0xc7, 0x3c, 0x9b, 0x44, 0x55, 0x65, 0xf4, 0x16, 0xe4,
+	0x1d, 0x1c, 0x2a, 0x43, 0xed, 0xb8, 0x48, 0xbe, 0x71, 0xfb, 0xf7, 0x91, 0xfe, 0x8a, 0x43, 0x45,
+	0xa7, 0x77, 0x60, 0xda, 0xcc, 0x4d, 0xf4, 0x4a, 0x7e, 0xaa, 0xbc, 0xfd, 0xc0, 0x12, 0x43, 0x9f,
+	0x70, 0x73, 0xc7, 0x13, 0xa8, 0x08, 0x69, 0x07, 0xf3, 0x88, 0x88, 0xdc, 0x50, 0xc7, 0x23, 0x5d,
+	0x79, 0x0f, 0xf3, 0x5d, 0xea, 0x52, 0x81, 0x00, 0x52, 0x82, 0xc5, 0x3c, 0xd0, 0x5d, 0xc8, 0xf4,
+	0x71, 0xb7, 0x47, 0xb4, 0x4c, 0x54, 0xe1, 0xce, 0xd9, 0x2b, 0x8c, 0x47, 0x7a, 0xb6, 0xee, 0xb2,
+	0x9e, 0x27, 0x90, 0x9a, 0xc8, 0x95, 0xad, 0x48, 0x1b, 0x2a, 0xca, 0x83, 0xd4, 0xd7, 0x72, 0x93,
+	0x65, 0xa0, 0x29, 0x93, 0x25, 0xd7, 0xf2, 0xe1, 0x72, 0xeb, 0x72, 0x78, 0xf6, 0x9f, 0x1f, 0x55,
+	0xb3, 0xcd, 0xc1, 0x36, 0x16, 0xd8, 0xf8, 0x21, 0x0d, 0x6a, 0xdd, 0xb6, 0x09, 0xe7, 0xbb, 0x94,
+	0x8b, 0xe6, 0x00, 0xed, 0x83, 0x62, 0x77, 0x30, 0xf5, 0x5a, 0xb4, 0x1d, 0x89, 0x91, 0x6f, 0xbc,
+	0xf3, 0x5c, 0xbc, 0x72, 0x77, 0xc3, 0xdd, 0x3b, 0xdb, 0xc7, 0x23, 0x3d, 0x67, 0xc7, 0xcb, 0x99,
+	0xba, 0xa9, 0x45, 0x75, 0xd3, 0xe7, 0x55, 0x57, 0x3e, 0x55, 0xdd, 0xcc, 0xbc, 0xba, 0xd9, 0x0b,
+	0x50, 0x37, 0x96, 0xf4, 0x03, 0x50, 0x70, 0xa4, 0x15, 0xe1, 0x9a, 0x52, 0x49, 0x6f, 0x14, 0x6a,
+	0xeb, 0xe6, 0xd3, 0xef, 0x9b, 0x19, 0xab, 0xd9, 0xec, 0xf9, 0x5d, 0xd2, 0xa8, 0x3c, 0x1e, 0xe9,
+	0x2b, 0xc7, 0x23, 0x1d, 0xf0, 0x54, 0xe2, 0xef, 0xff, 0xd0, 0x61, 0x26, 0x78, 0x6c, 0x58, 0x7e,
+	0x66, 0x18, 0xcc, 0x0c, 0x2b, 0x2c, 0x35, 0xec, 0x9f, 0x34, 0xa8, 0xdb, 0x43, 0x0f, 0xbb, 0xd4,
+	0x7e, 0x97, 0x90, 0xff, 0xc7, 0xb0, 0xb7, 0xa1, 0x10, 0x1a, 0x26, 0xa8, 0xdf, 0xb2, 0xb1, 0x7f,
+	0x0e, 0xcb, 0x92, 0x04, 0x87, 0x84, 0x44, 0x09, 0xe4, 0xf3, 0x7a, 0x9e, 0x39, 0xd5, 0xf3, 0xec,
+	0xbc, 0xe7, 0xb9, 0x0b, 0xf0, 0x5c, 0x59, 0xf0, 0x3c, 0x7f, 0x81, 0x9e, 0xc3, 0xcc, 0xf3, 0xc2,
+	0xcc, 0x73, 0x75, 0xa9, 0xe7, 0x06, 0x94, 0xee, 0x0d, 0x04, 0xf1, 0x38, 0x65, 0xde, 0xfb, 0x7e,
+	0x34, 0x58, 0x67, 0x03, 0x33, 0x19, 0x67, 0x5f, 0x48, 0x70, 0x6d, 0x6e, 0x90, 0xee, 0x13, 0xee,
+	0x33, 0x8f, 0x13, 0xa4, 0x26, 0x73, 0x31, 0x6a, 0x0e, 0xf4, 0x22, 0xc8, 0x5d, 0xe6, 0x70, 0x2d,
+	0x15, 0x9d, 0xe5, 0xda, 0xe2, 0x59, 0x76, 0x99, 0x83, 0x0a, 0x90, 0x0e, 0x88, 0x88, 0x8c, 0x56,
+	0xd1, 0x2a, 0x28, 0x7d, 0xb7, 0x45, 0x82, 0x80, 0x05, 0xc9, 0xe0, 0x5a, 0x05, 0x25, 0xb4, 0xb3,
+	0xc7, 0x49, 0x3b, 0xb6, 0x24, 0xe1, 0xf0, 0x39, 0x5c, 0xd9, 0xe3, 0xce, 0x47, 0x7e, 0x1b, 0x0b,
+	0x72, 0x1f, 0x07, 0xd8, 0xe5, 0xe8, 0x35, 0xc8, 0xe3, 0x9e, 0xe8, 0xb0, 0x80, 0x8a, 0x61, 0xd2,
+	0x9e, 0xda, 0x2f, 0x8f, 0xaa, 0x6b, 0xc9, 0xe7, 0xa6, 0xde, 0x6e, 0x07, 0x84, 0xf3, 0x0f, 0x45,
+	0x40, 0x3d, 0x07, 0x99, 0x90, 0xf5, 0xa3, 0x6d, 0x51, 0xdf, 0x15, 0x6a, 0xda, 0x22, 0xbb, 0x38,
+	0x6d, 0x43, 0x0e, 0x45, 0xde, 0xba, 0xfc, 0xf0, 0xef, 0x1f, 0x6f, 0xcf, 0xf2, 0x1b, 0x45, 0xb8,
+	0xfe, 0x54, 0xfd, 0x89, 0x08, 0xc6, 0x37, 0xb1, 0x3c, 0xfb, 0xc4, 0xa1, 0x5c, 0x90, 0xe0, 0x7e,
+	0x40, 0x6c, 0xe6, 0xfa, 0xb4, 0x4b, 0x9e, 0x8f, 0xe1, 0x9b, 0xa0, 0x50, 0x8f, 0x0b, 0x3c, 0x79,
+	0x37, 0x0a, 0xb5, 0x97, 0x96, 0x70, 0x9c, 0x26, 0xdf, 0x49, 0xb0, 0xa7, 0xf0, 0xd5, 0x61, 0x7d,
+	0x29, 0xa7, 0x29, 0x6b, 0x1b, 0xae, 0x46, 0x00, 0x97, 0xf5, 0xc9, 0x79, 0x29, 0x5f, 0x81, 0x1c,
+	0x8e, 0xff, 0x88, 0xbf, 0x64, 0x0b, 0x2c, 0xd6, 0xe1, 0xc6, 0x92, 0x22, 0x13, 0x0e, 0xb5, 0x9f,
+	0xd2, 0x90, 0xde, 0xe3, 0x0e, 0x1a, 0x02, 0x9c, 0xf8, 0x4a, 0xeb, 0x8b, 0xc7, 0x9e, 0xeb, 0xbe,
+	0xd2, 0xad, 0x67, 0x00, 0xa6, 0x67, 0xbc, 0xf9, 0xf0, 0xd7, 0xbf, 0xbe, 0x4d, 0xdd, 0x30, 0x8a,
+	0xe1, 0x1d, 0x83, 0xf1, 0xe9, 0x85, 0x23, 0x41, 0xb6, 0xc4, 0x00, 0x7d, 0x02, 0xea, 0x5c, 0x53,
+	0xdd, 0x5c, 0x9a, 0xfb, 0x24, 0xa4, 0xf4, 0xea, 0x33, 0x21, 0xd3, 0xf7, 0xc3, 0x03, 0xb4, 0xa4,
+	0x2d, 0x96, 0xf3, 0x5f, 0x04, 0x96, 0xac, 0x33, 0x02, 0xa7, 0xf5, 0x3a, 0xb0, 0xba, 0xe0, 0xe8,
+	0xcb, 0xa7, 0x24, 0x99, 0x87, 0x95, 0xaa, 0x67, 0x82, 0x4d, 0x2a, 0x35, 0xea, 0x8f, 0xc7, 0x65,
+	0xe9, 0xc9, 0xb8, 0x2c, 0xfd, 0x39, 0x2e, 0x4b, 0x5f, 0x1f, 0x95, 0x57, 0x9e, 0x1c, 0x95, 0x57,
+	0x7e, 0x3b, 0x2a, 0xaf, 0x7c, 0x7c, 0xeb, 0xc4, 0x54, 0x7c, 0x80, 0x05, 0xdb, 0xdc, 0xbc, 0x63,
+	0x1d, 0x0c, 0x05, 0xb1, 0xfa, 0x9b, 0x6f, 0x58, 0x83, 0xc8, 0x83, 0x68, 0x34, 0x1e, 0x64, 0xa3,
+	0x7b, 0xd7, 0xeb, 0xff, 0x0d, 0x00, 0xb3, 0x7d, 0x0d, 0xb3, 0x73, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -536,6 +729,12 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterPrecompile defines a governance operation for adding or updating
+	// an instance of the precompile registry.
+	RegisterPrecompile(ctx context.Context, in *MsgRegisterPrecompile, opts ...grpc.CallOption) (*MsgRegisterPrecompileResponse, error)
+	// RemovePrecompile defines a governance operation for deleting an instance
+	// of the precompile registry.
+	RemovePrecompile(ctx context.Context, in *MsgRemovePrecompile, opts ...grpc.CallOption) (*MsgRemovePrecompileResponse, error)
 }
 
 type msgClient struct {
@@ -564,6 +763,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterPrecompile(ctx context.Context, in *MsgRegisterPrecompile, opts ...grpc.CallOption) (*MsgRegisterPrecompileResponse, error) {
+	out := new(MsgRegisterPrecompileResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/RegisterPrecompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemovePrecompile(ctx context.Context, in *MsgRemovePrecompile, opts ...grpc.CallOption) (*MsgRemovePrecompileResponse, error) {
+	out := new(MsgRemovePrecompileResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/RemovePrecompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -571,6 +788,12 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterPrecompile defines a governance operation for adding or updating
+	// an instance of the precompile registry.
+	RegisterPrecompile(context.Context, *MsgRegisterPrecompile) (*MsgRegisterPrecompileResponse, error)
+	// RemovePrecompile defines a governance operation for deleting an instance
+	// of the precompile registry.
+	RemovePrecompile(context.Context, *MsgRemovePrecompile) (*MsgRemovePrecompileResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +806,12 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterPrecompile(ctx context.Context, req *MsgRegisterPrecompile) (*MsgRegisterPrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPrecompile not implemented")
+}
+func (*UnimplementedMsgServer) RemovePrecompile(ctx context.Context, req *MsgRemovePrecompile) (*MsgRemovePrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePrecompile not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -624,6 +853,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterPrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/RegisterPrecompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPrecompile(ctx, req.(*MsgRegisterPrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/RemovePrecompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePrecompile(ctx, req.(*MsgRemovePrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -636,6 +901,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterPrecompile",
+			Handler:    _Msg_RegisterPrecompile_Handler,
+		},
+		{
+			MethodName: "RemovePrecompile",
+			Handler:    _Msg_RemovePrecompile_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1185,53 +1458,176 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterPrecompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterPrecompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPrecompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Size_ != 0 {
-		n += 9
+	{
+		size, err := m.Instance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LegacyTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterPrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePrecompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePrecompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePrecompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 9
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LegacyTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
@@ -1436,6 +1832,56 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterPrecompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Instance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterPrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePrecompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemovePrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3179,6 +3625,335 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterPrecompile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPrecompile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPrecompile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Instance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPrecompileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPrecompileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePrecompile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePrecompile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePrecompile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemovePrecompileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemovePrecompileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemovePrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0